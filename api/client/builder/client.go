@@ -7,20 +7,24 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"mime"
 	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"text/template"
 	"time"
 
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v4/network"
 	"github.com/prysmaticlabs/prysm/v4/network/authorization"
+	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	log "github.com/sirupsen/logrus"
@@ -34,6 +38,18 @@ const (
 	postRegisterValidatorPath  = "/eth/v1/builder/validators"
 )
 
+const (
+	jsonMediaType = "application/json"
+	sszMediaType  = "application/octet-stream"
+	// sszAcceptHeader prefers SSZ but lets relays which only speak JSON keep answering in JSON.
+	sszAcceptHeader        = sszMediaType + ";q=1.0," + jsonMediaType + ";q=0.9"
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// DefaultRelayTimeout is the default maximum amount of time a single relay is given to respond to a get header request.
+// It is kept below the proposer's builder timeout so bids from responsive relays are not lost to a slow one.
+const DefaultRelayTimeout = 950 * time.Millisecond
+
 var errMalformedHostname = errors.New("hostname must include port, separated by one colon, like example.com:3500")
 var errMalformedRequest = errors.New("required request data are missing")
 var errNotBlinded = errors.New("submitted block is not blinded")
@@ -84,6 +100,13 @@ func (*requestLogger) observe(r *http.Request) (e error) {
 
 var _ observer = &requestLogger{}
 
+// WithoutSSZ disables SSZ content negotiation, so that every request and response is JSON encoded.
+func WithoutSSZ() ClientOpt {
+	return func(c *Client) {
+		c.ssz = false
+	}
+}
+
 // BuilderClient provides a collection of helper methods for calling Builder API endpoints.
 type BuilderClient interface {
 	NodeURL() string
@@ -98,6 +121,13 @@ type Client struct {
 	hc      *http.Client
 	baseURL *url.URL
 	obvs    []observer
+	// ssz enables SSZ content negotiation for GetHeader and SubmitBlindedBlock.
+	ssz bool
+	// sszRejected is set once the builder refuses an SSZ request, after which only JSON is used.
+	sszRejected atomic.Bool
+	// sszAdvertised is set once the builder answers GetHeader in SSZ, after which blinded blocks are posted SSZ
+	// encoded.
+	sszAdvertised atomic.Bool
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -112,6 +142,7 @@ func NewClient(host string, opts ...ClientOpt) (*Client, error) {
 	c := &Client{
 		hc:      &http.Client{},
 		baseURL: u,
+		ssz:     true,
 	}
 	for _, o := range opts {
 		o(c)
@@ -138,10 +169,43 @@ func (c *Client) NodeURL() string {
 	return c.baseURL.String()
 }
 
+// sszEnabled reports whether requests should currently be attempted with SSZ encoding.
+func (c *Client) sszEnabled() bool {
+	return c.ssz && !c.sszRejected.Load()
+}
+
+// rejectSSZ stops SSZ negotiation with this builder for the lifetime of the client.
+func (c *Client) rejectSSZ() {
+	if !c.sszRejected.Swap(true) {
+		log.WithField("url", c.NodeURL()).Info("Builder does not support SSZ encoding, falling back to JSON")
+	}
+}
+
 type reqOption func(*http.Request)
 
+func acceptSSZ(r *http.Request) {
+	r.Header.Set("Accept", sszAcceptHeader)
+}
+
+func sszContentType(r *http.Request) {
+	r.Header.Set("Content-Type", sszMediaType)
+}
+
+func consensusVersion(v int) reqOption {
+	return func(r *http.Request) {
+		r.Header.Add(consensusVersionHeader, version.String(v))
+	}
+}
+
+// isSSZ reports whether a response carries an SSZ encoded body.
+func isSSZ(h http.Header) bool {
+	mt, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && mt == sszMediaType
+}
+
 // do is a generic, opinionated request function to reduce boilerplate amongst the methods in this package api/client/builder/types.go.
-func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, err error) {
+// Alongside the response body, it returns the response headers so callers can tell which encoding the server chose.
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, header http.Header, err error) {
 	ctx, span := trace.StartSpan(ctx, "builder.client.do")
 	defer func() {
 		tracing.AnnotateError(span, err)
@@ -184,6 +248,7 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 		err = errors.Wrap(err, "error reading http response body from builder server")
 		return
 	}
+	header = r.Header
 	return
 }
 
@@ -208,15 +273,35 @@ func execHeaderPath(slot primitives.Slot, parentHash [32]byte, pubkey [48]byte)
 }
 
 // GetHeader is used by a proposing validator to request an execution payload header from the Builder node.
+// SSZ encoding is requested when enabled, and the response is decoded according to the content type the builder
+// answered with.
 func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (SignedBid, error) {
 	path, err := execHeaderPath(slot, parentHash, pubkey)
 	if err != nil {
 		return nil, err
 	}
-	hb, err := c.do(ctx, http.MethodGet, path, nil)
+	var hb []byte
+	var h http.Header
+	if c.sszEnabled() {
+		hb, h, err = c.do(ctx, http.MethodGet, path, nil, acceptSSZ)
+		if errors.Is(err, ErrUnsupportedMediaType) {
+			c.rejectSSZ()
+			hb, h, err = c.do(ctx, http.MethodGet, path, nil)
+		}
+	} else {
+		hb, h, err = c.do(ctx, http.MethodGet, path, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if isSSZ(h) {
+		c.sszAdvertised.Store(true)
+		sb, err := unmarshalHeaderSSZ(h.Get(consensusVersionHeader), hb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader ssz response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+		}
+		return sb, nil
+	}
 	v := &VersionResponse{}
 	if err := json.Unmarshal(hb, v); err != nil {
 		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
@@ -248,6 +333,27 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 
 }
 
+// unmarshalHeaderSSZ decodes an SSZ GetHeader response body. SSZ bodies carry no version field, so the fork is taken
+// from the Eth-Consensus-Version response header.
+func unmarshalHeaderSSZ(v string, b []byte) (SignedBid, error) {
+	switch strings.ToLower(v) {
+	case strings.ToLower(version.String(version.Capella)):
+		p := &ethpb.SignedBuilderBidCapella{}
+		if err := p.UnmarshalSSZ(b); err != nil {
+			return nil, err
+		}
+		return WrappedSignedBuilderBidCapella(p)
+	case strings.ToLower(version.String(version.Bellatrix)):
+		p := &ethpb.SignedBuilderBid{}
+		if err := p.UnmarshalSSZ(b); err != nil {
+			return nil, err
+		}
+		return WrappedSignedBuilderBid(p)
+	default:
+		return nil, fmt.Errorf("unsupported header version %q", v)
+	}
+}
+
 // RegisterValidator encodes the SignedValidatorRegistrationV1 message to json (including hex-encoding the byte
 // fields with 0x prefixes) and posts to the builder validator registration endpoint.
 func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error {
@@ -271,7 +377,7 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 		return err
 	}
 
-	_, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
+	_, _, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
 	return err
 }
 
@@ -288,21 +394,17 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 			return nil, errors.Wrapf(err, "could not get protobuf block")
 		}
 		b := &SignedBlindedBeaconBlockBellatrix{SignedBlindedBeaconBlockBellatrix: psb}
-		body, err := json.Marshal(b)
-		if err != nil {
-			return nil, errors.Wrap(err, "error encoding the SignedBlindedBeaconBlockBellatrix value body in SubmitBlindedBlock")
-		}
-
-		ctx, cancel := context.WithTimeout(ctx, submitBlindedBlockTimeout)
-		defer cancel()
-		versionOpt := func(r *http.Request) {
-			r.Header.Add("Eth-Consensus-Version", version.String(version.Bellatrix))
-		}
-		rb, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), versionOpt)
-
+		rb, h, err := c.postBlindedBlock(ctx, version.Bellatrix, psb, b)
 		if err != nil {
 			return nil, errors.Wrap(err, "error posting the SignedBlindedBeaconBlockBellatrix to the builder api")
 		}
+		if isSSZ(h) {
+			p := &v1.ExecutionPayload{}
+			if err := p.UnmarshalSSZ(rb); err != nil {
+				return nil, errors.Wrap(err, "error unmarshaling the builder SubmitBlindedBlock ssz response")
+			}
+			return blocks.WrappedExecutionPayload(p)
+		}
 		ep := &ExecPayloadResponse{}
 		if err := json.Unmarshal(rb, ep); err != nil {
 			return nil, errors.Wrap(err, "error unmarshaling the builder SubmitBlindedBlock response")
@@ -318,21 +420,17 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 			return nil, errors.Wrapf(err, "could not get protobuf block")
 		}
 		b := &SignedBlindedBeaconBlockCapella{SignedBlindedBeaconBlockCapella: psb}
-		body, err := json.Marshal(b)
-		if err != nil {
-			return nil, errors.Wrap(err, "error encoding the SignedBlindedBeaconBlockCapella value body in SubmitBlindedBlockCapella")
-		}
-
-		ctx, cancel := context.WithTimeout(ctx, submitBlindedBlockTimeout)
-		defer cancel()
-		versionOpt := func(r *http.Request) {
-			r.Header.Add("Eth-Consensus-Version", version.String(version.Capella))
-		}
-		rb, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), versionOpt)
-
+		rb, h, err := c.postBlindedBlock(ctx, version.Capella, psb, b)
 		if err != nil {
 			return nil, errors.Wrap(err, "error posting the SignedBlindedBeaconBlockCapella to the builder api")
 		}
+		if isSSZ(h) {
+			p := &v1.ExecutionPayloadCapella{}
+			if err := p.UnmarshalSSZ(rb); err != nil {
+				return nil, errors.Wrap(err, "error unmarshaling the builder SubmitBlindedBlockCapella ssz response")
+			}
+			return blocks.WrappedExecutionPayloadCapella(p, big.NewInt(0))
+		}
 		ep := &ExecPayloadResponseCapella{}
 		if err := json.Unmarshal(rb, ep); err != nil {
 			return nil, errors.Wrap(err, "error unmarshaling the builder SubmitBlindedBlockCapella response")
@@ -347,11 +445,35 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	}
 }
 
+// postBlindedBlock posts a signed blinded block to the builder, SSZ encoded when enabled and the builder answered
+// GetHeader in SSZ, so that a signed block is never lost to a builder without SSZ support. A builder which refuses the
+// SSZ body is sent the JSON encoding of the same block instead, and is only spoken to in JSON from then on.
+func (c *Client) postBlindedBlock(ctx context.Context, v int, sszBlock ssz.Marshaler, jsonBlock json.Marshaler) ([]byte, http.Header, error) {
+	ctx, cancel := context.WithTimeout(ctx, submitBlindedBlockTimeout)
+	defer cancel()
+	if c.sszEnabled() && c.sszAdvertised.Load() {
+		body, err := sszBlock.MarshalSSZ()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error ssz encoding the %s blinded block", version.String(v))
+		}
+		rb, h, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), consensusVersion(v), sszContentType, acceptSSZ)
+		if !errors.Is(err, ErrUnsupportedMediaType) {
+			return rb, h, err
+		}
+		c.rejectSSZ()
+	}
+	body, err := json.Marshal(jsonBlock)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error encoding the %s blinded block", version.String(v))
+	}
+	return c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), consensusVersion(v))
+}
+
 // Status asks the remote builder server for a health check. A response of 200 with an empty body is the success/healthy
 // response, and an error response may have an error message. This method will return a nil value for error in the
 // happy path, and an error with information about the server response body for a non-200 response.
 func (c *Client) Status(ctx context.Context) error {
-	_, err := c.do(ctx, http.MethodGet, getStatus, nil)
+	_, _, err := c.do(ctx, http.MethodGet, getStatus, nil)
 	return err
 }
 
@@ -375,6 +497,9 @@ func non200Err(response *http.Response) error {
 		}
 		log.WithError(ErrBadRequest).Debug(msg)
 		return errors.Wrap(ErrBadRequest, errMessage.Message)
+	case 406, 415:
+		log.WithError(ErrUnsupportedMediaType).Debug(msg)
+		return errors.Wrap(ErrUnsupportedMediaType, fmt.Sprintf("code=%d", response.StatusCode))
 	case 404:
 		if jsonErr := json.Unmarshal(bodyBytes, &errMessage); jsonErr != nil {
 			return errors.Wrap(jsonErr, "unable to read response body")
@@ -0,0 +1,223 @@
+package builder_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	buildertesting "github.com/prysmaticlabs/prysm/v4/api/client/builder/testing"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+const sszMediaType = "application/octet-stream"
+
+func testBidCapella() *ethpb.SignedBuilderBidCapella {
+	return &ethpb.SignedBuilderBidCapella{
+		Message: &ethpb.BuilderBidCapella{
+			Header: &v1.ExecutionPayloadHeaderCapella{
+				ParentHash:       bytesutil.PadTo([]byte("parent"), 32),
+				FeeRecipient:     bytesutil.PadTo([]byte("fee"), 20),
+				StateRoot:        bytesutil.PadTo([]byte("state"), 32),
+				ReceiptsRoot:     bytesutil.PadTo([]byte("receipts"), 32),
+				LogsBloom:        bytesutil.PadTo([]byte("bloom"), 256),
+				PrevRandao:       bytesutil.PadTo([]byte("randao"), 32),
+				BlockNumber:      1,
+				GasLimit:         30_000_000,
+				GasUsed:          21_000,
+				Timestamp:        1000,
+				ExtraData:        []byte("extra"),
+				BaseFeePerGas:    bytesutil.PadTo([]byte{7}, 32),
+				BlockHash:        bytesutil.PadTo([]byte("hash"), 32),
+				TransactionsRoot: bytesutil.PadTo([]byte("txs"), 32),
+				WithdrawalsRoot:  bytesutil.PadTo([]byte("withdrawals"), 32),
+			},
+			Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
+			Pubkey: bytesutil.PadTo([]byte("pubkey"), 48),
+		},
+		Signature: bytesutil.PadTo([]byte("sig"), 96),
+	}
+}
+
+func testPayloadCapella() *v1.ExecutionPayloadCapella {
+	return &v1.ExecutionPayloadCapella{
+		ParentHash:    bytesutil.PadTo([]byte("parent"), 32),
+		FeeRecipient:  bytesutil.PadTo([]byte("fee"), 20),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		ReceiptsRoot:  bytesutil.PadTo([]byte("receipts"), 32),
+		LogsBloom:     bytesutil.PadTo([]byte("bloom"), 256),
+		PrevRandao:    bytesutil.PadTo([]byte("randao"), 32),
+		BlockNumber:   1,
+		GasLimit:      30_000_000,
+		GasUsed:       21_000,
+		Timestamp:     1000,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: bytesutil.PadTo([]byte{7}, 32),
+		BlockHash:     bytesutil.PadTo([]byte("hash"), 32),
+		Transactions:  [][]byte{[]byte("tx1"), []byte("tx2")},
+		Withdrawals: []*v1.Withdrawal{{
+			Index:          1,
+			ValidatorIndex: 2,
+			Address:        bytesutil.PadTo([]byte("address"), 20),
+			Amount:         3,
+		}},
+	}
+}
+
+func TestClient_RelayRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	sb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		rejectSSZ bool
+		opts      []builder.ClientOpt
+		wantSSZ   bool
+	}{
+		{name: "ssz", wantSSZ: true},
+		{name: "relay rejects ssz", rejectSSZ: true},
+		{name: "ssz disabled", opts: []builder.ClientOpt{builder.WithoutSSZ()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relay := buildertesting.NewRelay()
+			defer relay.Close()
+			relay.RejectSSZ = tt.rejectSSZ
+			relay.BidCapella = testBidCapella()
+			relay.PayloadCapella = testPayloadCapella()
+
+			c, err := builder.NewClient(relay.URL, tt.opts...)
+			require.NoError(t, err)
+
+			bid, err := c.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+			require.NoError(t, err)
+			require.Equal(t, version.Capella, bid.Version())
+			require.DeepEqual(t, relay.BidCapella.Signature, bid.Signature())
+			msg, err := bid.Message()
+			require.NoError(t, err)
+			require.DeepEqual(t, relay.BidCapella.Message.Value, msg.Value())
+			header, err := msg.Header()
+			require.NoError(t, err)
+			require.DeepEqual(t, relay.BidCapella.Message.Header, header.Proto())
+
+			for i := 0; i < 2; i++ {
+				payload, err := c.SubmitBlindedBlock(ctx, sb)
+				require.NoError(t, err)
+				require.DeepEqual(t, relay.PayloadCapella, payload.Proto())
+			}
+
+			var posts []buildertesting.RelayRequest
+			for _, r := range relay.Requests() {
+				if r.Method == http.MethodPost {
+					posts = append(posts, r)
+				}
+			}
+			// Blinded blocks are only posted in SSZ to a relay which answered GetHeader in SSZ.
+			require.Equal(t, 2, len(posts))
+			for _, p := range posts {
+				require.Equal(t, tt.wantSSZ, p.ContentType == sszMediaType)
+			}
+			for _, p := range posts {
+				require.Equal(t, version.String(version.Capella), p.ConsensusVersion)
+			}
+		})
+	}
+}
+
+func TestClient_RelayRoundTrip_Bellatrix(t *testing.T) {
+	ctx := context.Background()
+	capellaBid := testBidCapella()
+	capellaPayload := testPayloadCapella()
+	h := capellaBid.Message.Header
+	relay := buildertesting.NewRelay()
+	defer relay.Close()
+	relay.Bid = &ethpb.SignedBuilderBid{
+		Message: &ethpb.BuilderBid{
+			Header: &v1.ExecutionPayloadHeader{
+				ParentHash:       h.ParentHash,
+				FeeRecipient:     h.FeeRecipient,
+				StateRoot:        h.StateRoot,
+				ReceiptsRoot:     h.ReceiptsRoot,
+				LogsBloom:        h.LogsBloom,
+				PrevRandao:       h.PrevRandao,
+				BlockNumber:      h.BlockNumber,
+				GasLimit:         h.GasLimit,
+				GasUsed:          h.GasUsed,
+				Timestamp:        h.Timestamp,
+				ExtraData:        h.ExtraData,
+				BaseFeePerGas:    h.BaseFeePerGas,
+				BlockHash:        h.BlockHash,
+				TransactionsRoot: h.TransactionsRoot,
+			},
+			Value:  capellaBid.Message.Value,
+			Pubkey: capellaBid.Message.Pubkey,
+		},
+		Signature: capellaBid.Signature,
+	}
+	relay.Payload = &v1.ExecutionPayload{
+		ParentHash:    capellaPayload.ParentHash,
+		FeeRecipient:  capellaPayload.FeeRecipient,
+		StateRoot:     capellaPayload.StateRoot,
+		ReceiptsRoot:  capellaPayload.ReceiptsRoot,
+		LogsBloom:     capellaPayload.LogsBloom,
+		PrevRandao:    capellaPayload.PrevRandao,
+		BlockNumber:   capellaPayload.BlockNumber,
+		GasLimit:      capellaPayload.GasLimit,
+		GasUsed:       capellaPayload.GasUsed,
+		Timestamp:     capellaPayload.Timestamp,
+		ExtraData:     capellaPayload.ExtraData,
+		BaseFeePerGas: capellaPayload.BaseFeePerGas,
+		BlockHash:     capellaPayload.BlockHash,
+		Transactions:  capellaPayload.Transactions,
+	}
+
+	c, err := builder.NewClient(relay.URL)
+	require.NoError(t, err)
+	bid, err := c.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.NoError(t, err)
+	require.Equal(t, version.Bellatrix, bid.Version())
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	header, err := msg.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, relay.Bid.Message.Header.BlockHash, header.BlockHash())
+
+	sb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockBellatrix())
+	require.NoError(t, err)
+	payload, err := c.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	require.DeepEqual(t, relay.Payload, payload.Proto())
+
+	reqs := relay.Requests()
+	require.Equal(t, 2, len(reqs))
+	for _, r := range reqs {
+		require.Equal(t, true, r.Accept != "")
+	}
+	require.Equal(t, sszMediaType, reqs[1].ContentType)
+}
+
+func TestClient_SubmitBlindedBlock_NoHeaderJSON(t *testing.T) {
+	ctx := context.Background()
+	relay := buildertesting.NewRelay()
+	defer relay.Close()
+	relay.PayloadCapella = testPayloadCapella()
+	c, err := builder.NewClient(relay.URL)
+	require.NoError(t, err)
+
+	// The relay has not answered any header in SSZ yet.
+	sb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+	require.NoError(t, err)
+	payload, err := c.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	require.DeepEqual(t, relay.PayloadCapella, payload.Proto())
+	reqs := relay.Requests()
+	require.Equal(t, 1, len(reqs))
+	require.NotEqual(t, sszMediaType, reqs[0].ContentType)
+}
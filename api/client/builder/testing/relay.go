@@ -0,0 +1,266 @@
+package testing
+
+import (
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+const (
+	sszMediaType  = "application/octet-stream"
+	jsonMediaType = "application/json"
+)
+
+// RelayRequest describes a request received by a Relay.
+type RelayRequest struct {
+	Method           string
+	Path             string
+	ContentType      string
+	Accept           string
+	ConsensusVersion string
+}
+
+// Relay is a minimal builder relay served over HTTP, used to exercise the builder client end to end. It answers
+// GetHeader and SubmitBlindedBlock with the configured bid and payload, SSZ encoded when the request asks for it and
+// JSON encoded otherwise. The Capella bid and payload take precedence over the Bellatrix ones when both are set.
+type Relay struct {
+	*httptest.Server
+	// RejectSSZ makes the relay behave like one without SSZ support: SSZ request bodies are refused with a 415 and
+	// every response is JSON encoded.
+	RejectSSZ      bool
+	Bid            *ethpb.SignedBuilderBid
+	BidCapella     *ethpb.SignedBuilderBidCapella
+	Payload        *v1.ExecutionPayload
+	PayloadCapella *v1.ExecutionPayloadCapella
+
+	lock     sync.Mutex
+	requests []RelayRequest
+}
+
+// NewRelay starts a new test relay. Callers should Close it once done.
+func NewRelay() *Relay {
+	r := &Relay{}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serve))
+	return r
+}
+
+// Requests returns the requests received by the relay so far, in order.
+func (r *Relay) Requests() []RelayRequest {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	reqs := make([]RelayRequest, len(r.requests))
+	copy(reqs, r.requests)
+	return reqs
+}
+
+func (r *Relay) serve(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	r.requests = append(r.requests, RelayRequest{
+		Method:           req.Method,
+		Path:             req.URL.Path,
+		ContentType:      req.Header.Get("Content-Type"),
+		Accept:           req.Header.Get("Accept"),
+		ConsensusVersion: req.Header.Get("Eth-Consensus-Version"),
+	})
+	r.lock.Unlock()
+
+	switch {
+	case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header/"):
+		r.serveHeader(w, req)
+	case req.Method == http.MethodPost && req.URL.Path == "/eth/v1/builder/blinded_blocks":
+		r.serveBlindedBlock(w, req)
+	case req.Method == http.MethodGet && req.URL.Path == "/eth/v1/builder/status":
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (r *Relay) serveHeader(w http.ResponseWriter, req *http.Request) {
+	wantSSZ := !r.RejectSSZ && strings.Contains(req.Header.Get("Accept"), sszMediaType)
+	switch {
+	case r.BidCapella != nil:
+		if wantSSZ {
+			writeSSZ(w, version.Capella, r.BidCapella)
+			return
+		}
+		resp := &builder.ExecHeaderResponseCapella{}
+		resp.Data.Signature = r.BidCapella.Signature
+		resp.Data.Message = &builder.BuilderBidCapella{
+			Header: &builder.ExecutionPayloadHeaderCapella{ExecutionPayloadHeaderCapella: r.BidCapella.Message.Header},
+			Value:  builder.Uint256{Int: bytesutil.LittleEndianBytesToBigInt(r.BidCapella.Message.Value)},
+			Pubkey: r.BidCapella.Message.Pubkey,
+		}
+		writeJSON(w, version.Capella, resp.Data)
+	case r.Bid != nil:
+		if wantSSZ {
+			writeSSZ(w, version.Bellatrix, r.Bid)
+			return
+		}
+		resp := &builder.ExecHeaderResponse{}
+		resp.Data.Signature = r.Bid.Signature
+		resp.Data.Message = &builder.BuilderBid{
+			Header: &builder.ExecutionPayloadHeader{ExecutionPayloadHeader: r.Bid.Message.Header},
+			Value:  builder.Uint256{Int: bytesutil.LittleEndianBytesToBigInt(r.Bid.Message.Value)},
+			Pubkey: r.Bid.Message.Pubkey,
+		}
+		writeJSON(w, version.Bellatrix, resp.Data)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (r *Relay) serveBlindedBlock(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	v := strings.ToLower(req.Header.Get("Eth-Consensus-Version"))
+	if strings.HasPrefix(req.Header.Get("Content-Type"), sszMediaType) {
+		if r.RejectSSZ {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		var sb interface{ UnmarshalSSZ([]byte) error }
+		switch v {
+		case version.String(version.Capella):
+			sb = &ethpb.SignedBlindedBeaconBlockCapella{}
+		case version.String(version.Bellatrix):
+			sb = &ethpb.SignedBlindedBeaconBlockBellatrix{}
+		default:
+			writeError(w, http.StatusBadRequest, "unsupported consensus version "+v)
+			return
+		}
+		if err := sb.UnmarshalSSZ(body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	} else if !json.Valid(body) {
+		writeError(w, http.StatusBadRequest, "invalid json body")
+		return
+	}
+
+	wantSSZ := !r.RejectSSZ && strings.Contains(req.Header.Get("Accept"), sszMediaType)
+	switch {
+	case v == version.String(version.Capella) && r.PayloadCapella != nil:
+		if wantSSZ {
+			writeSSZ(w, version.Capella, r.PayloadCapella)
+			return
+		}
+		writeJSON(w, version.Capella, payloadCapellaJSON(r.PayloadCapella))
+	case v == version.String(version.Bellatrix) && r.Payload != nil:
+		if wantSSZ {
+			writeSSZ(w, version.Bellatrix, r.Payload)
+			return
+		}
+		writeJSON(w, version.Bellatrix, payloadJSON(r.Payload))
+	default:
+		writeError(w, http.StatusBadRequest, "no payload for consensus version "+v)
+	}
+}
+
+func payloadJSON(p *v1.ExecutionPayload) *builder.ExecutionPayload {
+	txs := make([]hexutil.Bytes, len(p.Transactions))
+	for i := range p.Transactions {
+		txs[i] = p.Transactions[i]
+	}
+	return &builder.ExecutionPayload{
+		ParentHash:    p.ParentHash,
+		FeeRecipient:  p.FeeRecipient,
+		StateRoot:     p.StateRoot,
+		ReceiptsRoot:  p.ReceiptsRoot,
+		LogsBloom:     p.LogsBloom,
+		PrevRandao:    p.PrevRandao,
+		BlockNumber:   builder.Uint64String(p.BlockNumber),
+		GasLimit:      builder.Uint64String(p.GasLimit),
+		GasUsed:       builder.Uint64String(p.GasUsed),
+		Timestamp:     builder.Uint64String(p.Timestamp),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: builder.Uint256{Int: bytesutil.LittleEndianBytesToBigInt(p.BaseFeePerGas)},
+		BlockHash:     p.BlockHash,
+		Transactions:  txs,
+	}
+}
+
+func payloadCapellaJSON(p *v1.ExecutionPayloadCapella) *builder.ExecutionPayloadCapella {
+	txs := make([]hexutil.Bytes, len(p.Transactions))
+	for i := range p.Transactions {
+		txs[i] = p.Transactions[i]
+	}
+	withdrawals := make([]builder.Withdrawal, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawals[i] = builder.Withdrawal{
+			Index:          builder.Uint256{Int: new(big.Int).SetUint64(w.Index)},
+			ValidatorIndex: builder.Uint256{Int: new(big.Int).SetUint64(uint64(w.ValidatorIndex))},
+			Address:        w.Address,
+			Amount:         builder.Uint256{Int: new(big.Int).SetUint64(w.Amount)},
+		}
+	}
+	return &builder.ExecutionPayloadCapella{
+		ParentHash:    p.ParentHash,
+		FeeRecipient:  p.FeeRecipient,
+		StateRoot:     p.StateRoot,
+		ReceiptsRoot:  p.ReceiptsRoot,
+		LogsBloom:     p.LogsBloom,
+		PrevRandao:    p.PrevRandao,
+		BlockNumber:   builder.Uint64String(p.BlockNumber),
+		GasLimit:      builder.Uint64String(p.GasLimit),
+		GasUsed:       builder.Uint64String(p.GasUsed),
+		Timestamp:     builder.Uint64String(p.Timestamp),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: builder.Uint256{Int: bytesutil.LittleEndianBytesToBigInt(p.BaseFeePerGas)},
+		BlockHash:     p.BlockHash,
+		Transactions:  txs,
+		Withdrawals:   withdrawals,
+	}
+}
+
+func writeSSZ(w http.ResponseWriter, v int, m interface{ MarshalSSZ() ([]byte, error) }) {
+	b, err := m.MarshalSSZ()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", sszMediaType)
+	w.Header().Set("Eth-Consensus-Version", version.String(v))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+func writeJSON(w http.ResponseWriter, v int, data interface{}) {
+	b, err := json.Marshal(struct {
+		Version string      `json:"version"`
+		Data    interface{} `json:"data"`
+	}{Version: version.String(v), Data: data})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.Header().Set("Eth-Consensus-Version", version.String(v))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	b, err := json.Marshal(&builder.ErrorMessage{Code: code, Message: msg})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
@@ -45,6 +45,8 @@ type ForkchoiceFetcher interface {
 	ReceivedBlocksLastEpoch() (uint64, error)
 	InsertNode(context.Context, state.BeaconState, [32]byte) error
 	ForkChoiceDump(context.Context) (*ethpbv1.ForkChoiceDump, error)
+	ForkChoiceGraph(ctx context.Context, includeVotes bool) (*ethpb.ForkChoiceGraph, error)
+	ReorgDecisions() *ethpb.ReorgDecisions
 	NewSlot(context.Context, primitives.Slot) error
 	ProposerBoost() [32]byte
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 // CachedHeadRoot returns the corresponding value from Forkchoice
@@ -57,6 +58,20 @@ func (s *Service) ForkChoiceDump(ctx context.Context) (*ethpbv1.ForkChoiceDump,
 	return s.cfg.ForkChoiceStore.ForkChoiceDump(ctx)
 }
 
+// ForkChoiceGraph returns the corresponding value from forkchoice
+func (s *Service) ForkChoiceGraph(ctx context.Context, includeVotes bool) (*ethpb.ForkChoiceGraph, error) {
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.cfg.ForkChoiceStore.ForkChoiceGraph(ctx, includeVotes)
+}
+
+// ReorgDecisions returns the corresponding value from forkchoice
+func (s *Service) ReorgDecisions() *ethpb.ReorgDecisions {
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.cfg.ForkChoiceStore.ReorgDecisions()
+}
+
 // NewSlot returns the corresponding value from forkchoice
 func (s *Service) NewSlot(ctx context.Context, slot primitives.Slot) error {
 	s.cfg.ForkChoiceStore.Lock()
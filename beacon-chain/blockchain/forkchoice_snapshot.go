@@ -0,0 +1,82 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// saveForkChoiceSnapshot saves the fork choice store in the DB, so that the next start of the node
+// can restore it instead of rebuilding it from the finalized checkpoint. Saves are serialized so that
+// a snapshot is never overwritten by an older one.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	s.fcSnapshotLock.Lock()
+	defer s.fcSnapshotLock.Unlock()
+	s.cfg.ForkChoiceStore.RLock()
+	if s.cfg.ForkChoiceStore.NodeCount() == 0 {
+		s.cfg.ForkChoiceStore.RUnlock()
+		return nil
+	}
+	snapshot, err := s.cfg.ForkChoiceStore.Snapshot()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot fork choice store")
+	}
+	return s.cfg.BeaconDB.SaveForkChoiceSnapshot(ctx, snapshot)
+}
+
+// loadForkChoiceSnapshot restores the fork choice store from the snapshot saved in the DB by a previous
+// run of the node. The snapshot is only restored if it agrees with the finalized checkpoint and the blocks
+// in the DB, otherwise the fork choice store is left unchanged.
+// The caller of this function must hold a lock in forkchoice.
+func (s *Service) loadForkChoiceSnapshot(ctx context.Context, finalized *ethpb.Checkpoint) error {
+	snapshot, err := s.cfg.BeaconDB.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.verifyForkChoiceSnapshot(ctx, snapshot, finalized); err != nil {
+		return errors.Wrap(err, "fork choice snapshot is inconsistent with the database")
+	}
+	return s.cfg.ForkChoiceStore.LoadSnapshot(ctx, snapshot)
+}
+
+// verifyForkChoiceSnapshot checks that the snapshot is rooted at the finalized checkpoint and that
+// every node of the snapshot is a block of the DB, with the same slot and parent.
+func (s *Service) verifyForkChoiceSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot, finalized *ethpb.Checkpoint) error {
+	fc := snapshot.FinalizedCheckpoint
+	if fc == nil || fc.Epoch != finalized.Epoch || !bytes.Equal(fc.Root, finalized.Root) {
+		return errors.Errorf("finalized checkpoint of the snapshot is not the finalized checkpoint at epoch %d", finalized.Epoch)
+	}
+	if len(snapshot.Nodes) == 0 {
+		return errors.New("snapshot has no nodes")
+	}
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
+	if bytesutil.ToBytes32(snapshot.Nodes[0].Root) != fRoot {
+		return errors.Errorf("snapshot is not rooted at the finalized block %#x", fRoot)
+	}
+	for _, n := range snapshot.Nodes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		root := bytesutil.ToBytes32(n.Root)
+		blk, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if err := blocks.BeaconBlockIsNil(blk); err != nil {
+			return errors.Wrapf(err, "block %#x is not in the database", root)
+		}
+		if blk.Block().Slot() != n.Slot {
+			return errors.Errorf("block %#x is at slot %d in the database, not %d", root, blk.Block().Slot(), n.Slot)
+		}
+		parentRoot := blk.Block().ParentRoot()
+		if len(n.ParentRoot) > 0 && !bytes.Equal(n.ParentRoot, parentRoot[:]) {
+			return errors.Errorf("block %#x has parent %#x in the database, not %#x", root, parentRoot, n.ParentRoot)
+		}
+	}
+	return nil
+}
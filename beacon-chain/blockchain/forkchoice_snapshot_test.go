@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_ForkChoiceSnapshot(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableForkChoicePersistence: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	genesisState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]}))
+
+	child := util.NewBeaconBlock()
+	child.Block.Slot = 1
+	child.Block.ParentRoot = genesisRoot[:]
+	childRoot, err := child.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, child)
+	childState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, childState.SetSlot(1))
+	require.NoError(t, childState.SetLatestBlockHeader(util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{
+		Slot:       1,
+		ParentRoot: genesisRoot[:],
+	})))
+
+	newService := func() *Service {
+		fc := doublylinkedtree.New()
+		attSrv, err := attestations.NewService(ctx, &attestations.Config{})
+		require.NoError(t, err)
+		c, err := NewService(ctx,
+			WithForkChoiceStore(fc),
+			WithDatabase(beaconDB),
+			WithStateGen(stategen.New(beaconDB, fc)),
+			WithAttestationService(attSrv),
+			WithStateNotifier(&mock.MockStateNotifier{}),
+			WithFinalizedStateAtStartUp(genesisState))
+		require.NoError(t, err)
+		return c
+	}
+
+	// Nothing is restored on the first start, and the store is saved when the service stops.
+	c := newService()
+	require.NoError(t, c.StartFromSavedState(genesisState))
+	require.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	c.cfg.ForkChoiceStore.Lock()
+	require.NoError(t, c.cfg.ForkChoiceStore.InsertNode(ctx, childState, childRoot))
+	c.cfg.ForkChoiceStore.Unlock()
+	require.NoError(t, c.Stop())
+
+	c = newService()
+	require.NoError(t, c.StartFromSavedState(genesisState))
+	require.Equal(t, 2, c.cfg.ForkChoiceStore.NodeCount())
+	require.Equal(t, true, c.cfg.ForkChoiceStore.HasNode(childRoot))
+
+	// A snapshot that does not agree with the blocks in the database is not restored.
+	snapshot, err := beaconDB.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	snapshot.Nodes[1].Slot = 2
+	require.NoError(t, beaconDB.SaveForkChoiceSnapshot(ctx, snapshot))
+	hook := logTest.NewGlobal()
+	c = newService()
+	require.NoError(t, c.StartFromSavedState(genesisState))
+	require.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	require.LogsContain(t, hook, "is at slot 1 in the database, not 2")
+}
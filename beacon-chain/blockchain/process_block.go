@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
@@ -164,10 +165,10 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.ReadOnlySignedB
 		}
 	}
 
-	// If slasher is configured, forward the attestations in the block via
+	// If slasher or the slasher feed is configured, forward the attestations in the block via
 	// an event feed for processing.
-	if features.Get().EnableSlasher {
-		// Feed the indexed attestation to slasher if enabled. This action
+	if features.Get().EnableSlasher || features.Get().EnableSlasherFeed {
+		// Feed the indexed attestation to slasher or its feed if enabled. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
 			// Using a different context to prevent timeouts as this operation can be expensive
@@ -315,8 +316,27 @@ func getStateVersionAndPayload(st state.BeaconState) (int, interfaces.ExecutionD
 	return preStateVersion, preStateHeader, nil
 }
 
+// withoutProposerSignatures removes the proposer signatures and randao reveals from the signature batch.
+// The batch is returned as is if its signatures are not all described.
+func withoutProposerSignatures(set *bls.SignatureBatch) *bls.SignatureBatch {
+	if len(set.Descriptions) != len(set.Signatures) {
+		return set
+	}
+	filtered := bls.NewSet()
+	for i, desc := range set.Descriptions {
+		if desc == signing.BlockSignature || desc == signing.RandaoSignature {
+			continue
+		}
+		filtered.Signatures = append(filtered.Signatures, set.Signatures[i])
+		filtered.PublicKeys = append(filtered.PublicKeys, set.PublicKeys[i])
+		filtered.Messages = append(filtered.Messages, set.Messages[i])
+		filtered.Descriptions = append(filtered.Descriptions, desc)
+	}
+	return filtered
+}
+
 func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock,
-	blockRoots [][32]byte) error {
+	blockRoots [][32]byte, proposerSigsVerified bool) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.onBlockBatch")
 	defer span.End()
 
@@ -393,6 +413,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.ReadOnlySi
 		sigSet.Join(set)
 	}
 
+	if proposerSigsVerified {
+		sigSet = withoutProposerSignatures(sigSet)
+	}
 	var verify bool
 	if features.Get().EnableVerboseSigVerification {
 		verify, err = sigSet.VerifyVerbosely()
@@ -184,9 +184,9 @@ func TestStore_OnBlockBatch(t *testing.T) {
 		blks = append(blks, wsb)
 		blkRoots = append(blkRoots, root)
 	}
-	err = service.onBlockBatch(ctx, blks, blkRoots[1:])
+	err = service.onBlockBatch(ctx, blks, blkRoots[1:], false)
 	require.ErrorIs(t, errWrongBlockCount, err)
-	err = service.onBlockBatch(ctx, blks, blkRoots)
+	err = service.onBlockBatch(ctx, blks, blkRoots, false)
 	require.NoError(t, err)
 	jcp := service.CurrentJustifiedCheckpt()
 	jroot := bytesutil.ToBytes32(jcp.Root)
@@ -226,10 +226,70 @@ func TestStore_OnBlockBatch_NotifyNewPayload(t *testing.T) {
 		blks = append(blks, wsb)
 		blkRoots = append(blkRoots, root)
 	}
-	err = service.onBlockBatch(ctx, blks, blkRoots)
+	err = service.onBlockBatch(ctx, blks, blkRoots, false)
 	require.NoError(t, err)
 }
 
+func TestStore_OnBlockBatch_ProposerSigsVerified(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	fc := doublylinkedtree.New()
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fc)),
+		WithForkChoiceStore(fc),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	st, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, st))
+	bState := st.Copy()
+
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var blkRoots [][32]byte
+	for i := 1; i <= 4; i++ {
+		b, err := util.GenerateFullBlock(bState, keys, util.DefaultBlockGenConfig(), primitives.Slot(i))
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		bState, err = transition.ExecuteStateTransition(ctx, bState, wsb)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if i == 4 {
+			// The signature is not part of the block root.
+			b.Signature = keys[0].Sign(root[:]).Marshal()
+			wsb, err = consensusblocks.NewSignedBeaconBlock(b)
+			require.NoError(t, err)
+		}
+		blks = append(blks, wsb)
+		blkRoots = append(blkRoots, root)
+	}
+	require.ErrorContains(t, "batch block signature verification failed", service.onBlockBatch(ctx, blks, blkRoots, false))
+	// The proposer signatures are not verified again when the caller did.
+	require.NoError(t, service.onBlockBatch(ctx, blks, blkRoots, true))
+}
+
+func TestWithoutProposerSignatures(t *testing.T) {
+	set := bls.NewSet()
+	for _, desc := range []string{signing.BlockSignature, signing.RandaoSignature, signing.AttestationSignature} {
+		set.Join(&bls.SignatureBatch{
+			Signatures:   [][]byte{[]byte(desc)},
+			PublicKeys:   []bls.PublicKey{nil},
+			Messages:     [][32]byte{{}},
+			Descriptions: []string{desc},
+		})
+	}
+	filtered := withoutProposerSignatures(set)
+	assert.DeepEqual(t, []string{signing.AttestationSignature}, filtered.Descriptions)
+	assert.DeepEqual(t, [][]byte{[]byte(signing.AttestationSignature)}, filtered.Signatures)
+
+	// Batches with undescribed signatures are kept as is.
+	set.Descriptions = set.Descriptions[1:]
+	assert.Equal(t, 3, len(withoutProposerSignatures(set).Signatures))
+}
+
 func TestCachedPreState_CanGetFromStateSummary(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -2070,7 +2130,7 @@ func TestNoViableHead_Reboot(t *testing.T) {
 	root, err = b.Block.HashTreeRoot()
 	require.NoError(t, err)
 	// We use onBlockBatch here because the valid chain is missing in forkchoice
-	require.NoError(t, service.onBlockBatch(ctx, []interfaces.ReadOnlySignedBeaconBlock{wsb}, [][32]byte{root}))
+	require.NoError(t, service.onBlockBatch(ctx, []interfaces.ReadOnlySignedBeaconBlock{wsb}, [][32]byte{root}, false))
 	// Check that the head is now VALID and the node is not optimistic
 	require.Equal(t, genesisRoot, service.ensureRootNotZeros(service.cfg.ForkChoiceStore.CachedHeadRoot()))
 	headRoot, err = service.HeadRoot(ctx)
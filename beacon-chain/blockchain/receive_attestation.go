@@ -109,6 +109,14 @@ func (s *Service) spawnProcessAttestationsRoutine(stateFeed *event.Feed) {
 				s.cfg.ForkChoiceStore.Unlock()
 
 				s.UpdateHead(s.ctx, s.CurrentSlot())
+
+				if features.Get().EnableForkChoicePersistence && slots.IsEpochStart(s.CurrentSlot()) {
+					go func() {
+						if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+							log.WithError(err).Error("Could not save fork choice snapshot")
+						}
+					}()
+				}
 			}
 		}
 	}()
@@ -25,6 +25,7 @@ var epochsSinceFinalitySaveHotStateDB = primitives.Epoch(100)
 type BlockReceiver interface {
 	ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte) error
 	ReceiveBlockBatch(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, blkRoots [][32]byte) error
+	ReceiveVerifiedBlockBatch(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, blkRoots [][32]byte) error
 	HasBlock(ctx context.Context, root [32]byte) bool
 }
 
@@ -93,14 +94,26 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 // the state, performing batch verification of all collected signatures and then performing the appropriate
 // actions for a block post-transition.
 func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, blkRoots [][32]byte) error {
+	return s.receiveBlockBatch(ctx, blocks, blkRoots, false /* proposerSigsVerified */)
+}
+
+// ReceiveVerifiedBlockBatch processes a block batch like ReceiveBlockBatch, for a batch whose proposer
+// signatures and randao reveals have already been verified by the caller. Only the other signatures
+// collected during the state transition are verified.
+func (s *Service) ReceiveVerifiedBlockBatch(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, blkRoots [][32]byte) error {
+	return s.receiveBlockBatch(ctx, blocks, blkRoots, true /* proposerSigsVerified */)
+}
+
+func (s *Service) receiveBlockBatch(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, blkRoots [][32]byte, proposerSigsVerified bool) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
+	span.AddAttributes(trace.BoolAttribute("proposerSigsVerified", proposerSigsVerified))
 
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
 
 	// Apply state transition on the incoming newly received block batches, one by one.
-	if err := s.onBlockBatch(ctx, blocks, blkRoots); err != nil {
+	if err := s.onBlockBatch(ctx, blocks, blkRoots, proposerSigsVerified); err != nil {
 		err := errors.Wrap(err, "could not process block in batch")
 		tracing.AnnotateError(span, err)
 		return err
@@ -57,6 +57,7 @@ type Service struct {
 	initSyncBlocks        map[[32]byte]interfaces.ReadOnlySignedBeaconBlock
 	initSyncBlocksLock    sync.RWMutex
 	wsVerifier            *WeakSubjectivityVerifier
+	fcSnapshotLock        sync.Mutex
 }
 
 // config options for the service.
@@ -142,7 +143,14 @@ func (s *Service) Stop() error {
 		s.headLock.RUnlock()
 	}
 	// Save initial sync cached blocks to the DB before stop.
-	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	if err := s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks()); err != nil {
+		return err
+	}
+	// Save the fork choice store after the blocks, so that all of its nodes are in the DB when it is restored.
+	if features.Get().EnableForkChoicePersistence {
+		return s.saveForkChoiceSnapshot(s.ctx)
+	}
+	return nil
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -198,10 +206,53 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		return errNilFinalizedCheckpoint
 	}
 
-	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
-	if err := s.cfg.ForkChoiceStore.UpdateJustifiedCheckpoint(s.ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
+	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.genesisTime.Unix()))
+	restored := false
+	// Every block is treated as optimistic when starting optimistic, so the
+	// validation status saved in the fork choice snapshot is not used.
+	if features.Get().EnableForkChoicePersistence && !features.Get().EnableStartOptimistic {
+		err := s.loadForkChoiceSnapshot(s.ctx, finalized)
+		switch {
+		case err == nil:
+			log.WithField("nodes", s.cfg.ForkChoiceStore.NodeCount()).Info("Restored fork choice store from the database")
+			restored = true
+		case errors.Is(err, db.ErrNotFound):
+			// No snapshot was saved by the previous run of the node.
+		default:
+			log.WithError(err).Warn("Could not restore fork choice store from the database, rebuilding it from the finalized checkpoint")
+		}
+	}
+	if !restored {
+		if err := s.insertFinalizedToForkChoice(s.ctx, justified, finalized); err != nil {
+			return err
+		}
+	}
+	// not attempting to save initial sync blocks here, because there shouldn't be any until
+	// after the statefeed.Initialized event is fired (below)
+	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
+		// Exit run time if the node failed to verify weak subjectivity checkpoint.
+		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
+	}
+
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             s.genesisTime,
+			GenesisValidatorsRoot: saved.GenesisValidatorsRoot(),
+		},
+	})
+
+	return nil
+}
+
+// insertFinalizedToForkChoice initializes the fork choice store with the justified and finalized checkpoints
+// and the finalized block.
+// The caller of this function must hold a lock in forkchoice.
+func (s *Service) insertFinalizedToForkChoice(ctx context.Context, justified, finalized *ethpb.Checkpoint) error {
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
+	if err := s.cfg.ForkChoiceStore.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
 		Root: bytesutil.ToBytes32(justified.Root)}); err != nil {
 		return errors.Wrap(err, "could not update forkchoice's justified checkpoint")
 	}
@@ -209,41 +260,25 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		Root: bytesutil.ToBytes32(finalized.Root)}); err != nil {
 		return errors.Wrap(err, "could not update forkchoice's finalized checkpoint")
 	}
-	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.genesisTime.Unix()))
 
-	st, err := s.cfg.StateGen.StateByRoot(s.ctx, fRoot)
+	st, err := s.cfg.StateGen.StateByRoot(ctx, fRoot)
 	if err != nil {
 		return errors.Wrap(err, "could not get finalized checkpoint state")
 	}
-	if err := s.cfg.ForkChoiceStore.InsertNode(s.ctx, st, fRoot); err != nil {
+	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, st, fRoot); err != nil {
 		return errors.Wrap(err, "could not insert finalized block to forkchoice")
 	}
 	if !features.Get().EnableStartOptimistic {
-		lastValidatedCheckpoint, err := s.cfg.BeaconDB.LastValidatedCheckpoint(s.ctx)
+		lastValidatedCheckpoint, err := s.cfg.BeaconDB.LastValidatedCheckpoint(ctx)
 		if err != nil {
 			return errors.Wrap(err, "could not get last validated checkpoint")
 		}
 		if bytes.Equal(finalized.Root, lastValidatedCheckpoint.Root) {
-			if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(s.ctx, fRoot); err != nil {
+			if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, fRoot); err != nil {
 				return errors.Wrap(err, "could not set finalized block as validated")
 			}
 		}
 	}
-	// not attempting to save initial sync blocks here, because there shouldn't be any until
-	// after the statefeed.Initialized event is fired (below)
-	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
-		// Exit run time if the node failed to verify weak subjectivity checkpoint.
-		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
-	}
-
-	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
-		Type: statefeed.Initialized,
-		Data: &statefeed.InitializedData{
-			StartTime:             s.genesisTime,
-			GenesisValidatorsRoot: saved.GenesisValidatorsRoot(),
-		},
-	})
-
 	return nil
 }
 
@@ -202,6 +202,11 @@ func (s *ChainService) ReceiveBlockInitialSync(ctx context.Context, block interf
 	return nil
 }
 
+// ReceiveVerifiedBlockBatch processes blocks in batches from initial-sync, whose proposer signatures are verified.
+func (s *ChainService) ReceiveVerifiedBlockBatch(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, roots [][32]byte) error {
+	return s.ReceiveBlockBatch(ctx, blks, roots)
+}
+
 // ReceiveBlockBatch processes blocks in batches from initial-sync.
 func (s *ChainService) ReceiveBlockBatch(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, _ [][32]byte) error {
 	if s.State == nil {
@@ -572,6 +577,22 @@ func (s *ChainService) ForkChoiceDump(ctx context.Context) (*ethpbv1.ForkChoiceD
 	return nil, nil
 }
 
+// ForkChoiceGraph mocks the same method in the chain service
+func (s *ChainService) ForkChoiceGraph(ctx context.Context, includeVotes bool) (*ethpb.ForkChoiceGraph, error) {
+	if s.ForkChoiceStore != nil {
+		return s.ForkChoiceStore.ForkChoiceGraph(ctx, includeVotes)
+	}
+	return nil, nil
+}
+
+// ReorgDecisions mocks the same method in the chain service
+func (s *ChainService) ReorgDecisions() *ethpb.ReorgDecisions {
+	if s.ForkChoiceStore != nil {
+		return s.ForkChoiceStore.ReorgDecisions()
+	}
+	return nil
+}
+
 // NewSlot mocks the same method in the chain service
 func (s *ChainService) NewSlot(ctx context.Context, slot primitives.Slot) error {
 	if s.ForkChoiceStore != nil {
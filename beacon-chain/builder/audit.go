@@ -0,0 +1,78 @@
+package builder
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// relayBidRecord builds the audit record of a relay response to a header request.
+func relayBidRecord(r builder.BuilderClient, b *relayBid, latency time.Duration, err error) *ethpb.RelayBidRecord {
+	record := &ethpb.RelayBidRecord{
+		Relay:     relayLabel(r),
+		LatencyMs: uint64(latency.Milliseconds()),
+	}
+	if err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	record.Value = b.value.String()
+	record.BlockHash = b.blockHash[:]
+	return record
+}
+
+// relaySubmissionRecord builds the audit record of a blinded block submission to a relay.
+func relaySubmissionRecord(r builder.BuilderClient, blockHash [32]byte, latency time.Duration, err error) *ethpb.RelaySubmissionRecord {
+	record := &ethpb.RelaySubmissionRecord{
+		Relay:     relayLabel(r),
+		BlockHash: blockHash[:],
+		Delivered: err == nil,
+		LatencyMs: uint64(latency.Milliseconds()),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// updateBidAudit applies the update to the audit record of the slot and persists the record in the background,
+// so the proposal is not delayed by the database write.
+func (s *Service) updateBidAudit(slot primitives.Slot, update func(record *ethpb.BuilderBidRecord)) {
+	s.auditLock.Lock()
+	record, ok := s.audits[slot]
+	if !ok {
+		for sl := range s.audits {
+			if sl+params.BeaconConfig().SlotsPerEpoch < slot {
+				delete(s.audits, sl)
+			}
+		}
+		record = &ethpb.BuilderBidRecord{Slot: slot}
+		s.audits[slot] = record
+	}
+	update(record)
+	s.auditLock.Unlock()
+
+	if s.cfg.beaconDB == nil {
+		return
+	}
+	go func() {
+		// Saves are serialized, and each one copies the record as it is when saving, so that the latest version of
+		// the record is always the last one written. The record itself is only locked while copying it.
+		s.saveLock.Lock()
+		defer s.saveLock.Unlock()
+		s.auditLock.Lock()
+		saved, ok := proto.Clone(record).(*ethpb.BuilderBidRecord)
+		s.auditLock.Unlock()
+		if !ok {
+			return
+		}
+		if err := s.cfg.beaconDB.SaveBuilderBidRecord(s.ctx, saved); err != nil {
+			log.WithError(err).WithField("slot", slot).Error("Could not save builder bid record")
+		}
+	}()
+}
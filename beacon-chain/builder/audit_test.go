@@ -0,0 +1,87 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	dbtesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestService_BidAudit(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	blockHash := bytesutil.PadTo([]byte{'h'}, fieldparams.RootLength)
+	bid, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 3, parentHash, blockHash))
+	require.NoError(t, err)
+
+	s, err := NewService(ctx,
+		WithDatabase(db),
+		WithBuilderClient(newTestRelay("http://good:1", bid, nil)),
+		WithBuilderClient(newTestRelay("http://err:1", nil, errors.New("bad"))),
+	)
+	require.NoError(t, err)
+	pubkey := [48]byte{'k'}
+	_, err = s.GetHeader(ctx, 5, bytesutil.ToBytes32(parentHash), pubkey)
+	require.NoError(t, err)
+	// The local payload may still be used instead of the best bid.
+	s.auditLock.Lock()
+	assert.Equal(t, false, s.audits[5].Bids[0].Chosen)
+	s.auditLock.Unlock()
+
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.Slot = 5
+	blk.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+
+	// Records are saved in the background.
+	var record *ethpb.BuilderBidRecord
+	require.NoError(t, waitFor(func() bool {
+		records, err := db.BuilderBidRecords(ctx, 5, 5)
+		require.NoError(t, err)
+		if len(records) == 1 && len(records[0].Submissions) == 1 {
+			record = records[0]
+			return true
+		}
+		return false
+	}))
+	assert.DeepEqual(t, parentHash, record.ParentHash)
+	assert.DeepEqual(t, pubkey[:], record.ProposerPubkey)
+	require.Equal(t, 2, len(record.Bids))
+	assert.Equal(t, "good:1", record.Bids[0].Relay)
+	assert.Equal(t, "3", record.Bids[0].Value)
+	assert.DeepEqual(t, blockHash, record.Bids[0].BlockHash)
+	assert.Equal(t, true, record.Bids[0].Chosen)
+	assert.Equal(t, "err:1", record.Bids[1].Relay)
+	assert.Equal(t, "bad", record.Bids[1].Error)
+	assert.Equal(t, false, record.Bids[1].Chosen)
+	assert.Equal(t, "good:1", record.Submissions[0].Relay)
+	assert.Equal(t, true, record.Submissions[0].Delivered)
+	assert.DeepEqual(t, blockHash, record.Submissions[0].BlockHash)
+}
+
+func waitFor(f func() bool) error {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("timed out")
+}
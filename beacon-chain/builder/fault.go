@@ -0,0 +1,165 @@
+package builder
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+// Fault is a kind of relay misbehaviour, counted towards quarantining the relay.
+type Fault string
+
+const (
+	// FaultFailedUnblind is recorded when a relay does not return the payload of a signed blinded block.
+	// It quarantines the relay straight away, as it costs the proposer its slot.
+	FaultFailedUnblind Fault = "failed_unblind"
+	// FaultInvalidBid is recorded when a relay bid fails verification.
+	FaultInvalidBid Fault = "invalid_bid"
+	// FaultWithdrawalsMismatch is recorded when a relay header commits to withdrawals other than the expected ones.
+	FaultWithdrawalsMismatch Fault = "withdrawals_mismatch"
+	// FaultTimeout is recorded when a relay does not answer a header request within the relay timeout.
+	FaultTimeout Fault = "timeout"
+)
+
+const (
+	// defaultQuarantineEpochs is the number of epochs a faulty relay is quarantined for.
+	defaultQuarantineEpochs = 2
+	// defaultFaultThreshold is the number of slots with faults within an epoch which quarantines a relay.
+	defaultFaultThreshold = 3
+)
+
+// relayHealth is the fault tracking state of a relay.
+type relayHealth struct {
+	recent        []primitives.Slot // slots with faults, at most once each.
+	counts        map[Fault]uint64
+	quarantines   uint64
+	releaseEpoch  primitives.Epoch
+	lastFault     Fault
+	lastFaultSlot primitives.Slot
+}
+
+// RelayStatusFetcher retrieves the fault tracking state of the relays.
+type RelayStatusFetcher interface {
+	RelayStatuses(slot primitives.Slot) []*ethpb.BuilderRelay
+}
+
+// ReportFault records a fault against the relays which offered the header with the given block hash.
+func (s *Service) ReportFault(slot primitives.Slot, blockHash [32]byte, fault Fault) {
+	s.winnersLock.RLock()
+	w, ok := s.winners[blockHash]
+	s.winnersLock.RUnlock()
+	if !ok {
+		log.WithField("blockHash", blockHash).Debug("Could not attribute fault to a relay, unknown header")
+		return
+	}
+	for _, r := range w.relays {
+		s.recordFault(r, slot, fault)
+	}
+}
+
+// recordFault records a fault against the relay, and quarantines it once it collected faults in enough slots within an
+// epoch. Headers are requested several times per slot when bid timing is enabled, so only the first fault of the relay
+// within a slot is recorded, except for a failed unblind which always quarantines the relay.
+func (s *Service) recordFault(r builder.BuilderClient, slot primitives.Slot, fault Fault) {
+	label := relayLabel(r)
+
+	s.healthLock.Lock()
+	defer s.healthLock.Unlock()
+	h := s.relayHealthLocked(label)
+	if h.lastFault != "" && h.lastFaultSlot == slot && fault != FaultFailedUnblind {
+		return
+	}
+	relayFaultCount.WithLabelValues(label, string(fault)).Inc()
+	h.counts[fault]++
+	h.lastFault = fault
+	h.lastFaultSlot = slot
+	recent := h.recent[:0]
+	for _, sl := range h.recent {
+		if sl+params.BeaconConfig().SlotsPerEpoch > slot {
+			recent = append(recent, sl)
+		}
+	}
+	if len(recent) == 0 || recent[len(recent)-1] != slot {
+		recent = append(recent, slot)
+	}
+	h.recent = recent
+
+	if s.cfg.quarantineEpochs == 0 {
+		return
+	}
+	if fault != FaultFailedUnblind && uint64(len(h.recent)) < s.cfg.faultThreshold {
+		return
+	}
+	h.recent = nil
+	h.quarantines++
+	h.releaseEpoch = slots.ToEpoch(slot) + s.cfg.quarantineEpochs + 1
+	relayQuarantineCount.WithLabelValues(label).Inc()
+	log.WithFields(log.Fields{
+		"relay":        label,
+		"fault":        fault,
+		"slot":         slot,
+		"releaseEpoch": h.releaseEpoch,
+	}).Warn("Quarantining faulty relay")
+}
+
+// relayHealthLocked returns the fault tracking state of the relay, creating it if needed. The caller must hold the
+// health lock for writing.
+func (s *Service) relayHealthLocked(label string) *relayHealth {
+	h, ok := s.health[label]
+	if !ok {
+		h = &relayHealth{counts: make(map[Fault]uint64)}
+		s.health[label] = h
+	}
+	return h
+}
+
+// quarantined returns true if the relay is quarantined at the slot.
+func (s *Service) quarantined(r builder.BuilderClient, slot primitives.Slot) bool {
+	s.healthLock.RLock()
+	defer s.healthLock.RUnlock()
+	h, ok := s.health[relayLabel(r)]
+	return ok && slots.ToEpoch(slot) < h.releaseEpoch
+}
+
+// activeRelays filters out the relays quarantined at the slot.
+func (s *Service) activeRelays(relays []builder.BuilderClient, slot primitives.Slot) []builder.BuilderClient {
+	active := make([]builder.BuilderClient, 0, len(relays))
+	for _, r := range relays {
+		if !s.quarantined(r, slot) {
+			active = append(active, r)
+		}
+	}
+	return active
+}
+
+// AllRelaysQuarantined returns true if relays are configured and every one of them is quarantined at the slot.
+func (s *Service) AllRelaysQuarantined(slot primitives.Slot) bool {
+	return len(s.relays) > 0 && len(s.activeRelays(s.relays, slot)) == 0
+}
+
+// RelayStatuses returns the fault tracking state of every configured relay at the slot.
+func (s *Service) RelayStatuses(slot primitives.Slot) []*ethpb.BuilderRelay {
+	s.healthLock.RLock()
+	defer s.healthLock.RUnlock()
+	statuses := make([]*ethpb.BuilderRelay, len(s.relays))
+	for i, r := range s.relays {
+		label := relayLabel(r)
+		status := &ethpb.BuilderRelay{Relay: label}
+		if h, ok := s.health[label]; ok {
+			status.Quarantined = slots.ToEpoch(slot) < h.releaseEpoch
+			status.ReleaseEpoch = h.releaseEpoch
+			status.Quarantines = h.quarantines
+			status.FailedUnblinds = h.counts[FaultFailedUnblind]
+			status.InvalidBids = h.counts[FaultInvalidBid]
+			status.WithdrawalsMismatches = h.counts[FaultWithdrawalsMismatch]
+			status.Timeouts = h.counts[FaultTimeout]
+			status.LastFault = string(h.lastFault)
+			status.LastFaultSlot = h.lastFaultSlot
+		}
+		statuses[i] = status
+	}
+	return statuses
+}
@@ -0,0 +1,147 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestService_RecordFault_Threshold(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRelay("http://faulty:1", nil, nil)
+	s, err := NewService(ctx, WithBuilderClient(r), WithRelayQuarantine(2, 3))
+	require.NoError(t, err)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	// Faults spread over more than an epoch do not add up.
+	s.recordFault(r, 0, FaultTimeout)
+	s.recordFault(r, 1, FaultInvalidBid)
+	s.recordFault(r, spe+1, FaultTimeout)
+	assert.Equal(t, false, s.quarantined(r, spe+1))
+
+	s.recordFault(r, spe+2, FaultTimeout)
+	assert.Equal(t, false, s.quarantined(r, spe+2))
+	s.recordFault(r, spe+3, FaultTimeout)
+	assert.Equal(t, true, s.quarantined(r, spe+3))
+	assert.Equal(t, true, s.AllRelaysQuarantined(spe+3))
+	// Quarantined for the remainder of epoch 1 and the two epochs after it.
+	releaseSlot := 4 * spe
+	assert.Equal(t, true, s.quarantined(r, releaseSlot-1))
+	assert.Equal(t, false, s.quarantined(r, releaseSlot))
+
+	statuses := s.RelayStatuses(spe + 3)
+	require.Equal(t, 1, len(statuses))
+	st := statuses[0]
+	assert.Equal(t, "faulty:1", st.Relay)
+	assert.Equal(t, true, st.Quarantined)
+	assert.Equal(t, primitives.Epoch(4), st.ReleaseEpoch)
+	assert.Equal(t, uint64(1), st.Quarantines)
+	assert.Equal(t, uint64(4), st.Timeouts)
+	assert.Equal(t, uint64(1), st.InvalidBids)
+	assert.Equal(t, string(FaultTimeout), st.LastFault)
+	assert.Equal(t, spe+3, st.LastFaultSlot)
+	assert.Equal(t, false, s.RelayStatuses(releaseSlot)[0].Quarantined)
+}
+
+func TestService_RecordFault_OncePerSlot(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRelay("http://faulty:1", nil, nil)
+	s, err := NewService(ctx, WithBuilderClient(r), WithRelayQuarantine(2, 2))
+	require.NoError(t, err)
+
+	// A relay timing out on every poll of a slot is faulted once.
+	for i := 0; i < 5; i++ {
+		s.recordFault(r, 1, FaultTimeout)
+	}
+	s.recordFault(r, 1, FaultInvalidBid)
+	assert.Equal(t, false, s.quarantined(r, 1))
+	st := s.RelayStatuses(1)[0]
+	assert.Equal(t, uint64(1), st.Timeouts)
+	assert.Equal(t, uint64(0), st.InvalidBids)
+
+	s.recordFault(r, 2, FaultInvalidBid)
+	assert.Equal(t, true, s.quarantined(r, 2))
+	st = s.RelayStatuses(2)[0]
+	assert.Equal(t, uint64(1), st.InvalidBids)
+	assert.Equal(t, uint64(1), st.Quarantines)
+}
+
+func TestService_RecordFault_FailedUnblind(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRelay("http://faulty:1", nil, nil)
+	s, err := NewService(ctx, WithBuilderClient(r))
+	require.NoError(t, err)
+	s.recordFault(r, 1, FaultFailedUnblind)
+	assert.Equal(t, true, s.quarantined(r, 1))
+
+	s, err = NewService(ctx, WithBuilderClient(r), WithRelayQuarantine(0, 1))
+	require.NoError(t, err)
+	s.recordFault(r, 1, FaultFailedUnblind)
+	assert.Equal(t, false, s.quarantined(r, 1))
+	assert.Equal(t, uint64(1), s.RelayStatuses(1)[0].FailedUnblinds)
+
+	_, err = NewService(ctx, WithRelayQuarantine(1, 0))
+	require.ErrorContains(t, "threshold", err)
+}
+
+func TestService_GetHeader_SkipsQuarantinedRelays(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	lowHash := bytesutil.PadTo([]byte{'l'}, fieldparams.RootLength)
+	highHash := bytesutil.PadTo([]byte{'h'}, fieldparams.RootLength)
+	low, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 1, parentHash, lowHash))
+	require.NoError(t, err)
+	high, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 3, parentHash, highHash))
+	require.NoError(t, err)
+	lowRelay := newTestRelay("http://low:1", low, nil)
+	highRelay := newTestRelay("http://high:1", high, nil)
+	s, err := NewService(ctx, WithBuilderClient(lowRelay), WithBuilderClient(highRelay))
+	require.NoError(t, err)
+
+	got, err := s.GetHeader(ctx, 1, bytesutil.ToBytes32(parentHash), [48]byte{})
+	require.NoError(t, err)
+	bid, err := got.Message()
+	require.NoError(t, err)
+	header, err := bid.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, highHash, header.BlockHash())
+
+	// The proposer found the header withdrawals to be wrong, then the relay failed to unblind.
+	s.ReportFault(1, bytesutil.ToBytes32(highHash), FaultWithdrawalsMismatch)
+	assert.Equal(t, uint64(1), s.RelayStatuses(1)[1].WithdrawalsMismatches)
+	highRelay.err = errors.New("bad")
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.Body.ExecutionPayloadHeader.BlockHash = highHash
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.ErrorContains(t, "bad", err)
+	assert.Equal(t, true, s.quarantined(highRelay, 2))
+	assert.Equal(t, false, s.AllRelaysQuarantined(2))
+
+	got, err = s.GetHeader(ctx, 2, bytesutil.ToBytes32(parentHash), [48]byte{})
+	require.NoError(t, err)
+	bid, err = got.Message()
+	require.NoError(t, err)
+	header, err = bid.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, lowHash, header.BlockHash())
+
+	s.recordFault(lowRelay, 2, FaultFailedUnblind)
+	assert.Equal(t, true, s.AllRelaysQuarantined(2))
+	_, err = s.GetHeader(ctx, 2, bytesutil.ToBytes32(parentHash), [48]byte{})
+	require.ErrorIs(t, err, errRelaysQuarantined)
+}
@@ -27,4 +27,67 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	bidPollingRounds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "builder_bid_polling_rounds",
+			Help:    "The number of get header rounds sent to the relays while polling for bids until the bid cutoff",
+			Buckets: []float64{1, 2, 3, 5, 8, 10, 15, 20, 30},
+		},
+	)
+	relayGetHeaderLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_get_header_latency_milliseconds",
+			Help:    "Captures RPC latency for get header in milliseconds, per relay",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayGetHeaderCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_get_header_total",
+			Help: "The number of get header requests sent to each relay, by result",
+		},
+		[]string{"relay", "result"},
+	)
+	relayBidSelectedCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_bid_selected_total",
+			Help: "The number of times a relay offered the highest value valid bid",
+		},
+		[]string{"relay"},
+	)
+	relaySubmitBlindedBlockCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_submit_blinded_block_total",
+			Help: "The number of blinded blocks submitted to each relay, by result",
+		},
+		[]string{"relay", "result"},
+	)
+	relayFaultCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_fault_total",
+			Help: "The number of faults recorded against each relay, by kind of fault",
+		},
+		[]string{"relay", "fault"},
+	)
+	relayQuarantineCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_quarantine_total",
+			Help: "The number of times each relay was quarantined for faults",
+		},
+		[]string{"relay"},
+	)
+	relayRegisterValidatorCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_register_validator_total",
+			Help: "The number of validator registration requests sent to each relay, by result",
+		},
+		[]string{"relay", "result"},
+	)
+)
+
+const (
+	resultSuccess    = "success"
+	resultError      = "error"
+	resultInvalidBid = "invalid_bid"
 )
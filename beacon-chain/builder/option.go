@@ -1,10 +1,16 @@
 package builder
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/urfave/cli/v2"
 )
 
@@ -12,25 +18,72 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range c.StringSlice(flags.MevRelayEndpoint.Name) {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
+	}
+	if timeout := c.Duration(flags.BuilderRelayTimeout.Name); timeout > 0 {
+		opts = append(opts, WithRelayTimeout(timeout))
+	}
+	if cutoff := c.Duration(flags.BuilderBidCutoff.Name); cutoff > 0 {
+		deadline := c.Duration(flags.BuilderBidDeadline.Name)
+		if deadline < cutoff {
+			return nil, fmt.Errorf("--%s (%s) must not be before --%s (%s)", flags.BuilderBidDeadline.Name, deadline, flags.BuilderBidCutoff.Name, cutoff)
+		}
+		opts = append(opts, WithBidTiming(cutoff, deadline))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
+	if c.IsSet(flags.BuilderRelayQuarantineEpochs.Name) || c.IsSet(flags.BuilderRelayFaultThreshold.Name) {
+		opts = append(opts, WithRelayQuarantine(
+			primitives.Epoch(c.Uint64(flags.BuilderRelayQuarantineEpochs.Name)),
+			c.Uint64(flags.BuilderRelayFaultThreshold.Name),
+		))
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a relay client to the set of relays used by the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithRelayTimeout sets the maximum amount of time a single relay is given to respond to a get header request.
+func WithRelayTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.relayTimeout = timeout
+		return nil
+	}
+}
+
+// WithBidTiming makes the service poll the relays for headers, from the slot start until the cutoff into the slot,
+// returning the best bid seen by then. If no relay offered a valid bid by the cutoff, polling goes on until the
+// deadline into the slot.
+func WithBidTiming(cutoff, deadline time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.bidCutoff = cutoff
+		s.cfg.bidDeadline = deadline
+		return nil
+	}
+}
+
+// WithRelayQuarantine sets the number of epochs a relay is quarantined for once it collected the threshold number of
+// faults within an epoch. Zero epochs disables quarantining, faults still being tracked.
+func WithRelayQuarantine(epochs primitives.Epoch, threshold uint64) Option {
+	return func(s *Service) error {
+		if threshold == 0 {
+			return errors.New("relay fault threshold must be greater than 0")
+		}
+		s.cfg.quarantineEpochs = epochs
+		s.cfg.faultThreshold = threshold
 		return nil
 	}
 }
@@ -43,6 +96,23 @@ func WithHeadFetcher(svc blockchain.HeadFetcher) Option {
 	}
 }
 
+// WithTimeFetcher gets the genesis time from chain service, to time header requests within the slot.
+func WithTimeFetcher(svc blockchain.TimeFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.timeFetcher = svc
+		return nil
+	}
+}
+
+// WithProposerCache gets the validators tracked by the beacon node which propose the upcoming slots, to start polling
+// the relays for their headers at the slot start.
+func WithProposerCache(c *cache.ProposerPayloadIDsCache) Option {
+	return func(s *Service) error {
+		s.cfg.proposerCache = c
+		return nil
+	}
+}
+
 // WithDatabase for head access.
 func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	return func(s *Service) error {
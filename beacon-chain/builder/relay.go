@@ -0,0 +1,142 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+// defaultRelayTimeout is the maximum amount of time a single relay is given to respond to a get header request.
+const defaultRelayTimeout = builder.DefaultRelayTimeout
+
+var (
+	errNoValidBid        = errors.New("no relay returned a valid bid")
+	errInvalidBid        = errors.New("invalid bid")
+	errRelaysQuarantined = errors.New("every allowed relay is quarantined")
+)
+
+// relayBid is a verified bid along with the relay which served it.
+type relayBid struct {
+	relay     builder.BuilderClient
+	bid       builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+}
+
+// relayLabel returns the relay host, used to identify a relay in logs and metrics without leaking its full url.
+func relayLabel(r builder.BuilderClient) string {
+	return relayHost(r.NodeURL())
+}
+
+// relayHost returns the host and port of a relay url, so relays configured with and without a scheme or
+// credentials match each other.
+func relayHost(relayURL string) string {
+	u, err := url.Parse(relayURL)
+	if err != nil || u.Host == "" {
+		return relayURL
+	}
+	return u.Host
+}
+
+// getRelayHeader requests a header from a single relay and verifies the returned bid.
+func getRelayHeader(ctx context.Context, r builder.BuilderClient, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (*relayBid, error) {
+	label := relayLabel(r)
+	start := time.Now()
+	signedBid, err := r.GetHeader(ctx, slot, parentHash, pubKey)
+	relayGetHeaderLatency.WithLabelValues(label).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		relayGetHeaderCount.WithLabelValues(label, resultError).Inc()
+		return nil, err
+	}
+	b, err := verifyRelayBid(signedBid, parentHash)
+	if err != nil {
+		relayGetHeaderCount.WithLabelValues(label, resultInvalidBid).Inc()
+		return nil, errors.Wrapf(errInvalidBid, "%v", err)
+	}
+	relayGetHeaderCount.WithLabelValues(label, resultSuccess).Inc()
+	b.relay = r
+	return b, nil
+}
+
+// verifyRelayBid checks that the bid is well-formed, builds on the requested parent and is signed by the builder.
+func verifyRelayBid(signedBid builder.SignedBid, parentHash [32]byte) (*relayBid, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, errors.New("relay returned nil bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return nil, errors.New("relay returned nil bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if bytesutil.ToBytes32(header.ParentHash()) != parentHash {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	if err := validateBuilderSignature(signedBid); err != nil {
+		return nil, errors.Wrap(err, "could not validate builder signature")
+	}
+	return &relayBid{
+		bid:       signedBid,
+		value:     bytesutil.LittleEndianBytesToBigInt(bid.Value()),
+		blockHash: bytesutil.ToBytes32(header.BlockHash()),
+	}, nil
+}
+
+// bestBid returns the highest value bid, along with every relay that offered the same header.
+// Ties between different headers are broken by relay order.
+func bestBid(bids []*relayBid) (*relayBid, []builder.BuilderClient) {
+	var best *relayBid
+	for _, b := range bids {
+		if b == nil {
+			continue
+		}
+		if best == nil || b.value.Cmp(best.value) > 0 {
+			best = b
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	relays := make([]builder.BuilderClient, 0, 1)
+	for _, b := range bids {
+		if b != nil && b.blockHash == best.blockHash {
+			relays = append(relays, b.relay)
+		}
+	}
+	return best, relays
+}
+
+// Validates builder signature and returns an error if the signature is invalid.
+func validateBuilderSignature(signedBid builder.SignedBid) error {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return err
+	}
+	if signedBid.IsNil() {
+		return errors.New("nil builder bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return errors.New("builder returned nil bid")
+	}
+	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
+}
@@ -0,0 +1,334 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	blockchainTesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	dbtesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// testRelay is a relay client returning canned responses and recording the requests it gets.
+type testRelay struct {
+	url           string
+	bid           builder.SignedBid
+	err           error
+	submitted     chan struct{}
+	headers       int32
+	registrations int32
+}
+
+func newTestRelay(url string, bid builder.SignedBid, err error) *testRelay {
+	return &testRelay{url: url, bid: bid, err: err, submitted: make(chan struct{}, 1)}
+}
+
+func (r *testRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *testRelay) GetHeader(_ context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	atomic.AddInt32(&r.headers, 1)
+	return r.bid, r.err
+}
+
+func (r *testRelay) RegisterValidator(_ context.Context, _ []*ethpb.SignedValidatorRegistrationV1) error {
+	atomic.AddInt32(&r.registrations, 1)
+	return r.err
+}
+
+func (r *testRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
+	r.submitted <- struct{}{}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return blocks.WrappedExecutionPayload(&v1.ExecutionPayload{BlockNumber: 1})
+}
+
+func (*testRelay) Status(_ context.Context) error {
+	return nil
+}
+
+func testSignedBid(t *testing.T, sk bls.SecretKey, value uint64, parentHash, blockHash []byte) *ethpb.SignedBuilderBid {
+	bid := &ethpb.BuilderBid{
+		Header: &v1.ExecutionPayloadHeader{
+			ParentHash:       parentHash,
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        blockHash,
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			BlockNumber:      1,
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo(bytesutil.Bytes8(value), 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	return &ethpb.SignedBuilderBid{
+		Message:   bid,
+		Signature: sk.Sign(sr[:]).Marshal(),
+	}
+}
+
+func Test_validateBuilderSignature(t *testing.T) {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	pbBid := testSignedBid(t, sk, 1, make([]byte, fieldparams.RootLength), make([]byte, fieldparams.RootLength))
+	sBid, err := builder.WrappedSignedBuilderBid(pbBid)
+	require.NoError(t, err)
+	require.NoError(t, validateBuilderSignature(sBid))
+
+	pbBid.Message.Value = make([]byte, 32)
+	sBid, err = builder.WrappedSignedBuilderBid(pbBid)
+	require.NoError(t, err)
+	require.ErrorIs(t, validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
+}
+
+func TestService_GetHeader_BestBid(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	lowHash := bytesutil.PadTo([]byte{'l'}, fieldparams.RootLength)
+	highHash := bytesutil.PadTo([]byte{'h'}, fieldparams.RootLength)
+
+	low, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 1, parentHash, lowHash))
+	require.NoError(t, err)
+	high, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 3, parentHash, highHash))
+	require.NoError(t, err)
+	badSig := testSignedBid(t, sk, 2, parentHash, lowHash)
+	badSig.Message.Value = bytesutil.PadTo([]byte{10}, 32)
+	invalid, err := builder.WrappedSignedBuilderBid(badSig)
+	require.NoError(t, err)
+	wrongParent, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 20, lowHash, lowHash))
+	require.NoError(t, err)
+
+	lowRelay := newTestRelay("http://low:1", low, nil)
+	highRelay := newTestRelay("http://high:1", high, nil)
+	highRelay2 := newTestRelay("http://high:2", high, nil)
+	invalidRelay := newTestRelay("http://invalid:1", invalid, nil)
+	wrongParentRelay := newTestRelay("http://parent:1", wrongParent, nil)
+	errRelay := newTestRelay("http://err:1", nil, errors.New("bad"))
+	s, err := NewService(ctx,
+		WithBuilderClient(lowRelay),
+		WithBuilderClient(invalidRelay),
+		WithBuilderClient(highRelay),
+		WithBuilderClient(wrongParentRelay),
+		WithBuilderClient(errRelay),
+		WithBuilderClient(highRelay2),
+	)
+	require.NoError(t, err)
+
+	got, err := s.GetHeader(ctx, 1, bytesutil.ToBytes32(parentHash), [48]byte{})
+	require.NoError(t, err)
+	bid, err := got.Message()
+	require.NoError(t, err)
+	header, err := bid.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, highHash, header.BlockHash())
+
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.Body.ExecutionPayloadHeader.BlockHash = highHash
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	select {
+	case <-highRelay.submitted:
+	case <-highRelay2.submitted:
+	}
+	assert.Equal(t, 0, len(lowRelay.submitted))
+	assert.Equal(t, 0, len(invalidRelay.submitted))
+	assert.Equal(t, 0, len(errRelay.submitted))
+}
+
+func TestService_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(newTestRelay("http://err:1", nil, errors.New("bad"))),
+		WithBuilderClient(newTestRelay("http://empty:1", nil, nil)),
+	)
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, errNoValidBid)
+}
+
+func TestService_SubmitBlindedBlock_UnknownHeader(t *testing.T) {
+	ctx := context.Background()
+	r1 := newTestRelay("http://one:1", nil, errors.New("bad"))
+	r2 := newTestRelay("http://two:1", nil, nil)
+	s, err := NewService(ctx, WithBuilderClient(r1), WithBuilderClient(r2))
+	require.NoError(t, err)
+
+	sb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockBellatrix())
+	require.NoError(t, err)
+	payload, err := s.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), payload.BlockNumber())
+	<-r1.submitted
+	<-r2.submitted
+
+	// Relays are not faulted for failing to unblind a header they may never have offered.
+	s, err = NewService(ctx, WithBuilderClient(r1))
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.ErrorContains(t, "bad", err)
+	<-r1.submitted
+	assert.Equal(t, false, s.quarantined(r1, sb.Block().Slot()+1))
+}
+
+func TestService_SubmitBlindedBlock_UnknownHeaderAllowedRelays(t *testing.T) {
+	ctx := context.Background()
+	allowed := newTestRelay("https://allowed.relay:443", nil, nil)
+	other := newTestRelay("https://other.relay:443", nil, nil)
+	s, err := NewService(ctx, WithBuilderClient(allowed), WithBuilderClient(other))
+	require.NoError(t, err)
+
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.ProposerIndex = 1
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	// The block is only revealed to the relays allowed by the proposer, even if no relay is known to offer its header.
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{1}, []*ValidatorPreferences{{Relays: []string{"allowed.relay:443"}}}))
+	payload, err := s.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), payload.BlockNumber())
+	<-allowed.submitted
+	assert.Equal(t, 0, len(other.submitted))
+
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{1}, []*ValidatorPreferences{{Relays: []string{"unknown.relay:443"}}}))
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.ErrorIs(t, err, ErrNoBuilder)
+	assert.Equal(t, 0, len(allowed.submitted))
+	assert.Equal(t, 0, len(other.submitted))
+}
+
+func TestService_PreferencesSurviveRestart(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	headFetcher := &blockchainTesting.ChainService{}
+	var pubkey [48]byte
+	idx, ok := headFetcher.HeadPublicKeyToValidatorIndex(pubkey)
+	require.Equal(t, true, ok)
+	s, err := NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher),
+		WithBuilderClient(newTestRelay("https://allowed.relay:443", nil, nil)))
+	require.NoError(t, err)
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx, idx + 1},
+		[]*ValidatorPreferences{{Relays: []string{"allowed.relay:443"}, MinBid: 2}, {MinBid: 1}}))
+	// Resetting preferences deletes the saved ones.
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx + 1}, []*ValidatorPreferences{{}}))
+
+	// A restarted service only contacts the relays the validator allowed before the restart.
+	allowed := newTestRelay("https://allowed.relay:443", nil, errors.New("no bid"))
+	other := newTestRelay("https://other.relay:443", nil, errors.New("no bid"))
+	s, err = NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher),
+		WithBuilderClient(allowed), WithBuilderClient(other))
+	require.NoError(t, err)
+	require.DeepEqual(t, &ValidatorPreferences{Relays: []string{"allowed.relay:443"}, MinBid: 2}, s.Preferences(idx))
+	assert.Equal(t, (*ValidatorPreferences)(nil), s.Preferences(idx+1))
+
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, pubkey)
+	require.ErrorIs(t, err, errNoValidBid)
+	regs := []*ethpb.SignedValidatorRegistrationV1{{Message: &ethpb.ValidatorRegistrationV1{}}}
+	require.ErrorContains(t, "no bid", s.registerWithRelays(ctx, []primitives.ValidatorIndex{idx}, regs))
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.ProposerIndex = idx
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.ErrorContains(t, "no bid", err)
+	<-allowed.submitted
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&allowed.headers))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&allowed.registrations))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&other.headers))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&other.registrations))
+	assert.Equal(t, 0, len(other.submitted))
+}
+
+func TestService_RegisterValidator_AnyRelay(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(newTestRelay("http://one:1", nil, errors.New("bad"))),
+		WithBuilderClient(newTestRelay("http://two:1", nil, nil)),
+	)
+	require.NoError(t, err)
+	idxs := []primitives.ValidatorIndex{1}
+	regs := []*ethpb.SignedValidatorRegistrationV1{{Message: &ethpb.ValidatorRegistrationV1{}}}
+	require.NoError(t, s.registerWithRelays(ctx, idxs, regs))
+
+	s, err = NewService(ctx, WithBuilderClient(newTestRelay("http://one:1", nil, errors.New("bad"))))
+	require.NoError(t, err)
+	require.ErrorContains(t, "bad", s.registerWithRelays(ctx, idxs, regs))
+}
+
+func TestService_AllowedRelays(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	lowHash := bytesutil.PadTo([]byte{'l'}, fieldparams.RootLength)
+	highHash := bytesutil.PadTo([]byte{'h'}, fieldparams.RootLength)
+	low, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 1, parentHash, lowHash))
+	require.NoError(t, err)
+	high, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, 3, parentHash, highHash))
+	require.NoError(t, err)
+
+	var pubkey [48]byte
+	headFetcher := &blockchainTesting.ChainService{}
+	idx, ok := headFetcher.HeadPublicKeyToValidatorIndex(pubkey)
+	require.Equal(t, true, ok)
+	s, err := NewService(ctx,
+		WithHeadFetcher(headFetcher),
+		WithBuilderClient(newTestRelay("https://low.relay:443", low, nil)),
+		WithBuilderClient(newTestRelay("https://high.relay:443", high, nil)),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx}, []*ValidatorPreferences{{Relays: []string{"https://0xabcd@low.relay:443"}}}))
+	got, err := s.GetHeader(ctx, 1, bytesutil.ToBytes32(parentHash), pubkey)
+	require.NoError(t, err)
+	bid, err := got.Message()
+	require.NoError(t, err)
+	header, err := bid.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, lowHash, header.BlockHash())
+
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx}, []*ValidatorPreferences{{Relays: []string{"other.relay:443"}}}))
+	_, err = s.GetHeader(ctx, 1, bytesutil.ToBytes32(parentHash), pubkey)
+	require.ErrorIs(t, err, errNoValidBid)
+
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx}, []*ValidatorPreferences{{MinBid: 1}}))
+	assert.Equal(t, uint64(1), s.Preferences(idx).MinBid)
+	require.NoError(t, s.SetPreferences(ctx, []primitives.ValidatorIndex{idx}, []*ValidatorPreferences{nil}))
+	assert.Equal(t, (*ValidatorPreferences)(nil), s.Preferences(idx))
+	got, err = s.GetHeader(ctx, 1, bytesutil.ToBytes32(parentHash), pubkey)
+	require.NoError(t, err)
+	bid, err = got.Message()
+	require.NoError(t, err)
+	header, err = bid.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, highHash, header.BlockHash())
+}
@@ -1,14 +1,18 @@
 package builder
 
 import (
+	"bytes"
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
@@ -25,22 +29,91 @@ type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error)
 	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
+	SetPreferences(ctx context.Context, idxs []primitives.ValidatorIndex, prefs []*ValidatorPreferences) error
+	Preferences(idx primitives.ValidatorIndex) *ValidatorPreferences
+	HeaderDeadline(slot primitives.Slot) (time.Time, bool)
+	ReportFault(slot primitives.Slot, blockHash [32]byte, fault Fault)
+	AllRelaysQuarantined(slot primitives.Slot) bool
+	RelayStatusFetcher
 	Configured() bool
 }
 
+// ValidatorPreferences are the builder preferences a proposer sends along with its fee recipient.
+type ValidatorPreferences struct {
+	// Relays restricts the relays used on behalf of the validator. An empty list allows every configured relay.
+	Relays []string
+	// MinBid is the minimum builder bid value in gwei the validator accepts. Zero uses the beacon node minimum.
+	MinBid uint64
+}
+
+func (p *ValidatorPreferences) empty() bool {
+	return p == nil || (len(p.Relays) == 0 && p.MinBid == 0)
+}
+
+func (p *ValidatorPreferences) equal(o *ValidatorPreferences) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	if p.MinBid != o.MinBid || len(p.Relays) != len(o.Relays) {
+		return false
+	}
+	for i := range p.Relays {
+		if p.Relays[i] != o.Relays[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ValidatorPreferences) toProto() *ethpb.BuilderPreferences {
+	if p == nil {
+		return nil
+	}
+	return &ethpb.BuilderPreferences{Relays: p.Relays, MinBid: p.MinBid}
+}
+
+func preferencesFromProto(p *ethpb.BuilderPreferences) *ValidatorPreferences {
+	return &ValidatorPreferences{Relays: p.Relays, MinBid: p.MinBid}
+}
+
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients   []builder.BuilderClient
+	relayTimeout     time.Duration
+	bidCutoff        time.Duration
+	bidDeadline      time.Duration
+	bidPollInterval  time.Duration
+	quarantineEpochs primitives.Epoch
+	faultThreshold   uint64
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
+	timeFetcher      blockchain.TimeFetcher
+	proposerCache    *cache.ProposerPayloadIDsCache
+}
+
+// bidWinners are the relays which offered the winning header for a slot.
+type bidWinners struct {
+	slot   primitives.Slot
+	relays []builder.BuilderClient
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
-	cfg    *config
-	c      builder.BuilderClient
-	ctx    context.Context
-	cancel context.CancelFunc
+	cfg         *config
+	relays      []builder.BuilderClient
+	winners     map[[32]byte]*bidWinners
+	winnersLock sync.RWMutex
+	prefs       map[primitives.ValidatorIndex]*ValidatorPreferences
+	prefsLock   sync.RWMutex
+	audits      map[primitives.Slot]*ethpb.BuilderBidRecord
+	auditLock   sync.Mutex
+	saveLock    sync.Mutex
+	health      map[string]*relayHealth
+	healthLock  sync.RWMutex
+	polls       map[primitives.Slot]*headerPoll
+	pollsLock   sync.Mutex
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
 // NewService instantiates a new service.
@@ -49,39 +122,63 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	s := &Service{
 		ctx:    ctx,
 		cancel: cancel,
-		cfg:    &config{},
+		cfg: &config{
+			relayTimeout:     defaultRelayTimeout,
+			bidPollInterval:  defaultBidPollInterval,
+			quarantineEpochs: defaultQuarantineEpochs,
+			faultThreshold:   defaultFaultThreshold,
+		},
+		winners: make(map[[32]byte]*bidWinners),
+		prefs:   make(map[primitives.ValidatorIndex]*ValidatorPreferences),
+		audits:  make(map[primitives.Slot]*ethpb.BuilderBidRecord),
+		health:  make(map[string]*relayHealth),
+		polls:   make(map[primitives.Slot]*headerPoll),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	if err := s.loadPreferences(ctx); err != nil {
+		return nil, err
+	}
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		s.relays = append(s.relays, c)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", relayLabel(c)).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
 // Start initializes the service.
 func (s *Service) Start() {
 	go s.pollRelayerStatus(s.ctx)
+	if s.slotStartPollingEnabled() {
+		go s.pollHeadersAtSlotStart(s.ctx)
+	}
 }
 
 // Stop halts the service.
-func (*Service) Stop() error {
+func (s *Service) Stop() error {
+	s.cancel()
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the relays which offered its header. The first payload
+// returned by any of those relays is used. If the relays which offered the header are unknown, the block
+// is submitted to every relay allowed by the proposer.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -90,10 +187,63 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	h, err := b.Block().Body().Execution()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get execution header")
+	}
+	blockHash := bytesutil.ToBytes32(h.BlockHash())
+	slot := b.Block().Slot()
+	relays, known := s.deliveringRelays(blockHash, b.Block().ProposerIndex())
+	// The builder bid is only used once the proposer submits its blinded block, rather than the local payload.
+	s.updateBidAudit(slot, func(record *ethpb.BuilderBidRecord) {
+		for _, bid := range record.Bids {
+			bid.Chosen = bytes.Equal(bid.BlockHash, blockHash[:])
+		}
+	})
+
+	type result struct {
+		payload interfaces.ExecutionData
+		err     error
+	}
+	results := make(chan result, len(relays))
+	for _, r := range relays {
+		go func(r builder.BuilderClient) {
+			start := time.Now()
+			payload, err := r.SubmitBlindedBlock(ctx, b)
+			submission := relaySubmissionRecord(r, blockHash, time.Since(start), err)
+			s.updateBidAudit(slot, func(record *ethpb.BuilderBidRecord) {
+				record.Submissions = append(record.Submissions, submission)
+			})
+			if err != nil {
+				relaySubmitBlindedBlockCount.WithLabelValues(relayLabel(r), resultError).Inc()
+				log.WithError(err).WithField("relay", relayLabel(r)).Warn("Failed to submit blinded block to relay")
+				// A request cut short by the caller is not the relay's fault, nor is a header the relay never offered.
+				if ctx.Err() == nil && known {
+					s.recordFault(r, slot, FaultFailedUnblind)
+				}
+			} else {
+				relaySubmitBlindedBlockCount.WithLabelValues(relayLabel(r), resultSuccess).Inc()
+			}
+			results <- result{payload: payload, err: err}
+		}(r)
+	}
+	for i := 0; i < len(relays); i++ {
+		res := <-results
+		if res.err == nil {
+			return res.payload, nil
+		}
+		err = res.err
+	}
+	if err == nil {
+		err = ErrNoBuilder
+	}
+	return nil, err
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// GetHeader retrieves the header for a given slot and parent hash from every relay allowed by the proposer in
+// parallel, and returns the highest value bid with a valid builder signature. Quarantined relays are not asked. When
+// bid timing is enabled, the relays are polled until the bid cutoff instead of being asked once, the bids polled since
+// the slot start being used if the beacon node started polling for the same parent and proposer.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -102,21 +252,99 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.GetHeader(ctx, slot, parentHash, pubKey)
+	if len(s.relays) == 0 {
+		return nil, ErrNoBuilder
+	}
+	relays := s.relays
+	if s.cfg.headFetcher != nil {
+		if idx, ok := s.cfg.headFetcher.HeadPublicKeyToValidatorIndex(pubKey); ok {
+			relays = s.allowedRelays(idx)
+		}
+	}
+	active := s.activeRelays(relays, slot)
+	if len(relays) > 0 && len(active) == 0 {
+		return nil, errRelaysQuarantined
+	}
+	relays = active
+	var bids []*relayBid
+	var records []*ethpb.RelayBidRecord
+	if p := s.slotStartPoll(slot, parentHash, pubKey); p != nil {
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		bids, records = p.bids, p.records
+	} else if s.bidTimingEnabled() {
+		bids, records = s.pollHeaders(ctx, relays, slot, parentHash, pubKey)
+	} else {
+		bids, records = s.requestHeaders(ctx, relays, slot, parentHash, pubKey)
+	}
+
+	best, winners := bestBid(bids)
+	s.updateBidAudit(slot, func(record *ethpb.BuilderBidRecord) {
+		record.ParentHash = parentHash[:]
+		record.ProposerPubkey = pubKey[:]
+		record.Bids = records
+	})
+	if best == nil {
+		return nil, errNoValidBid
+	}
+	for _, r := range winners {
+		relayBidSelectedCount.WithLabelValues(relayLabel(r)).Inc()
+	}
+	s.saveDeliveringRelays(slot, best.blockHash, winners)
+	return best.bid, nil
+}
+
+// requestHeaders asks every relay for a header in parallel, each relay being given at most the relay timeout to answer.
+// The verified bids and the audit records of the relays are returned in relay order, failed relays having a nil bid.
+// Invalid bids and relays running out of their timeout are recorded as faults.
+func (s *Service) requestHeaders(ctx context.Context, relays []builder.BuilderClient, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) ([]*relayBid, []*ethpb.RelayBidRecord) {
+	bids := make([]*relayBid, len(relays))
+	records := make([]*ethpb.RelayBidRecord, len(relays))
+	var wg sync.WaitGroup
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, r builder.BuilderClient) {
+			defer wg.Done()
+			relayCtx, cancel := context.WithTimeout(ctx, s.cfg.relayTimeout)
+			defer cancel()
+			start := time.Now()
+			b, err := getRelayHeader(relayCtx, r, slot, parentHash, pubKey)
+			records[i] = relayBidRecord(r, b, time.Since(start), err)
+			switch {
+			case errors.Is(err, errInvalidBid):
+				s.recordFault(r, slot, FaultInvalidBid)
+			case err != nil && ctx.Err() == nil && errors.Is(relayCtx.Err(), context.DeadlineExceeded):
+				s.recordFault(r, slot, FaultTimeout)
+			}
+			if err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"relay": relayLabel(r),
+					"slot":  slot,
+				}).Warn("Could not get header from relay")
+				return
+			}
+			bids[i] = b
+		}(i, r)
+	}
+	wg.Wait()
+	return bids, records
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
+// RegisterValidator registers a validator with every relay in the builder relay network allowed by the validator.
+// It also saves the registration object to the DB. An error is only returned if no relay accepted the registrations.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
@@ -141,7 +369,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		msgs = append(msgs, r.Message)
 		valid = append(valid, r)
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, idxs, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -150,7 +378,166 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
+}
+
+// SetPreferences saves the builder preferences of validators, persisting the changed ones so the relays a validator
+// allows are still enforced after a restart. Empty preferences reset the validator to the beacon node defaults.
+func (s *Service) SetPreferences(ctx context.Context, idxs []primitives.ValidatorIndex, prefs []*ValidatorPreferences) error {
+	if len(idxs) != len(prefs) {
+		return errors.New("validator indices and preferences must be the same length")
+	}
+	s.prefsLock.Lock()
+	defer s.prefsLock.Unlock()
+	changedIdxs := make([]primitives.ValidatorIndex, 0, len(idxs))
+	changed := make([]*ethpb.BuilderPreferences, 0, len(idxs))
+	for i, idx := range idxs {
+		p := prefs[i]
+		if p.empty() {
+			p = nil
+		}
+		if p.equal(s.prefs[idx]) {
+			continue
+		}
+		changedIdxs = append(changedIdxs, idx)
+		changed = append(changed, p.toProto())
+	}
+	if len(changedIdxs) == 0 {
+		return nil
+	}
+	if s.cfg.beaconDB != nil {
+		if err := s.cfg.beaconDB.SaveBuilderPreferencesByValidatorIDs(ctx, changedIdxs, changed); err != nil {
+			return errors.Wrap(err, "could not save builder preferences")
+		}
+	}
+	for i, idx := range changedIdxs {
+		if changed[i] == nil {
+			delete(s.prefs, idx)
+			continue
+		}
+		s.prefs[idx] = preferencesFromProto(changed[i])
+	}
+	return nil
+}
+
+// loadPreferences loads the builder preferences saved in the db.
+func (s *Service) loadPreferences(ctx context.Context) error {
+	if s.cfg.beaconDB == nil {
+		return nil
+	}
+	saved, err := s.cfg.beaconDB.BuilderPreferences(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load builder preferences")
+	}
+	s.prefsLock.Lock()
+	defer s.prefsLock.Unlock()
+	for idx, p := range saved {
+		s.prefs[idx] = preferencesFromProto(p)
+	}
+	return nil
+}
+
+// Preferences returns the builder preferences of a validator, or nil if the validator has not set any.
+func (s *Service) Preferences(idx primitives.ValidatorIndex) *ValidatorPreferences {
+	s.prefsLock.RLock()
+	defer s.prefsLock.RUnlock()
+	return s.prefs[idx]
+}
+
+// allowedRelays returns the configured relays the validator allows.
+func (s *Service) allowedRelays(idx primitives.ValidatorIndex) []builder.BuilderClient {
+	relays := make([]builder.BuilderClient, 0, len(s.relays))
+	for _, r := range s.relays {
+		if s.relayAllowed(idx, r) {
+			relays = append(relays, r)
+		}
+	}
+	return relays
+}
+
+// relayAllowed returns true if the validator allows the relay to be used on its behalf.
+func (s *Service) relayAllowed(idx primitives.ValidatorIndex, r builder.BuilderClient) bool {
+	prefs := s.Preferences(idx)
+	if prefs == nil || len(prefs.Relays) == 0 {
+		return true
+	}
+	for _, a := range prefs.Relays {
+		if relayHost(a) == relayLabel(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// registerWithRelays sends the registrations to every relay in parallel, each relay only receiving the
+// registrations of validators which allow it. An error is only returned if no relay accepted the registrations.
+func (s *Service) registerWithRelays(ctx context.Context, idxs []primitives.ValidatorIndex, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(s.relays))
+	sent := make([]bool, len(s.relays))
+	for i, r := range s.relays {
+		regs := make([]*ethpb.SignedValidatorRegistrationV1, 0, len(reg))
+		for j := range reg {
+			if s.relayAllowed(idxs[j], r) {
+				regs = append(regs, reg[j])
+			}
+		}
+		if len(regs) == 0 {
+			continue
+		}
+		sent[i] = true
+		wg.Add(1)
+		go func(i int, r builder.BuilderClient, regs []*ethpb.SignedValidatorRegistrationV1) {
+			defer wg.Done()
+			if err := r.RegisterValidator(ctx, regs); err != nil {
+				relayRegisterValidatorCount.WithLabelValues(relayLabel(r), resultError).Inc()
+				log.WithError(err).WithField("relay", relayLabel(r)).Warn("Failed to register validator(s) with relay")
+				errs[i] = err
+				return
+			}
+			relayRegisterValidatorCount.WithLabelValues(relayLabel(r), resultSuccess).Inc()
+		}(i, r, regs)
+	}
+	wg.Wait()
+
+	var firstErr error
+	for i := range s.relays {
+		if !sent[i] {
+			continue
+		}
+		if errs[i] == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = errs[i]
+		}
+	}
+	return firstErr
+}
+
+// saveDeliveringRelays records the relays which offered the header with the given block hash, so the signed
+// blinded block is only revealed to them. Entries older than an epoch are pruned.
+func (s *Service) saveDeliveringRelays(slot primitives.Slot, blockHash [32]byte, relays []builder.BuilderClient) {
+	s.winnersLock.Lock()
+	defer s.winnersLock.Unlock()
+	for h, w := range s.winners {
+		if w.slot+params.BeaconConfig().SlotsPerEpoch < slot {
+			delete(s.winners, h)
+		}
+	}
+	s.winners[blockHash] = &bidWinners{slot: slot, relays: relays}
+}
+
+// deliveringRelays returns the relays which offered the header with the given block hash, or every relay allowed by
+// the proposer if unknown, in which case known is false.
+func (s *Service) deliveringRelays(blockHash [32]byte, proposerIndex primitives.ValidatorIndex) (relays []builder.BuilderClient, known bool) {
+	s.winnersLock.RLock()
+	w, ok := s.winners[blockHash]
+	s.winnersLock.RUnlock()
+	if ok {
+		return w.relays, true
+	}
+	return s.allowedRelays(proposerIndex), false
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -159,9 +546,9 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.Status(ctx); err != nil {
+					log.WithError(err).WithField("relay", relayLabel(r)).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
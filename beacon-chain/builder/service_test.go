@@ -2,15 +2,19 @@ package builder
 
 import (
 	"context"
+	"flag"
 	"testing"
+	"time"
 
 	buildertesting "github.com/prysmaticlabs/prysm/v4/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	dbtesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/urfave/cli/v2"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -25,6 +29,24 @@ func Test_NewServiceWithoutBuilder(t *testing.T) {
 	assert.Equal(t, false, s.Configured())
 }
 
+func Test_FlagOptionsRelayTimeout(t *testing.T) {
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, flags.BuilderRelayTimeout.Apply(set))
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	opts, err := FlagOptions(cliCtx)
+	require.NoError(t, err)
+	s, err := NewService(context.Background(), opts...)
+	require.NoError(t, err)
+	assert.Equal(t, defaultRelayTimeout, s.cfg.relayTimeout)
+
+	require.NoError(t, cliCtx.Set(flags.BuilderRelayTimeout.Name, "300ms"))
+	opts, err = FlagOptions(cliCtx)
+	require.NoError(t, err)
+	s, err = NewService(context.Background(), opts...)
+	require.NoError(t, err)
+	assert.Equal(t, 300*time.Millisecond, s.cfg.relayTimeout)
+}
+
 func Test_RegisterValidator(t *testing.T) {
 	ctx := context.Background()
 	db := dbtesting.SetupDB(t)
@@ -3,9 +3,11 @@ package testing
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	builderService "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -25,6 +27,11 @@ type MockBuilderService struct {
 	BidCapella            *ethpb.SignedBuilderBidCapella
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	ValidatorPreferences  map[primitives.ValidatorIndex]*builderService.ValidatorPreferences
+	BidDeadline           time.Time
+	Faults                []builderService.Fault
+	Quarantined           bool
+	RelayStatusList       []*ethpb.BuilderRelay
 }
 
 // Configured for mocking.
@@ -64,3 +71,39 @@ func (s *MockBuilderService) GetHeader(ctx context.Context, slot primitives.Slot
 func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
 	return s.ErrRegisterValidator
 }
+
+// SetPreferences for mocking.
+func (s *MockBuilderService) SetPreferences(_ context.Context, idxs []primitives.ValidatorIndex, prefs []*builderService.ValidatorPreferences) error {
+	if s.ValidatorPreferences == nil {
+		s.ValidatorPreferences = make(map[primitives.ValidatorIndex]*builderService.ValidatorPreferences)
+	}
+	for i, idx := range idxs {
+		s.ValidatorPreferences[idx] = prefs[i]
+	}
+	return nil
+}
+
+// Preferences for mocking.
+func (s *MockBuilderService) Preferences(idx primitives.ValidatorIndex) *builderService.ValidatorPreferences {
+	return s.ValidatorPreferences[idx]
+}
+
+// HeaderDeadline for mocking.
+func (s *MockBuilderService) HeaderDeadline(_ primitives.Slot) (time.Time, bool) {
+	return s.BidDeadline, !s.BidDeadline.IsZero()
+}
+
+// ReportFault for mocking.
+func (s *MockBuilderService) ReportFault(_ primitives.Slot, _ [32]byte, fault builderService.Fault) {
+	s.Faults = append(s.Faults, fault)
+}
+
+// AllRelaysQuarantined for mocking.
+func (s *MockBuilderService) AllRelaysQuarantined(_ primitives.Slot) bool {
+	return s.Quarantined
+}
+
+// RelayStatuses for mocking.
+func (s *MockBuilderService) RelayStatuses(_ primitives.Slot) []*ethpb.BuilderRelay {
+	return s.RelayStatusList
+}
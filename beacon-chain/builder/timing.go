@@ -0,0 +1,203 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultBidPollInterval is the delay between two rounds of get header requests while waiting for the bid cutoff.
+const defaultBidPollInterval = 200 * time.Millisecond
+
+// bidTimingEnabled returns true if headers are polled until the bid cutoff rather than requested once.
+func (s *Service) bidTimingEnabled() bool {
+	return s.cfg.bidCutoff > 0 && s.cfg.timeFetcher != nil
+}
+
+// HeaderDeadline returns the time at which GetHeader gives up on the relays for the slot, and true, if bid timing is
+// enabled. Callers should allow GetHeader to run until then, rather than applying their own builder timeout.
+func (s *Service) HeaderDeadline(slot primitives.Slot) (time.Time, bool) {
+	if !s.bidTimingEnabled() {
+		return time.Time{}, false
+	}
+	return s.slotStartTime(slot).Add(s.cfg.bidDeadline), true
+}
+
+func (s *Service) slotStartTime(slot primitives.Slot) time.Time {
+	return slots.StartTime(uint64(s.cfg.timeFetcher.GenesisTime().Unix()), slot)
+}
+
+// pollHeaders requests headers from the relays in rounds, until the bid cutoff into the slot, keeping the highest
+// value bid offered by each relay. Builders keep raising their bids as the slot goes on, so waiting trades block
+// propagation time for value. If no relay offered a valid bid by the cutoff, polling goes on until the hard deadline,
+// after which the proposer falls back to the local payload.
+func (s *Service) pollHeaders(ctx context.Context, relays []builder.BuilderClient, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) ([]*relayBid, []*ethpb.RelayBidRecord) {
+	slotStart := s.slotStartTime(slot)
+	cutoff := slotStart.Add(s.cfg.bidCutoff)
+	deadline := slotStart.Add(s.cfg.bidDeadline)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	bids := make([]*relayBid, len(relays))
+	records := make([]*ethpb.RelayBidRecord, len(relays))
+	rounds := 0
+	defer func() {
+		bidPollingRounds.Observe(float64(rounds))
+	}()
+	for {
+		// Requests of a round sent before the cutoff must not hold on to the bids past it.
+		roundEnd := deadline
+		if time.Now().Before(cutoff) {
+			roundEnd = cutoff
+		}
+		roundCtx, roundCancel := context.WithDeadline(ctx, roundEnd)
+		roundBids, roundRecords := s.requestHeaders(roundCtx, relays, slot, parentHash, pubKey)
+		roundCancel()
+		rounds++
+		for i, b := range roundBids {
+			switch {
+			case b != nil && (bids[i] == nil || b.value.Cmp(bids[i].value) > 0):
+				bids[i], records[i] = b, roundRecords[i]
+			case bids[i] == nil:
+				records[i] = roundRecords[i]
+			}
+		}
+
+		now := time.Now()
+		if !now.Before(cutoff) && hasBid(bids) {
+			return bids, records
+		}
+		wait := s.cfg.bidPollInterval
+		if untilCutoff := cutoff.Sub(now); untilCutoff > 0 && untilCutoff < wait {
+			wait = untilCutoff
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.WithFields(log.Fields{
+				"slot":   slot,
+				"rounds": rounds,
+			}).Warn("Reached builder bid deadline without a valid bid")
+			return bids, records
+		case <-timer.C:
+		}
+	}
+}
+
+// headerPoll is the polling of the relays for the header of a slot, started by the beacon node at the slot start on
+// behalf of the proposer. The bids and records are set once done is closed.
+type headerPoll struct {
+	parentHash [32]byte
+	pubKey     [48]byte
+	done       chan struct{}
+	bids       []*relayBid
+	records    []*ethpb.RelayBidRecord
+}
+
+// slotStartPollingEnabled returns true if the relays are polled from the slot start for the proposers tracked by the
+// beacon node.
+func (s *Service) slotStartPollingEnabled() bool {
+	return s.bidTimingEnabled() && s.cfg.proposerCache != nil && s.cfg.headFetcher != nil && s.cfg.beaconDB != nil
+}
+
+// pollHeadersAtSlotStart starts polling the relays at the start of every slot, until the context is done.
+func (s *Service) pollHeadersAtSlotStart(ctx context.Context) {
+	genesis := s.cfg.timeFetcher.GenesisTime()
+	for genesis.IsZero() {
+		// The genesis time is not known before the chain starts.
+		select {
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		genesis = s.cfg.timeFetcher.GenesisTime()
+	}
+	ticker := slots.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			s.startHeaderPoll(ctx, slot)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// startHeaderPoll starts polling the relays for the header of the slot if it is proposed by a validator tracked by the
+// beacon node and registered with the relays. The header is requested on top of the execution block of the head, the
+// proposer only getting the polled bids if it requests a header for the same parent.
+func (s *Service) startHeaderPoll(ctx context.Context, slot primitives.Slot) {
+	idx, _, ok := s.cfg.proposerCache.GetProposerPayloadIDs(slot, [32]byte{})
+	if !ok {
+		return
+	}
+	if _, err := s.cfg.beaconDB.RegistrationByValidatorID(ctx, idx); err != nil {
+		return
+	}
+	pubKey, err := s.cfg.headFetcher.HeadValidatorIndexToPublicKey(ctx, idx)
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", idx).Debug("Could not get proposer public key to poll relays")
+		return
+	}
+	head, err := s.cfg.headFetcher.HeadBlock(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get head block to poll relays")
+		return
+	}
+	payload, err := head.Block().Body().Execution()
+	if err != nil {
+		// Blocks before bellatrix have no execution payload to build on.
+		return
+	}
+	parentHash := bytesutil.ToBytes32(payload.BlockHash())
+	if parentHash == [32]byte{} {
+		return
+	}
+	relays := s.activeRelays(s.allowedRelays(idx), slot)
+	if len(relays) == 0 {
+		return
+	}
+
+	p := &headerPoll{parentHash: parentHash, pubKey: pubKey, done: make(chan struct{})}
+	s.pollsLock.Lock()
+	for sl := range s.polls {
+		if sl < slot {
+			delete(s.polls, sl)
+		}
+	}
+	s.polls[slot] = p
+	s.pollsLock.Unlock()
+	go func() {
+		defer close(p.done)
+		p.bids, p.records = s.pollHeaders(ctx, relays, slot, parentHash, pubKey)
+	}()
+}
+
+// slotStartPoll returns the polling started at the slot start for the given parent and proposer, or nil if there is none.
+func (s *Service) slotStartPoll(slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) *headerPoll {
+	s.pollsLock.Lock()
+	defer s.pollsLock.Unlock()
+	p, ok := s.polls[slot]
+	if !ok || p.parentHash != parentHash || p.pubKey != pubKey {
+		return nil
+	}
+	return p
+}
+
+func hasBid(bids []*relayBid) bool {
+	for _, b := range bids {
+		if b != nil {
+			return true
+		}
+	}
+	return false
+}
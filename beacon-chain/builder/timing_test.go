@@ -0,0 +1,186 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	blockchainTesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
+	dbtesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// risingRelay is a relay client raising its bid every time it is asked for a header.
+type risingRelay struct {
+	*testRelay
+	lock  sync.Mutex
+	bids  []builder.SignedBid
+	calls int
+}
+
+func (r *risingRelay) GetHeader(_ context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	i := r.calls
+	if i >= len(r.bids) {
+		i = len(r.bids) - 1
+	}
+	r.calls++
+	return r.bids[i], nil
+}
+
+// genesisForSlotStart returns a genesis time for which the slot starts within the next second, genesis times
+// being whole seconds.
+func genesisForSlotStart(slot primitives.Slot) time.Time {
+	return time.Unix(time.Now().Unix()+1, 0).Add(-time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+}
+
+func TestService_GetHeader_BidTiming(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	relay := &risingRelay{testRelay: newTestRelay("http://rising:1", nil, nil)}
+	for i := uint64(1); i <= 100; i++ {
+		b, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, i, parentHash, bytesutil.PadTo(bytesutil.Bytes8(i), fieldparams.RootLength)))
+		require.NoError(t, err)
+		relay.bids = append(relay.bids, b)
+	}
+	slot := primitives.Slot(1)
+	s, err := NewService(ctx,
+		WithBuilderClient(relay),
+		WithTimeFetcher(&blockchainTesting.ChainService{Genesis: genesisForSlotStart(slot)}),
+		WithBidTiming(300*time.Millisecond, time.Second),
+	)
+	require.NoError(t, err)
+	s.cfg.bidPollInterval = 20 * time.Millisecond
+
+	got, err := s.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), [48]byte{})
+	require.NoError(t, err)
+	now := time.Now()
+	slotStart := s.slotStartTime(slot)
+	assert.Equal(t, false, now.Before(slotStart.Add(300*time.Millisecond)), "returned before the cutoff")
+	assert.Equal(t, true, now.Before(slotStart.Add(time.Second)), "returned after the deadline")
+
+	relay.lock.Lock()
+	calls := relay.calls
+	relay.lock.Unlock()
+	require.Equal(t, true, calls > 1, "relay was only polled once")
+	bid, err := got.Message()
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(uint64(calls)), 32), bid.Value())
+}
+
+func TestService_GetHeader_BidTimingDeadline(t *testing.T) {
+	ctx := context.Background()
+	slot := primitives.Slot(1)
+	s, err := NewService(ctx,
+		WithBuilderClient(newTestRelay("http://err:1", nil, errors.New("bad"))),
+		WithTimeFetcher(&blockchainTesting.ChainService{Genesis: genesisForSlotStart(slot)}),
+		WithBidTiming(100*time.Millisecond, 300*time.Millisecond),
+	)
+	require.NoError(t, err)
+	s.cfg.bidPollInterval = 20 * time.Millisecond
+
+	_, err = s.GetHeader(ctx, slot, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, errNoValidBid)
+	now := time.Now()
+	slotStart := s.slotStartTime(slot)
+	assert.Equal(t, false, now.Before(slotStart.Add(300*time.Millisecond)), "gave up before the deadline")
+	assert.Equal(t, true, now.Before(slotStart.Add(time.Second)), "gave up long after the deadline")
+}
+
+func TestService_GetHeader_SlotStartPoll(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := bytesutil.PadTo([]byte{'p'}, fieldparams.RootLength)
+	relay := &risingRelay{testRelay: newTestRelay("http://rising:1", nil, nil)}
+	for i := uint64(1); i <= 100; i++ {
+		b, err := builder.WrappedSignedBuilderBid(testSignedBid(t, sk, i, parentHash, bytesutil.PadTo(bytesutil.Bytes8(i), fieldparams.RootLength)))
+		require.NoError(t, err)
+		relay.bids = append(relay.bids, b)
+	}
+	head := util.NewBeaconBlockBellatrix()
+	head.Block.Body.ExecutionPayload.BlockHash = parentHash
+	headBlock, err := blocks.NewSignedBeaconBlock(head)
+	require.NoError(t, err)
+	pubKey := [48]byte{'k'}
+	slot := primitives.Slot(1)
+	proposer := primitives.ValidatorIndex(2)
+	proposerCache := cache.NewProposerPayloadIDsCache()
+	proposerCache.SetProposerAndPayloadIDs(slot, proposer, [8]byte{}, [32]byte{})
+	db := dbtesting.SetupDB(t)
+	chain := &blockchainTesting.ChainService{Genesis: genesisForSlotStart(slot), Block: headBlock, PublicKey: pubKey}
+	s, err := NewService(ctx,
+		WithBuilderClient(relay),
+		WithDatabase(db),
+		WithHeadFetcher(chain),
+		WithTimeFetcher(chain),
+		WithProposerCache(proposerCache),
+		WithBidTiming(300*time.Millisecond, time.Second),
+	)
+	require.NoError(t, err)
+	s.cfg.bidPollInterval = 20 * time.Millisecond
+
+	// Validators which did not register with the relays are not polled for.
+	s.startHeaderPoll(ctx, slot)
+	assert.Equal(t, true, s.slotStartPoll(slot, bytesutil.ToBytes32(parentHash), pubKey) == nil)
+
+	reg := &ethpb.ValidatorRegistrationV1{FeeRecipient: make([]byte, 20), Pubkey: pubKey[:]}
+	require.NoError(t, db.SaveRegistrationsByValidatorIDs(ctx, []primitives.ValidatorIndex{proposer}, []*ethpb.ValidatorRegistrationV1{reg}))
+	s.startHeaderPoll(ctx, slot)
+	require.NotNil(t, s.slotStartPoll(slot, bytesutil.ToBytes32(parentHash), pubKey))
+	assert.Equal(t, true, s.slotStartPoll(slot, [32]byte{'o'}, pubKey) == nil)
+	assert.Equal(t, true, s.slotStartPoll(slot, bytesutil.ToBytes32(parentHash), [48]byte{}) == nil)
+
+	// The proposer asking for the header late gets the bids polled since the slot start.
+	time.Sleep(200 * time.Millisecond)
+	relay.lock.Lock()
+	callsBefore := relay.calls
+	relay.lock.Unlock()
+	require.Equal(t, true, callsBefore > 1, "relay was not polled before the header request")
+
+	got, err := s.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), pubKey)
+	require.NoError(t, err)
+	slotStart := s.slotStartTime(slot)
+	assert.Equal(t, false, time.Now().Before(slotStart.Add(300*time.Millisecond)), "returned before the cutoff")
+	relay.lock.Lock()
+	calls := relay.calls
+	relay.lock.Unlock()
+	bid, err := got.Message()
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(uint64(calls)), 32), bid.Value())
+}
+
+func TestService_HeaderDeadline(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1000, 0)
+	s, err := NewService(ctx, WithTimeFetcher(&blockchainTesting.ChainService{Genesis: genesis}))
+	require.NoError(t, err)
+	_, ok := s.HeaderDeadline(2)
+	assert.Equal(t, false, ok)
+
+	s, err = NewService(ctx,
+		WithTimeFetcher(&blockchainTesting.ChainService{Genesis: genesis}),
+		WithBidTiming(time.Second, 2*time.Second),
+	)
+	require.NoError(t, err)
+	deadline, ok := s.HeaderDeadline(2)
+	assert.Equal(t, true, ok)
+	want := genesis.Add(time.Duration(2*params.BeaconConfig().SecondsPerSlot)*time.Second + 2*time.Second)
+	assert.Equal(t, want, deadline)
+}
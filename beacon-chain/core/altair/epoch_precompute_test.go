@@ -237,6 +237,29 @@ func TestAttestationsDelta(t *testing.T) {
 	require.DeepEqual(t, want, penalties)
 }
 
+func TestAttestationDeltas(t *testing.T) {
+	s, err := testState()
+	require.NoError(t, err)
+	validators, balance, err := InitializePrecomputeValidators(context.Background(), s)
+	require.NoError(t, err)
+	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
+	require.NoError(t, err)
+	deltas, err := AttestationDeltas(s, balance, validators)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(s, balance, validators)
+	require.NoError(t, err)
+
+	require.Equal(t, len(validators), len(deltas))
+	for i, d := range deltas {
+		require.Equal(t, rewards[i], d.Reward())
+		require.Equal(t, penalties[i], d.Penalty())
+	}
+	// The first validator missed all its votes, the last one made all of them.
+	require.DeepEqual(t, &AttDelta{SourcePenalty: 1252195, TargetPenalty: 2325505}, deltas[0])
+	require.Equal(t, uint64(0), deltas[3].Penalty())
+	require.Equal(t, true, deltas[3].HeadReward > 0)
+}
+
 func TestAttestationsDeltaBellatrix(t *testing.T) {
 	s, err := testStateBellatrix()
 	require.NoError(t, err)
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
@@ -154,6 +155,53 @@ func RandaoSignatureBatch(
 	return set, nil
 }
 
+// ProposerSignatureBatchUsingCurrentFork retrieves the signature batch of the proposer signature and of
+// the randao reveal of a block, given the public key of its proposer. Like VerifyBlockSignatureUsingCurrentFork,
+// it retrieves the fork data via the block epoch instead of a state, so the signatures can be verified ahead
+// of the state transition of the block. The block root is returned as well, to spare hashing the block again.
+func ProposerSignatureBatchUsingCurrentFork(
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	proposerPubKey []byte,
+	genesisValidatorsRoot []byte,
+) (*bls.SignatureBatch, [32]byte, error) {
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return nil, [32]byte{}, err
+	}
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not hash block")
+	}
+	currentEpoch := slots.ToEpoch(blk.Block().Slot())
+	fork, err := forks.Fork(currentEpoch)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	domain, err := signing.Domain(fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer, genesisValidatorsRoot)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	sig := blk.Signature()
+	set, err := signing.BlockSignatureBatch(proposerPubKey, sig[:], domain, func() ([32]byte, error) {
+		return blkRoot, nil
+	})
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, uint64(currentEpoch))
+	domain, err = signing.Domain(fork, currentEpoch, params.BeaconConfig().DomainRandao, genesisValidatorsRoot)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	reveal := blk.Block().Body().RandaoReveal()
+	rSet, err := signatureBatch(buf, proposerPubKey, reveal[:], domain, signing.RandaoSignature)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	return set.Join(rSet), blkRoot, nil
+}
+
 // retrieves the randao related signing data from the state.
 func randaoSigningData(ctx context.Context, beaconState state.ReadOnlyBeaconState) ([]byte, []byte, []byte, error) {
 	proposerIdx, err := helpers.BeaconProposerIndex(ctx, beaconState)
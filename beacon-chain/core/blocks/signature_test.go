@@ -87,3 +87,33 @@ func TestVerifyBlockSignatureUsingCurrentFork(t *testing.T) {
 	require.NoError(t, err)
 	assert.NoError(t, blocks.VerifyBlockSignatureUsingCurrentFork(bState, wsb))
 }
+
+func TestProposerSignatureBatchUsingCurrentFork(t *testing.T) {
+	bState, keys := util.DeterministicGenesisState(t, 64)
+	b, err := util.GenerateFullBlock(bState, keys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	pubKey := keys[b.Block.ProposerIndex].PublicKey().Marshal()
+	gvr := bState.GenesisValidatorsRoot()
+
+	set, root, err := blocks.ProposerSignatureBatchUsingCurrentFork(wsb, pubKey, gvr)
+	require.NoError(t, err)
+	wantRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+	assert.DeepEqual(t, []string{signing.BlockSignature, signing.RandaoSignature}, set.Descriptions)
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified)
+
+	// A randao reveal of another proposer does not verify.
+	b.Block.Body.RandaoReveal = keys[b.Block.ProposerIndex+1].Sign(make([]byte, 32)).Marshal()
+	wsb, err = consensusblocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	set, _, err = blocks.ProposerSignatureBatchUsingCurrentFork(wsb, pubKey, gvr)
+	require.NoError(t, err)
+	verified, err = set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, verified)
+}
@@ -0,0 +1,97 @@
+// Package backend defines the key-value engines the beacon database can be stored with. Every engine exposes the
+// same model of transactions over named buckets of sorted keys, which is the model of bolt, so that the beacon
+// database is written once against the interfaces of this package.
+package backend
+
+import (
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Kind is the name of a key-value engine.
+type Kind string
+
+const (
+	// Bolt stores the database in a single memory mapped B+tree file.
+	Bolt Kind = "bolt"
+	// Pebble stores the database in a log-structured merge tree, whose writes are cheaper than those of bolt.
+	Pebble Kind = "pebble"
+)
+
+// Kinds lists the supported engines.
+var Kinds = []Kind{Bolt, Pebble}
+
+var (
+	// ErrUnknownKind is returned when parsing the name of an unsupported engine.
+	ErrUnknownKind = errors.New("unknown database backend")
+	// ErrBucketNotFound is returned when deleting a bucket which does not exist.
+	ErrBucketNotFound = bolt.ErrBucketNotFound
+	// ErrTxNotWritable is returned when writing in a read-only transaction.
+	ErrTxNotWritable = bolt.ErrTxNotWritable
+	// ErrKeyRequired is returned when writing an empty key.
+	ErrKeyRequired = bolt.ErrKeyRequired
+)
+
+// ParseKind returns the engine of the given name.
+func ParseKind(name string) (Kind, error) {
+	for _, k := range Kinds {
+		if string(k) == name {
+			return k, nil
+		}
+	}
+	return "", errors.Wrapf(ErrUnknownKind, "%q, expected one of %v", name, Kinds)
+}
+
+// DB is a key-value store, made of buckets holding sorted keys.
+type DB interface {
+	// View runs the given function in a read-only transaction, which sees a consistent snapshot of the store.
+	View(fn func(tx Tx) error) error
+	// Update runs the given function in a read-write transaction, which is committed if the function returns no
+	// error. Read-write transactions are serialized.
+	Update(fn func(tx Tx) error) error
+	// Kind returns the engine of the store.
+	Kind() Kind
+	// Path returns the path of the file or directory of the store.
+	Path() string
+	Close() error
+}
+
+// Tx is a transaction over the buckets of a store. Byte slices returned by a transaction are only valid until the
+// transaction ends.
+type Tx interface {
+	// Bucket returns the bucket of the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	DeleteBucket(name []byte) error
+	// ForEach calls the given function with every bucket, ordered by name.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a collection of sorted keys and their values.
+type Bucket interface {
+	// Get returns the value of the given key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	Cursor() Cursor
+	// ForEach calls the given function with every key and value of the bucket, in key order.
+	ForEach(fn func(k, v []byte) error) error
+}
+
+// Cursor iterates the keys of a bucket in order. Positioning methods return a nil key when the cursor moves past
+// the keys of the bucket.
+type Cursor interface {
+	First() (key []byte, value []byte)
+	Last() (key []byte, value []byte)
+	Next() (key []byte, value []byte)
+	Prev() (key []byte, value []byte)
+	// Seek moves the cursor to the first key which is greater than or equal to the given key.
+	Seek(seek []byte) (key []byte, value []byte)
+	// Delete removes the key under the cursor.
+	Delete() error
+}
+
+// Compacter is a store which can reclaim the space freed by deletions while it is open.
+type Compacter interface {
+	Compact() error
+}
@@ -0,0 +1,286 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func open(t *testing.T, kind Kind) DB {
+	var db DB
+	switch kind {
+	case Bolt:
+		b, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, &bolt.Options{Timeout: time.Second})
+		require.NoError(t, err)
+		db = WrapBolt(b)
+	case Pebble:
+		var err error
+		db, err = OpenPebble(filepath.Join(t.TempDir(), "test.pebble"))
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func forEachKind(t *testing.T, fn func(t *testing.T, kind Kind)) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			fn(t, kind)
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	k, err := ParseKind("pebble")
+	require.NoError(t, err)
+	assert.Equal(t, Pebble, k)
+	_, err = ParseKind("leveldb")
+	require.ErrorIs(t, err, ErrUnknownKind)
+}
+
+func TestDB_Buckets(t *testing.T) {
+	forEachKind(t, func(t *testing.T, kind Kind) {
+		db := open(t, kind)
+		assert.Equal(t, kind, db.Kind())
+		require.NoError(t, db.Update(func(tx Tx) error {
+			assert.Equal(t, nil, tx.Bucket([]byte("a")))
+			for _, name := range []string{"b", "a", "ab"} {
+				if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+					return err
+				}
+			}
+			b, err := tx.CreateBucketIfNotExists([]byte("a"))
+			require.NoError(t, err)
+			return b.Put([]byte("k"), []byte("v"))
+		}))
+		require.NoError(t, db.View(func(tx Tx) error {
+			var names []string
+			require.NoError(t, tx.ForEach(func(name []byte, b Bucket) error {
+				names = append(names, string(name))
+				return nil
+			}))
+			assert.DeepEqual(t, []string{"a", "ab", "b"}, names)
+			assert.DeepEqual(t, []byte("v"), tx.Bucket([]byte("a")).Get([]byte("k")))
+			assert.DeepEqual(t, []byte(nil), tx.Bucket([]byte("ab")).Get([]byte("k")))
+			_, err := tx.CreateBucketIfNotExists([]byte("c"))
+			require.ErrorIs(t, err, ErrTxNotWritable)
+			require.ErrorIs(t, tx.Bucket([]byte("a")).Put([]byte("k"), nil), ErrTxNotWritable)
+			return nil
+		}))
+		require.NoError(t, db.Update(func(tx Tx) error {
+			require.ErrorIs(t, tx.DeleteBucket([]byte("c")), ErrBucketNotFound)
+			return tx.DeleteBucket([]byte("a"))
+		}))
+		require.NoError(t, db.View(func(tx Tx) error {
+			assert.Equal(t, nil, tx.Bucket([]byte("a")))
+			assert.NotNil(t, tx.Bucket([]byte("ab")))
+			return nil
+		}))
+		require.NoError(t, db.Update(func(tx Tx) error {
+			b, err := tx.CreateBucketIfNotExists([]byte("a"))
+			require.NoError(t, err)
+			// The keys of a deleted bucket do not come back with it.
+			assert.DeepEqual(t, []byte(nil), b.Get([]byte("k")))
+			return nil
+		}))
+	})
+}
+
+func TestDB_Update(t *testing.T) {
+	forEachKind(t, func(t *testing.T, kind Kind) {
+		db := open(t, kind)
+		name := []byte("bucket")
+		require.NoError(t, db.Update(func(tx Tx) error {
+			b, err := tx.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+			require.NoError(t, b.Put([]byte("k"), []byte("v")))
+			// Writes are visible to the transaction which made them.
+			assert.DeepEqual(t, []byte("v"), b.Get([]byte("k")))
+			require.NoError(t, b.Put([]byte("empty"), []byte{}))
+			require.ErrorIs(t, b.Put(nil, []byte("v")), ErrKeyRequired)
+			return nil
+		}))
+		err := db.Update(func(tx Tx) error {
+			require.NoError(t, tx.Bucket(name).Put([]byte("k"), []byte("w")))
+			return fmt.Errorf("rollback")
+		})
+		require.ErrorContains(t, "rollback", err)
+		require.NoError(t, db.View(func(tx Tx) error {
+			b := tx.Bucket(name)
+			assert.DeepEqual(t, []byte("v"), b.Get([]byte("k")))
+			assert.NotNil(t, b.Get([]byte("empty")))
+			assert.Equal(t, 0, len(b.Get([]byte("empty"))))
+			return nil
+		}))
+		require.NoError(t, db.Update(func(tx Tx) error {
+			return tx.Bucket(name).Delete([]byte("k"))
+		}))
+		require.NoError(t, db.View(func(tx Tx) error {
+			assert.DeepEqual(t, []byte(nil), tx.Bucket(name).Get([]byte("k")))
+			return nil
+		}))
+	})
+}
+
+func TestCursor(t *testing.T) {
+	forEachKind(t, func(t *testing.T, kind Kind) {
+		db := open(t, kind)
+		name := []byte("bucket")
+		keys := [][]byte{{1}, {2}, {2, 0}, {4}, {0xff, 0xff}}
+		require.NoError(t, db.Update(func(tx Tx) error {
+			b, err := tx.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+			for i, k := range keys {
+				if err := b.Put(k, []byte{byte(i)}); err != nil {
+					return err
+				}
+			}
+			// A bucket whose name extends the name of the other one must not show in its keys.
+			b, err = tx.CreateBucketIfNotExists([]byte("bucket2"))
+			if err != nil {
+				return err
+			}
+			return b.Put([]byte{3}, []byte{3})
+		}))
+		require.NoError(t, db.View(func(tx Tx) error {
+			c := tx.Bucket(name).Cursor()
+			var got [][]byte
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				got = append(got, k)
+			}
+			// Keys stay valid after the cursor moves.
+			assert.DeepEqual(t, keys, got)
+			k, v := c.Last()
+			assert.DeepEqual(t, []byte{0xff, 0xff}, k)
+			assert.DeepEqual(t, []byte{4}, v)
+			k, _ = c.Prev()
+			assert.DeepEqual(t, []byte{4}, k)
+			k, v = c.Seek([]byte{3})
+			assert.DeepEqual(t, []byte{4}, k)
+			assert.DeepEqual(t, []byte{3}, v)
+			k, _ = c.Seek([]byte{0xff, 0xff, 0})
+			assert.DeepEqual(t, []byte(nil), k)
+			k, _ = c.First()
+			assert.DeepEqual(t, []byte{1}, k)
+			k, _ = c.Prev()
+			assert.DeepEqual(t, []byte(nil), k)
+			return nil
+		}))
+		require.NoError(t, db.Update(func(tx Tx) error {
+			c := tx.Bucket(name).Cursor()
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				if k[0] == 2 {
+					if err := c.Delete(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}))
+		require.NoError(t, db.View(func(tx Tx) error {
+			var got [][]byte
+			require.NoError(t, tx.Bucket(name).ForEach(func(k, v []byte) error {
+				got = append(got, k)
+				return nil
+			}))
+			assert.DeepEqual(t, [][]byte{{1}, {4}, {0xff, 0xff}}, got)
+			return nil
+		}))
+	})
+}
+
+func TestCopy(t *testing.T) {
+	forEachKind(t, func(t *testing.T, kind Kind) {
+		src := open(t, kind)
+		require.NoError(t, src.Update(func(tx Tx) error {
+			for i := 0; i < 3; i++ {
+				b, err := tx.CreateBucketIfNotExists([]byte(fmt.Sprintf("bucket%d", i)))
+				if err != nil {
+					return err
+				}
+				for j := 0; j < 100*i; j++ {
+					if err := b.Put([]byte(fmt.Sprintf("key%03d", j)), []byte(fmt.Sprintf("value%d", j))); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}))
+		for _, dstKind := range Kinds {
+			dst := open(t, dstKind)
+			require.NoError(t, Copy(context.Background(), dst, src))
+			require.NoError(t, dst.View(func(tx Tx) error {
+				return src.View(func(srcTx Tx) error {
+					return srcTx.ForEach(func(name []byte, b Bucket) error {
+						copied := tx.Bucket(name)
+						require.NotNil(t, copied, "bucket %s was not copied", name)
+						n := 0
+						require.NoError(t, b.ForEach(func(k, v []byte) error {
+							n++
+							assert.DeepEqual(t, v, copied.Get(k))
+							return nil
+						}))
+						m := 0
+						require.NoError(t, copied.ForEach(func(k, v []byte) error {
+							m++
+							return nil
+						}))
+						assert.Equal(t, n, m)
+						return nil
+					})
+				})
+			}))
+		}
+	})
+}
+
+func TestRepair(t *testing.T) {
+	forEachKind(t, func(t *testing.T, kind Kind) {
+		db := open(t, kind)
+		name := []byte("bucket")
+		require.NoError(t, db.Update(func(tx Tx) error {
+			b, err := tx.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+			for i := 0; i < repairBatchSize+1; i++ {
+				if err := b.Put([]byte{byte(i >> 8), byte(i)}, []byte{1}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+		issues := []*Inconsistency{{Bucket: string(name), Key: []byte{0xff, 0xff}, Reason: "cannot be repaired"}}
+		for i := 0; i < repairBatchSize+1; i++ {
+			key := []byte{byte(i >> 8), byte(i)}
+			issues = append(issues, &Inconsistency{
+				Bucket: string(name),
+				Key:    key,
+				Reason: "dangling",
+				Fix: func(tx Tx) error {
+					return tx.Bucket(name).Delete(key)
+				},
+			})
+		}
+		n, err := Repair(db, issues)
+		require.NoError(t, err)
+		assert.Equal(t, repairBatchSize+1, n)
+		require.NoError(t, db.View(func(tx Tx) error {
+			k, _ := tx.Bucket(name).Cursor().First()
+			assert.DeepEqual(t, []byte(nil), k)
+			return nil
+		}))
+	})
+}
@@ -0,0 +1,117 @@
+package backend
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// boltDB adapts a bolt database to the DB interface.
+type boltDB struct {
+	db *bolt.DB
+}
+
+// WrapBolt returns the given bolt database as a DB.
+func WrapBolt(db *bolt.DB) DB {
+	return &boltDB{db: db}
+}
+
+// AsBolt returns the bolt database underlying the given store, if it is stored with bolt.
+func AsBolt(db DB) (*bolt.DB, bool) {
+	b, ok := db.(*boltDB)
+	if !ok {
+		return nil, false
+	}
+	return b.db, true
+}
+
+// View --
+func (d *boltDB) View(fn func(tx Tx) error) error {
+	return d.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Update --
+func (d *boltDB) Update(fn func(tx Tx) error) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Kind --
+func (*boltDB) Kind() Kind {
+	return Bolt
+}
+
+// Path --
+func (d *boltDB) Path() string {
+	return d.db.Path()
+}
+
+// Close --
+func (d *boltDB) Close() error {
+	return d.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+// Bucket --
+func (t *boltTx) Bucket(name []byte) Bucket {
+	b := t.tx.Bucket(name)
+	// A nil bucket must be returned as a nil interface, so that callers can compare it to nil.
+	if b == nil {
+		return nil
+	}
+	return &boltBucket{b: b}
+}
+
+// CreateBucketIfNotExists --
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{b: b}, nil
+}
+
+// DeleteBucket --
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return t.tx.DeleteBucket(name)
+}
+
+// ForEach --
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, &boltBucket{b: b})
+	})
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+// Get --
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.b.Get(key)
+}
+
+// Put --
+func (b *boltBucket) Put(key []byte, value []byte) error {
+	return b.b.Put(key, value)
+}
+
+// Delete --
+func (b *boltBucket) Delete(key []byte) error {
+	return b.b.Delete(key)
+}
+
+// Cursor --
+func (b *boltBucket) Cursor() Cursor {
+	return b.b.Cursor()
+}
+
+// ForEach --
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.b.ForEach(fn)
+}
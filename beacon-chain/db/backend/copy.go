@@ -0,0 +1,60 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// copyBatchBytes is the size of the keys and values above which copied entries are committed, so that copying a
+// large store does not hold it all in a single transaction.
+const copyBatchBytes = 64 * 1024 * 1024
+
+type entry struct {
+	key   []byte
+	value []byte
+}
+
+// Copy copies every bucket of the source store into the destination store, which can use another engine. The source
+// is read in a single transaction, while the destination is written in bounded transactions.
+func Copy(ctx context.Context, dst, src DB) error {
+	return src.View(func(tx Tx) error {
+		return tx.ForEach(func(name []byte, b Bucket) error {
+			if err := dst.Update(func(tx Tx) error {
+				_, err := tx.CreateBucketIfNotExists(name)
+				return err
+			}); err != nil {
+				return errors.Wrapf(err, "could not create bucket %s", name)
+			}
+			var pending []entry
+			size := 0
+			flush := func() error {
+				err := dst.Update(func(tx Tx) error {
+					bkt := tx.Bucket(name)
+					for _, e := range pending {
+						if err := bkt.Put(e.key, e.value); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				pending, size = pending[:0], 0
+				return errors.Wrapf(err, "could not copy bucket %s", name)
+			}
+			c := b.Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				pending = append(pending, entry{key: k, value: v})
+				size += len(k) + len(v)
+				if size >= copyBatchBytes {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			return flush()
+		})
+	})
+}
@@ -0,0 +1,347 @@
+package backend
+
+import (
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// maxBucketNameLength is the maximum length of the name of a pebble bucket, which prefixes its keys.
+const maxBucketNameLength = 255
+
+// Pebble has no buckets, so they are laid out in its single key space. The keys of a bucket are prefixed by the
+// length of its name followed by its name, and the existence of a bucket is recorded under the bucket key made of a
+// zero byte followed by its name, which sorts before every prefixed key since names are not empty.
+var bucketKeyPrefix = []byte{0}
+
+// pebbleDB implements DB with a pebble database.
+type pebbleDB struct {
+	db   *pebble.DB
+	path string
+	// writeLock serializes read-write transactions, which read their own writes and must not interleave, as is the
+	// case with bolt.
+	writeLock sync.Mutex
+}
+
+// OpenPebble opens the pebble database in the given directory, which is created if missing.
+func OpenPebble(dir string) (DB, error) {
+	db, err := pebble.Open(dir, &pebble.Options{Logger: log})
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleDB{db: db, path: dir}, nil
+}
+
+// View --
+func (d *pebbleDB) View(fn func(tx Tx) error) error {
+	snap := d.db.NewSnapshot()
+	tx := &pebbleTx{r: snap}
+	err := fn(tx)
+	tx.closeIterators()
+	if closeErr := snap.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Update --
+func (d *pebbleDB) Update(fn func(tx Tx) error) error {
+	d.writeLock.Lock()
+	defer d.writeLock.Unlock()
+	batch := d.db.NewIndexedBatch()
+	tx := &pebbleTx{r: batch, batch: batch}
+	err := fn(tx)
+	tx.closeIterators()
+	if err == nil {
+		err = batch.Commit(pebble.Sync)
+	}
+	if closeErr := batch.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Kind --
+func (*pebbleDB) Kind() Kind {
+	return Pebble
+}
+
+// Path --
+func (d *pebbleDB) Path() string {
+	return d.path
+}
+
+// Close --
+func (d *pebbleDB) Close() error {
+	return d.db.Close()
+}
+
+// Compact compacts the whole key space of the database, dropping the deleted keys.
+func (d *pebbleDB) Compact() error {
+	iter := d.db.NewIter(nil)
+	var first, last []byte
+	if iter.First() {
+		first = append(first, iter.Key()...)
+	}
+	if iter.Last() {
+		last = append(last, iter.Key()...)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if first == nil {
+		return nil
+	}
+	// The end of the compacted range is exclusive.
+	return d.db.Compact(first, append(last, 0), true /* parallelize */)
+}
+
+// pebbleReader is implemented by snapshots, for read-only transactions, and by indexed batches, for read-write
+// transactions.
+type pebbleReader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+type pebbleTx struct {
+	r pebbleReader
+	// batch is nil in read-only transactions.
+	batch *pebble.Batch
+	iters []*pebble.Iterator
+}
+
+func (t *pebbleTx) closeIterators() {
+	for _, it := range t.iters {
+		if err := it.Close(); err != nil {
+			log.WithError(err).Error("Could not close iterator")
+		}
+	}
+	t.iters = nil
+}
+
+// get returns a copy of the value of the given key, as values read from pebble are only valid until the closer is
+// closed.
+func (t *pebbleTx) get(key []byte) ([]byte, error) {
+	v, closer, err := t.r.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	value := make([]byte, len(v))
+	copy(value, v)
+	return value, closer.Close()
+}
+
+func (t *pebbleTx) newIter(lower, upper []byte) *pebble.Iterator {
+	it := t.r.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	t.iters = append(t.iters, it)
+	return it
+}
+
+// Bucket --
+func (t *pebbleTx) Bucket(name []byte) Bucket {
+	if len(name) == 0 || len(name) > maxBucketNameLength {
+		return nil
+	}
+	v, err := t.get(bucketKey(name))
+	if err != nil {
+		log.WithError(err).WithField("bucket", string(name)).Error("Could not look up bucket")
+		return nil
+	}
+	if v == nil {
+		return nil
+	}
+	return t.bucket(name)
+}
+
+func (t *pebbleTx) bucket(name []byte) *pebbleBucket {
+	prefix := make([]byte, 0, 1+len(name))
+	prefix = append(prefix, byte(len(name)))
+	prefix = append(prefix, name...)
+	return &pebbleBucket{tx: t, prefix: prefix, end: prefixEnd(prefix)}
+}
+
+// CreateBucketIfNotExists --
+func (t *pebbleTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if b := t.Bucket(name); b != nil {
+		return b, nil
+	}
+	if t.batch == nil {
+		return nil, ErrTxNotWritable
+	}
+	if len(name) == 0 || len(name) > maxBucketNameLength {
+		return nil, errors.Errorf("bucket name must be 1 to %d bytes long, got %d", maxBucketNameLength, len(name))
+	}
+	if err := t.batch.Set(bucketKey(name), []byte{}, nil); err != nil {
+		return nil, err
+	}
+	return t.bucket(name), nil
+}
+
+// DeleteBucket --
+func (t *pebbleTx) DeleteBucket(name []byte) error {
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	if t.Bucket(name) == nil {
+		return ErrBucketNotFound
+	}
+	b := t.bucket(name)
+	if err := t.batch.DeleteRange(b.prefix, b.end, nil); err != nil {
+		return err
+	}
+	return t.batch.Delete(bucketKey(name), nil)
+}
+
+// ForEach --
+func (t *pebbleTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	it := t.newIter(bucketKeyPrefix, prefixEnd(bucketKeyPrefix))
+	for ok := it.First(); ok; ok = it.Next() {
+		name := make([]byte, len(it.Key())-len(bucketKeyPrefix))
+		copy(name, it.Key()[len(bucketKeyPrefix):])
+		if err := fn(name, t.bucket(name)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+type pebbleBucket struct {
+	tx *pebbleTx
+	// prefix and end bound the keys of the bucket, end being excluded.
+	prefix []byte
+	end    []byte
+}
+
+func (b *pebbleBucket) key(k []byte) []byte {
+	key := make([]byte, 0, len(b.prefix)+len(k))
+	key = append(key, b.prefix...)
+	return append(key, k...)
+}
+
+// Get --
+func (b *pebbleBucket) Get(key []byte) []byte {
+	v, err := b.tx.get(b.key(key))
+	if err != nil {
+		log.WithError(err).Error("Could not get key")
+		return nil
+	}
+	return v
+}
+
+// Put --
+func (b *pebbleBucket) Put(key []byte, value []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	if len(key) == 0 {
+		return ErrKeyRequired
+	}
+	return b.tx.batch.Set(b.key(key), value, nil)
+}
+
+// Delete --
+func (b *pebbleBucket) Delete(key []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	return b.tx.batch.Delete(b.key(key), nil)
+}
+
+// Cursor --
+func (b *pebbleBucket) Cursor() Cursor {
+	return &pebbleCursor{b: b, it: b.tx.newIter(b.prefix, b.end)}
+}
+
+// ForEach --
+func (b *pebbleBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pebbleCursor iterates the keys of a bucket. Unlike a bolt cursor, it does not see the keys written by its
+// transaction after it was created.
+type pebbleCursor struct {
+	b  *pebbleBucket
+	it *pebble.Iterator
+}
+
+// First --
+func (c *pebbleCursor) First() ([]byte, []byte) {
+	return c.current(c.it.First())
+}
+
+// Last --
+func (c *pebbleCursor) Last() ([]byte, []byte) {
+	return c.current(c.it.Last())
+}
+
+// Next --
+func (c *pebbleCursor) Next() ([]byte, []byte) {
+	return c.current(c.it.Next())
+}
+
+// Prev --
+func (c *pebbleCursor) Prev() ([]byte, []byte) {
+	return c.current(c.it.Prev())
+}
+
+// Seek --
+func (c *pebbleCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.current(c.it.SeekGE(c.b.key(seek)))
+}
+
+// Delete --
+func (c *pebbleCursor) Delete() error {
+	if c.b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	if !c.it.Valid() {
+		return nil
+	}
+	key := make([]byte, len(c.it.Key()))
+	copy(key, c.it.Key())
+	return c.b.tx.batch.Delete(key, nil)
+}
+
+// current returns copies of the key, without the bucket prefix, and of the value under the iterator, which are
+// only valid until it moves, while callers expect them to be valid until the end of the transaction.
+func (c *pebbleCursor) current(valid bool) ([]byte, []byte) {
+	if !valid {
+		return nil, nil
+	}
+	k := c.it.Key()[len(c.b.prefix):]
+	key := make([]byte, len(k))
+	copy(key, k)
+	value := make([]byte, len(c.it.Value()))
+	copy(value, c.it.Value())
+	return key, value
+}
+
+func bucketKey(name []byte) []byte {
+	key := make([]byte, 0, len(bucketKeyPrefix)+len(name))
+	key = append(key, bucketKeyPrefix...)
+	return append(key, name...)
+}
+
+// prefixEnd returns the smallest key greater than every key starting with the given prefix, or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
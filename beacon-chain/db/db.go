@@ -9,13 +9,18 @@ import (
 )
 
 // NewDB initializes a new DB.
-func NewDB(ctx context.Context, dirPath string) (Database, error) {
-	return kv.NewKVStore(ctx, dirPath)
+func NewDB(ctx context.Context, dirPath string, opts ...kv.Option) (Database, error) {
+	return kv.NewKVStore(ctx, dirPath, opts...)
 }
 
-// NewDBFilename uses the KVStoreDatafilePath so that if this layer of
+// NewDBFilename uses the StoragePath so that if this layer of
 // indirection between db.NewDB->kv.NewKVStore ever changes, it will be easy to remember
-// to also change this filename indirection at the same time.
+// to also change this filename indirection at the same time. The path is a
+// directory when the database is stored with pebble.
 func NewDBFilename(dirPath string) string {
-	return kv.KVStoreDatafilePath(dirPath)
+	kind, exists, err := kv.DetectBackend(dirPath)
+	if err != nil || !exists {
+		return kv.KVStoreDatafilePath(dirPath)
+	}
+	return kv.StoragePath(dirPath, kind)
 }
@@ -0,0 +1,293 @@
+// Package era exports the finalized history of the beacon chain to era files, and imports it back into the database
+// of another node, which can then serve and replay the whole history without syncing it from its peers.
+//
+// An era file is an e2store file, a sequence of records made of a type, a length and the record data, which holds the
+// blocks of SLOTS_PER_HISTORICAL_ROOT slots followed by the state at the slot following them, and indices by slot of
+// those blocks and of the state. Blocks and states are ssz encoded and snappy compressed, with the framing format.
+// Era e covers the blocks of the slots from (e-1)*SLOTS_PER_HISTORICAL_ROOT up to e*SLOTS_PER_HISTORICAL_ROOT, and
+// the state at slot e*SLOTS_PER_HISTORICAL_ROOT, before the block of that slot is applied. Era 0 only holds the
+// genesis state. See https://github.com/status-im/nimbus-eth2/blob/stable/docs/e2store.md for the specification.
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// headerLength is the length of the header of an e2store record: a 2 bytes type, a 4 bytes little endian length and 2
+// reserved bytes.
+const headerLength = 8
+
+var (
+	typeVersion         = [2]byte{0x65, 0x32}
+	typeCompressedBlock = [2]byte{0x01, 0x00}
+	typeCompressedState = [2]byte{0x02, 0x00}
+	typeSlotIndex       = [2]byte{0x69, 0x32}
+)
+
+var (
+	// ErrSlotOutOfRange is returned when a block is not in the range of slots of an era.
+	ErrSlotOutOfRange = errors.New("slot is not in the range of the era")
+	// ErrMalformedFile is returned when a file is not a well formed era file.
+	ErrMalformedFile = errors.New("malformed era file")
+)
+
+// StartSlot returns the slot of the first block of an era.
+func StartSlot(era uint64) primitives.Slot {
+	if era == 0 {
+		return 0
+	}
+	return primitives.Slot(era-1) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// StateSlot returns the slot of the state of an era, which follows the slots of its blocks.
+func StateSlot(era uint64) primitives.Slot {
+	return primitives.Slot(era) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// Filename returns the name of the file of an era, made of the name of the network, the number of the era and the first
+// bytes of the historical root of the era, or of the genesis validators root for era 0.
+func Filename(configName string, era uint64, historicalRoot []byte) string {
+	if len(historicalRoot) > 4 {
+		historicalRoot = historicalRoot[:4]
+	}
+	return fmt.Sprintf("%s-%05d-%x.era", configName, era, historicalRoot)
+}
+
+// Writer writes the blocks and the state of an era as an era file.
+type Writer struct {
+	w      io.Writer
+	era    uint64
+	offset int64
+	// blocks holds the offset of the record of the block of each slot of the era, or 0 for an empty slot.
+	blocks []int64
+	// next is the lowest slot of the blocks which can still be written.
+	next  primitives.Slot
+	state int64
+}
+
+// NewWriter returns a Writer of the given era, and writes the version record of the era file.
+func NewWriter(w io.Writer, era uint64) (*Writer, error) {
+	ew := &Writer{w: w, era: era, next: StartSlot(era), state: -1}
+	if era > 0 {
+		ew.blocks = make([]int64, params.BeaconConfig().SlotsPerHistoricalRoot)
+	}
+	if err := ew.writeRecord(typeVersion, nil); err != nil {
+		return nil, err
+	}
+	return ew, nil
+}
+
+// WriteBlock writes the ssz encoded block of the given slot. Blocks must be written by increasing slot, before the
+// state.
+func (w *Writer) WriteBlock(slot primitives.Slot, enc []byte) error {
+	start := StartSlot(w.era)
+	if w.era == 0 || slot < start || slot >= StateSlot(w.era) {
+		return errors.Wrapf(ErrSlotOutOfRange, "block slot=%d, era=%d", slot, w.era)
+	}
+	if w.state >= 0 || slot < w.next {
+		return fmt.Errorf("block at slot %d is not written in order", slot)
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	w.blocks[slot-start] = w.offset
+	w.next = slot + 1
+	return w.writeRecord(typeCompressedBlock, compressed)
+}
+
+// WriteState writes the ssz encoded state of the era, once its blocks are written.
+func (w *Writer) WriteState(enc []byte) error {
+	if w.state >= 0 {
+		return errors.New("state of the era is already written")
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	w.state = w.offset
+	return w.writeRecord(typeCompressedState, compressed)
+}
+
+// Close writes the slot indices of the blocks and of the state, which end the era file. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if w.state < 0 {
+		return errors.New("state of the era is not written")
+	}
+	if w.era > 0 {
+		if err := w.writeIndex(StartSlot(w.era), w.blocks); err != nil {
+			return err
+		}
+	}
+	return w.writeIndex(StateSlot(w.era), []int64{w.state})
+}
+
+// writeIndex writes a slot index, whose offsets are relative to the start of the index record.
+func (w *Writer) writeIndex(start primitives.Slot, offsets []int64) error {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, uint64(start))
+	for i, o := range offsets {
+		if o != 0 {
+			binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o-w.offset))
+		}
+	}
+	binary.LittleEndian.PutUint64(data[8*(len(offsets)+1):], uint64(len(offsets)))
+	return w.writeRecord(typeSlotIndex, data)
+}
+
+func (w *Writer) writeRecord(typ [2]byte, data []byte) error {
+	header := make([]byte, headerLength)
+	copy(header, typ[:])
+	binary.LittleEndian.PutUint32(header[2:], uint32(len(data)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	w.offset += int64(headerLength + len(data))
+	return nil
+}
+
+// Reader reads the blocks and the state of an era file. Only era files holding a single era, as written by Writer,
+// are supported.
+type Reader struct {
+	r   io.ReaderAt
+	era uint64
+	// blocks holds the offset of the record of the block of each slot of the era, or 0 for an empty slot.
+	blocks []int64
+	state  int64
+}
+
+// NewReader returns a Reader of the era file of the given size, after reading its slot indices.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	er := &Reader{r: r}
+	stateStart, stateOffsets, err := er.readIndex(size)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if len(stateOffsets) != 1 || stateStart%sphr != 0 {
+		return nil, errors.Wrapf(ErrMalformedFile, "state index of %d states at slot %d", len(stateOffsets), stateStart)
+	}
+	er.era = uint64(stateStart / sphr)
+	er.state = stateOffsets[0]
+	if er.era == 0 {
+		return er, nil
+	}
+	indexLength := int64(headerLength + 8*(len(stateOffsets)+2))
+	blockStart, blockOffsets, err := er.readIndex(size - indexLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read block index")
+	}
+	if blockStart != StartSlot(er.era) || len(blockOffsets) != int(sphr) {
+		return nil, errors.Wrapf(ErrMalformedFile, "block index of %d blocks at slot %d", len(blockOffsets), blockStart)
+	}
+	er.blocks = blockOffsets
+	return er, nil
+}
+
+// Era returns the number of the era.
+func (r *Reader) Era() uint64 {
+	return r.era
+}
+
+// State returns the ssz encoded state of the era.
+func (r *Reader) State() ([]byte, error) {
+	return r.readRecord(r.state, typeCompressedState)
+}
+
+// Block returns the ssz encoded block of the given slot, or nil if the slot is empty.
+func (r *Reader) Block(slot primitives.Slot) ([]byte, error) {
+	start := StartSlot(r.era)
+	if r.era == 0 || slot < start || slot >= StateSlot(r.era) {
+		return nil, errors.Wrapf(ErrSlotOutOfRange, "block slot=%d, era=%d", slot, r.era)
+	}
+	if r.blocks[slot-start] == 0 {
+		return nil, nil
+	}
+	return r.readRecord(r.blocks[slot-start], typeCompressedBlock)
+}
+
+// readIndex reads the slot index ending at the given offset, and returns its starting slot and the absolute offsets
+// of its records, which are 0 for empty slots.
+func (r *Reader) readIndex(end int64) (primitives.Slot, []int64, error) {
+	if end < headerLength+16 {
+		return 0, nil, ErrMalformedFile
+	}
+	countEnc := make([]byte, 8)
+	if _, err := r.r.ReadAt(countEnc, end-8); err != nil {
+		return 0, nil, err
+	}
+	count := binary.LittleEndian.Uint64(countEnc)
+	if count > uint64(end/8) {
+		return 0, nil, errors.Wrapf(ErrMalformedFile, "slot index of %d entries", count)
+	}
+	start := end - int64(headerLength+8*(count+2))
+	if start < 0 {
+		return 0, nil, ErrMalformedFile
+	}
+	data, err := r.readRawRecord(start, typeSlotIndex)
+	if err != nil {
+		return 0, nil, err
+	}
+	if uint64(len(data)) != 8*(count+2) {
+		return 0, nil, errors.Wrapf(ErrMalformedFile, "slot index of %d bytes for %d entries", len(data), count)
+	}
+	offsets := make([]int64, count)
+	for i := range offsets {
+		rel := int64(binary.LittleEndian.Uint64(data[8*(i+1):]))
+		if rel != 0 {
+			offsets[i] = start + rel
+		}
+	}
+	return primitives.Slot(binary.LittleEndian.Uint64(data)), offsets, nil
+}
+
+// readRecord reads and decompresses the data of the record of the given type at the given offset.
+func (r *Reader) readRecord(offset int64, typ [2]byte) ([]byte, error) {
+	data, err := r.readRawRecord(offset, typ)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
+
+func (r *Reader) readRawRecord(offset int64, typ [2]byte) ([]byte, error) {
+	if offset < 0 {
+		return nil, ErrMalformedFile
+	}
+	header := make([]byte, headerLength)
+	if _, err := r.r.ReadAt(header, offset); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:2], typ[:]) {
+		return nil, errors.Wrapf(ErrMalformedFile, "record at offset %d has type %#x, expected %#x", offset, header[:2], typ)
+	}
+	data := make([]byte, binary.LittleEndian.Uint32(header[2:]))
+	if _, err := r.r.ReadAt(data, offset+headerLength); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func compress(enc []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(enc); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,71 @@
+package era
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 2)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.WriteBlock(sphr-1, []byte{1}), ErrSlotOutOfRange)
+	require.NoError(t, w.WriteBlock(sphr, []byte("first")))
+	require.NoError(t, w.WriteBlock(sphr+5, []byte("second")))
+	require.ErrorContains(t, "not written in order", w.WriteBlock(sphr+5, []byte("again")))
+	require.NoError(t, w.WriteBlock(2*sphr-1, bytes.Repeat([]byte("last"), 1000)))
+	require.ErrorContains(t, "not written", w.Close())
+	require.NoError(t, w.WriteState([]byte("state")))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), r.Era())
+	st, err := r.State()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("state"), st)
+	want := map[primitives.Slot][]byte{
+		sphr:       []byte("first"),
+		sphr + 5:   []byte("second"),
+		2*sphr - 1: bytes.Repeat([]byte("last"), 1000),
+	}
+	for slot := sphr; slot < 2*sphr; slot++ {
+		blk, err := r.Block(slot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want[slot], blk, "Unexpected block at slot %d", slot)
+	}
+	_, err = r.Block(2 * sphr)
+	require.ErrorIs(t, err, ErrSlotOutOfRange)
+
+	_, err = NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), int64(buf.Len()-1))
+	require.ErrorIs(t, err, ErrMalformedFile)
+}
+
+func TestWriterReader_Genesis(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.WriteBlock(0, []byte{1}), ErrSlotOutOfRange)
+	require.NoError(t, w.WriteState([]byte("genesis")))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), r.Era())
+	st, err := r.State()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("genesis"), st)
+}
+
+func TestFilename(t *testing.T) {
+	assert.Equal(t, "mainnet-00012-01020304.era", Filename("mainnet", 12, []byte{1, 2, 3, 4, 5, 6}))
+}
@@ -0,0 +1,217 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ErrNotFinalized is returned when exporting an era whose state is not finalized.
+var ErrNotFinalized = errors.New("era is not finalized")
+
+// ExportDB describes the set of DB methods needed to export era files.
+type ExportDB interface {
+	stategen.HistoryAccessor
+	GenesisState(ctx context.Context) (state.BeaconState, error)
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
+}
+
+// LastFinalizedEra returns the highest era whose state is finalized, which is the last era that can be exported.
+func LastFinalizedEra(ctx context.Context, d ExportDB) (uint64, error) {
+	cp, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	slot, err := primitives.Slot(params.BeaconConfig().SlotsPerEpoch).SafeMul(uint64(cp.Epoch))
+	if err != nil {
+		return 0, err
+	}
+	return uint64(slot / params.BeaconConfig().SlotsPerHistoricalRoot), nil
+}
+
+// Export writes the era files of the eras from the first to the last given era, included, to the given directory, and
+// returns their paths. The states of the eras are replayed from the states saved in the database, and all the blocks
+// of the eras must be saved, so the history of a node initialized via checkpoint sync can only be exported once
+// backfill is complete, and not below the slot below which history is pruned.
+func Export(ctx context.Context, d ExportDB, dir string, first, last uint64) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "era.Export")
+	defer span.End()
+
+	finalized, err := LastFinalizedEra(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if last > finalized {
+		return nil, errors.Wrapf(ErrNotFinalized, "era=%d, last finalized era=%d", last, finalized)
+	}
+	if err := os.MkdirAll(dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", dir)
+	}
+	ch := stategen.NewCanonicalHistory(d, &finalizedChecker{d: d}, &finalizedSlotter{slot: StateSlot(finalized)})
+	paths := make([]string, 0, last-first+1)
+	for era := first; era <= last; era++ {
+		if ctx.Err() != nil {
+			return paths, ctx.Err()
+		}
+		path, err := exportEra(ctx, d, ch, dir, era)
+		if err != nil {
+			return paths, errors.Wrapf(err, "could not export era %d", era)
+		}
+		log.WithFields(logrus.Fields{
+			"era":  era,
+			"path": path,
+		}).Info("Exported era")
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func exportEra(ctx context.Context, d ExportDB, ch *stategen.CanonicalHistory, dir string, era uint64) (string, error) {
+	var st state.BeaconState
+	var err error
+	if era == 0 {
+		st, err = d.GenesisState(ctx)
+	} else {
+		st, err = ch.ReplayerForSlot(StateSlot(era)-1).ReplayToSlot(ctx, StateSlot(era))
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "could not get state")
+	}
+	if st == nil || st.IsNil() {
+		return "", errors.New("state not found")
+	}
+	root, err := historicalRoot(st, era)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, Filename(params.BeaconConfig().ConfigName, era, root))
+	// The era file is written under a temporary name, so that an interrupted export does not leave a truncated file.
+	tmp := path + ".tmp"
+	f, err := os.Create(filepath.Clean(tmp))
+	if err != nil {
+		return "", err
+	}
+	if err := writeEra(ctx, d, f, st, era); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close era file")
+		}
+		if rmErr := os.Remove(tmp); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove partially written era file")
+		}
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp, path)
+}
+
+// writeEra writes the canonical blocks of an era, which are those listed in the block roots of its state, and the
+// state itself.
+func writeEra(ctx context.Context, d ExportDB, f *os.File, st state.BeaconState, era uint64) error {
+	w, err := NewWriter(f, era)
+	if err != nil {
+		return err
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	roots := st.BlockRoots()
+	var previous [32]byte
+	for slot := StartSlot(era); era > 0 && slot < StateSlot(era); slot++ {
+		// The block roots of the state repeat the root of the last block for empty slots.
+		root := bytesutil.ToBytes32(roots[slot%sphr])
+		if root == previous {
+			continue
+		}
+		previous = root
+		blk, err := d.Block(ctx, root)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if blk == nil || blk.IsNil() {
+			return errors.Errorf("block %#x of slot %d is missing from the database", root, slot)
+		}
+		if blk.Block().Slot() != slot {
+			continue
+		}
+		if blk.IsBlinded() {
+			return errors.Errorf("block %#x of slot %d is saved without its execution payload", root, slot)
+		}
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err := w.WriteBlock(slot, enc); err != nil {
+			return err
+		}
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err := w.WriteState(enc); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// historicalRoot returns the historical root of an era, which the state of the era holds as its last historical root
+// or historical summary, or the genesis validators root for era 0.
+func historicalRoot(st state.BeaconState, era uint64) ([]byte, error) {
+	if era == 0 {
+		return st.GenesisValidatorsRoot(), nil
+	}
+	roots, err := st.HistoricalRoots()
+	if err != nil {
+		return nil, err
+	}
+	if era <= uint64(len(roots)) {
+		return roots[era-1], nil
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get historical summary of era %d", era)
+	}
+	i := era - 1 - uint64(len(roots))
+	if i >= uint64(len(summaries)) {
+		return nil, errors.Errorf("state holds no historical root for era %d", era)
+	}
+	root, err := summaries[i].HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
+
+// finalizedChecker considers the blocks of the finalized block roots index canonical.
+type finalizedChecker struct {
+	d ExportDB
+}
+
+// IsCanonical --
+func (c *finalizedChecker) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return c.d.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+// finalizedSlotter prevents replaying states above the finalized era.
+type finalizedSlotter struct {
+	slot primitives.Slot
+}
+
+// CurrentSlot --
+func (s *finalizedSlotter) CurrentSlot() primitives.Slot {
+	return s.slot
+}
@@ -0,0 +1,178 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	coreBlocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// testBlockSlots are the slots of the blocks of the test chain, with 6 blocks in era 1, including the genesis block,
+// 3 in era 2, whose first slot is empty, and one in era 3. The state of era 2 is at the start of epoch 512.
+var (
+	testBlockSlots     = []primitives.Slot{1, 2, 5, 8000, 8191, 8193, 9000, 16383, 16390}
+	testFinalizedEpoch = primitives.Epoch(512)
+	eraBlocks          = map[uint64]int{1: 6, 2: 3}
+)
+
+// testChain is a chain of blocks, with the genesis state and the states of the first eras.
+type testChain struct {
+	genesis state.BeaconState
+	blocks  []interfaces.ReadOnlySignedBeaconBlock
+	roots   [][32]byte
+	states  map[[32]byte]state.BeaconState
+	// eraStates holds the state at the slot of each era, by era.
+	eraStates []state.BeaconState
+}
+
+// blockAt returns the index of the latest block at or below the given slot.
+func (c *testChain) blockAt(slot primitives.Slot) int {
+	i := 0
+	for i+1 < len(c.blocks) && c.blocks[i+1].Block().Slot() <= slot {
+		i++
+	}
+	return i
+}
+
+// newTestChain builds a chain whose blocks are at the given slots, following the genesis block.
+func newTestChain(t *testing.T, blockSlots ...primitives.Slot) *testChain {
+	// The database holds the embedded genesis state of the network of a known config name.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(t, 64)
+	genesis, err := coreBlocks.NewGenesisBlockForState(ctx, st)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	c := &testChain{
+		genesis:   st.Copy(),
+		blocks:    []interfaces.ReadOnlySignedBeaconBlock{genesis},
+		roots:     [][32]byte{genesisRoot},
+		states:    map[[32]byte]state.BeaconState{genesisRoot: st.Copy()},
+		eraStates: []state.BeaconState{st.Copy()},
+	}
+	for _, slot := range blockSlots {
+		for next := StateSlot(uint64(len(c.eraStates))); next <= slot; next = StateSlot(uint64(len(c.eraStates))) {
+			eraState, err := transition.ProcessSlots(ctx, st.Copy(), next)
+			require.NoError(t, err)
+			c.eraStates = append(c.eraStates, eraState)
+		}
+		// The block generator does not process skipped slots, so the block is built from the state at its slot.
+		atSlot, err := transition.ProcessSlots(ctx, st.Copy(), slot)
+		require.NoError(t, err)
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = c.roots[len(c.roots)-1][:]
+		b.Block.ProposerIndex, err = helpers.BeaconProposerIndex(ctx, atSlot)
+		require.NoError(t, err)
+		b.Block.Body.Eth1Data = atSlot.Eth1Data()
+		b.Block.Body.RandaoReveal, err = util.RandaoReveal(atSlot, slots.ToEpoch(slot), keys)
+		require.NoError(t, err)
+		// The signature is computed from the state at the previous slot, which the state transition of the block starts
+		// from.
+		previous := st.Copy()
+		if slot > st.Slot()+1 {
+			previous, err = transition.ProcessSlots(ctx, previous, slot-1)
+			require.NoError(t, err)
+		}
+		sig, err := util.BlockSignature(previous, b.Block, keys)
+		require.NoError(t, err)
+		b.Signature = sig.Marshal()
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		c.blocks = append(c.blocks, blk)
+		c.roots = append(c.roots, root)
+		c.states[root] = st.Copy()
+	}
+	return c
+}
+
+// saveFinalized saves the chain to a new database, finalized at the given epoch, as a node synced from genesis holds
+// it. The database must be closed by the caller, so that another database can be opened.
+func (c *testChain) saveFinalized(t *testing.T, epoch primitives.Epoch) *kv.Store {
+	ctx := context.Background()
+	d, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, d.SaveGenesisData(ctx, c.genesis))
+	require.NoError(t, d.SaveBlocks(ctx, c.blocks[1:]))
+	start, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	i := c.blockAt(start)
+	require.NoError(t, d.SaveState(ctx, c.states[c.roots[i]], c.roots[i]))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: epoch, Root: c.roots[i][:]}))
+	return d
+}
+
+func TestExport(t *testing.T) {
+	c := newTestChain(t, testBlockSlots...)
+	ctx := context.Background()
+	d := c.saveFinalized(t, testFinalizedEpoch)
+	defer func() {
+		require.NoError(t, d.Close())
+	}()
+	last, err := LastFinalizedEra(ctx, d)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), last)
+
+	dir := t.TempDir()
+	_, err = Export(ctx, d, dir, 0, 3)
+	require.ErrorIs(t, err, ErrNotFinalized)
+	paths, err := Export(ctx, d, dir, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(paths))
+	for i, p := range paths {
+		root, err := historicalRoot(c.eraStates[i], uint64(i))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, Filename(params.BeaconConfig().ConfigName, uint64(i), root)), p)
+	}
+
+	files, err := openFiles(paths)
+	require.NoError(t, err)
+	for _, f := range files {
+		st, err := f.state()
+		require.NoError(t, err)
+		want, err := c.eraStates[f.Era()].HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "Unexpected state of era %d", f.Era())
+		if f.Era() == 0 {
+			continue
+		}
+		roots := st.BlockRoots()
+		count := 0
+		for slot := StartSlot(f.Era()); slot < StateSlot(f.Era()); slot++ {
+			blk, root, err := f.block(slot, roots)
+			require.NoError(t, err)
+			if blk == nil {
+				continue
+			}
+			assert.Equal(t, c.roots[c.blockAt(slot)], root)
+			count++
+		}
+		// Era 1 holds the genesis block as well.
+		assert.Equal(t, eraBlocks[f.Era()], count, "Unexpected number of blocks in era %d", f.Era())
+		require.NoError(t, f.f.Close())
+	}
+}
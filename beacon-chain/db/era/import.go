@@ -0,0 +1,398 @@
+package era
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// importBatchSize is the maximum number of blocks saved at once.
+const importBatchSize = 256
+
+// ErrChainMismatch is returned when the blocks of an era file do not form the chain expected by the database.
+var ErrChainMismatch = errors.New("era does not match the chain of the database")
+
+// ImportDB describes the set of DB methods needed to import era files.
+type ImportDB interface {
+	backfill.BackfillDB
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	SaveGenesisData(ctx context.Context, genesisState state.BeaconState) error
+	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	SaveState(ctx context.Context, st state.ReadOnlyBeaconState, blockRoot [32]byte) error
+	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
+	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
+	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
+	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillFinalizedBlockRoots(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+}
+
+// Import saves the finalized history held by the given era files into the database. The blocks of every era are
+// checked against the block roots of the state of the era, and against the blocks of the neighbouring eras.
+//
+// An empty database is initialized from genesis, which requires the eras to be contiguous from era 0: the blocks of
+// the eras are marked as finalized, and the state of the last era becomes the finalized checkpoint and the head from
+// which the node syncs. The states of the eras are trusted as is, just like the state given to checkpoint sync.
+//
+// The database of a node initialized via checkpoint sync is backfilled from the eras instead, down from the lowest
+// block backfilled so far, as if the blocks were downloaded from peers, and the states of the eras are saved so that
+// the history can be replayed from them. Eras which are not below the lowest backfilled block are skipped.
+func Import(ctx context.Context, d ImportDB, paths []string) error {
+	ctx, span := trace.StartSpan(ctx, "era.Import")
+	defer span.End()
+
+	files, err := openFiles(paths)
+	defer func() {
+		for _, f := range files {
+			if err := f.f.Close(); err != nil {
+				log.WithError(err).Error("Could not close era file")
+			}
+		}
+	}()
+	if err != nil {
+		return err
+	}
+	if _, err := d.GenesisBlockRoot(ctx); err != nil {
+		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
+			return importFromGenesis(ctx, d, files)
+		}
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	return importBackfill(ctx, d, files)
+}
+
+// importFromGenesis imports the eras into an empty database.
+func importFromGenesis(ctx context.Context, d ImportDB, files []*eraFile) error {
+	for i, f := range files {
+		if f.Era() != uint64(i) {
+			return fmt.Errorf("eras must be contiguous from era 0 to initialize an empty database, era %d is missing", i)
+		}
+	}
+	genesis, err := files[0].state()
+	if err != nil {
+		return err
+	}
+	if err := d.SaveGenesisData(ctx, genesis); err != nil {
+		return errors.Wrap(err, "could not save genesis data")
+	}
+	parent, err := d.GenesisBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	var cp *ethpb.Checkpoint
+	for _, f := range files[1:] {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		st, err := f.state()
+		if err != nil {
+			return err
+		}
+		roots := st.BlockRoots()
+		batch := make([]interfaces.ReadOnlySignedBeaconBlock, 0, importBatchSize)
+		for slot := StartSlot(f.Era()); slot < StateSlot(f.Era()); slot++ {
+			blk, root, err := f.block(slot, roots)
+			if err != nil {
+				return err
+			}
+			if blk == nil {
+				continue
+			}
+			if slot == params.BeaconConfig().GenesisSlot {
+				// The genesis block is saved with the genesis state.
+				if root != parent {
+					return errors.Wrapf(ErrChainMismatch, "genesis block %#x of era %d does not match the genesis state", root, f.Era())
+				}
+				continue
+			}
+			if blk.Block().ParentRoot() != parent {
+				return errors.Wrapf(ErrChainMismatch, "block %#x of era %d at slot %d is not a child of block %#x", root, f.Era(), slot, parent)
+			}
+			parent = root
+			batch = append(batch, blk)
+			if len(batch) == importBatchSize {
+				if err := d.SaveBlocks(ctx, batch); err != nil {
+					return errors.Wrap(err, "could not save blocks")
+				}
+				batch = batch[:0]
+			}
+		}
+		if err := d.SaveBlocks(ctx, batch); err != nil {
+			return errors.Wrap(err, "could not save blocks")
+		}
+		if latestRoot(st, roots) != parent {
+			return errors.Wrapf(ErrChainMismatch, "era %d is missing blocks after block %#x", f.Era(), parent)
+		}
+		if err := saveState(ctx, d, st, parent); err != nil {
+			return err
+		}
+		cp = &ethpb.Checkpoint{Epoch: slots.ToEpoch(st.Slot()), Root: parent[:]}
+		if err := d.SaveFinalizedCheckpoint(ctx, cp); err != nil {
+			return errors.Wrap(err, "could not save finalized checkpoint")
+		}
+		log.WithFields(logrus.Fields{
+			"era":  f.Era(),
+			"path": f.path,
+		}).Info("Imported era")
+	}
+	if cp == nil {
+		return nil
+	}
+	if err := d.SaveJustifiedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	return d.SaveHeadBlockRoot(ctx, bytesutil.ToBytes32(cp.Root))
+}
+
+// importBackfill backfills the database of a node initialized via checkpoint sync from the eras.
+func importBackfill(ctx context.Context, d ImportDB, files []*eraFile) error {
+	status := backfill.NewStatus(d)
+	if err := status.Reload(ctx); err != nil {
+		return errors.Wrap(err, "could not load backfill status")
+	}
+	if status.Complete() {
+		log.Info("The database already holds the chain history, no era is imported")
+		return nil
+	}
+	genesisRoot, err := d.GenesisBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	bfRoot, err := d.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	bfBlock, err := d.Block(ctx, bfRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get backfill block %#x", bfRoot)
+	}
+	if bfBlock == nil || bfBlock.IsNil() {
+		return errors.Errorf("backfill block %#x is missing", bfRoot)
+	}
+	end := status.EndGap()
+	expected := bfBlock.Block().ParentRoot()
+	// checked is the lowest slot whose block was imported, or found empty in the eras imported so far.
+	checked := end
+	for i := len(files) - 1; i >= 0 && !status.Complete(); i-- {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		f := files[i]
+		if f.Era() == 0 || StartSlot(f.Era()) >= checked {
+			continue
+		}
+		if StateSlot(f.Era()) < checked {
+			return fmt.Errorf("era %d is missing to backfill the blocks below slot %d", f.Era()+1, checked)
+		}
+		st, err := f.state()
+		if err != nil {
+			return err
+		}
+		roots := st.BlockRoots()
+		top := StateSlot(f.Era())
+		if status.EndGap() < top {
+			top = status.EndGap()
+		}
+		batch := make([]interfaces.ReadOnlySignedBeaconBlock, 0, importBatchSize)
+		genesis := false
+		for slot := top; slot > StartSlot(f.Era()); slot-- {
+			blk, root, err := f.block(slot-1, roots)
+			if err != nil {
+				return err
+			}
+			if blk == nil {
+				continue
+			}
+			if root != expected {
+				return errors.Wrapf(ErrChainMismatch, "block %#x of era %d at slot %d is not the parent %#x of the lowest backfilled block", root, f.Era(), slot-1, expected)
+			}
+			if root == genesisRoot {
+				genesis = true
+				break
+			}
+			expected = blk.Block().ParentRoot()
+			batch = append(batch, blk)
+			if len(batch) == importBatchSize {
+				if err := saveBackfillBatch(ctx, d, status, batch); err != nil {
+					return err
+				}
+				batch = batch[:0]
+			}
+		}
+		if err := saveBackfillBatch(ctx, d, status, batch); err != nil {
+			return err
+		}
+		if genesis {
+			if err := status.Advance(ctx, params.BeaconConfig().GenesisSlot, genesisRoot); err != nil {
+				return err
+			}
+		}
+		checked = StartSlot(f.Era())
+		// The state of an era is saved once all the blocks of the era are, unless the node already held them.
+		if root := latestRoot(st, roots); StateSlot(f.Era()) <= end && d.HasBlock(ctx, root) && !d.HasState(ctx, root) {
+			if err := saveState(ctx, d, st, root); err != nil {
+				return err
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"era":          f.Era(),
+			"path":         f.path,
+			"backfillSlot": status.EndGap(),
+		}).Info("Imported era")
+	}
+	if !status.Complete() {
+		log.WithField("backfillSlot", status.EndGap()).Warn("Imported eras do not reach genesis, backfill downloads the remaining blocks from peers")
+	}
+	return nil
+}
+
+// saveBackfillBatch saves blocks ordered by decreasing slot below the lowest backfilled block, and moves the backfill
+// position down to the lowest of them.
+func saveBackfillBatch(ctx context.Context, d ImportDB, status *backfill.Status, batch []interfaces.ReadOnlySignedBeaconBlock) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, len(batch))
+	for i, b := range batch {
+		blks[len(batch)-1-i] = b
+	}
+	if err := d.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := d.SaveBackfillFinalizedBlockRoots(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save finalized block roots")
+	}
+	lowest := blks[0].Block()
+	root, err := lowest.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	return status.Advance(ctx, lowest.Slot(), root)
+}
+
+// saveState saves the state of an era, along with its summary, under the root of the latest block it holds.
+func saveState(ctx context.Context, d ImportDB, st state.BeaconState, root [32]byte) error {
+	if err := d.SaveState(ctx, st, root); err != nil {
+		return errors.Wrapf(err, "could not save state at slot %d", st.Slot())
+	}
+	return d.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: st.Slot(), Root: root[:]})
+}
+
+// latestRoot returns the root of the latest block at or below the last slot of the era of the given state.
+func latestRoot(st state.BeaconState, roots [][]byte) [32]byte {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	return bytesutil.ToBytes32(roots[(st.Slot()-1)%sphr])
+}
+
+type eraFile struct {
+	*Reader
+	f    *os.File
+	path string
+}
+
+// openFiles opens the given era files, and returns them ordered by era.
+func openFiles(paths []string) ([]*eraFile, error) {
+	files := make([]*eraFile, 0, len(paths))
+	for _, p := range paths {
+		f, err := os.Open(filepath.Clean(p))
+		if err != nil {
+			return files, err
+		}
+		ef := &eraFile{f: f, path: p}
+		files = append(files, ef)
+		info, err := f.Stat()
+		if err != nil {
+			return files, err
+		}
+		ef.Reader, err = NewReader(f, info.Size())
+		if err != nil {
+			return files, errors.Wrapf(err, "could not read era file %s", p)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Era() < files[j].Era()
+	})
+	for i := 1; i < len(files); i++ {
+		if files[i].Era() == files[i-1].Era() {
+			return files, fmt.Errorf("era %d is held by both %s and %s", files[i].Era(), files[i-1].path, files[i].path)
+		}
+	}
+	if len(files) == 0 {
+		return files, errors.New("no era file to import")
+	}
+	return files, nil
+}
+
+// state reads the state of the era, which must belong to the network of the node.
+func (f *eraFile) state() (state.BeaconState, error) {
+	enc, err := f.State()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read state of era %d", f.Era())
+	}
+	cf, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect the fork of the state of era %d", f.Era())
+	}
+	if _, ok := params.BeaconConfig().ForkVersionSchedule[cf.Version]; !ok {
+		return nil, fmt.Errorf("config mismatch, node is configured for %s, era %d is for %s", params.BeaconConfig().ConfigName, f.Era(), cf.Config.ConfigName)
+	}
+	st, err := cf.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal state of era %d", f.Era())
+	}
+	if st.Slot() != StateSlot(f.Era()) {
+		return nil, errors.Wrapf(ErrMalformedFile, "state of era %d is at slot %d", f.Era(), st.Slot())
+	}
+	return st, nil
+}
+
+// block reads the block of the given slot, or nil if the slot is empty, and checks that it is in the block roots of
+// the state of the era.
+func (f *eraFile) block(slot primitives.Slot, roots [][]byte) (interfaces.ReadOnlySignedBeaconBlock, [32]byte, error) {
+	enc, err := f.Block(slot)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrapf(err, "could not read block of era %d at slot %d", f.Era(), slot)
+	}
+	if enc == nil {
+		return nil, [32]byte{}, nil
+	}
+	v, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(slots.ToEpoch(slot))
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	cf, err := detect.FromForkVersion(v)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	blk, err := cf.UnmarshalBeaconBlock(enc)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrapf(err, "could not unmarshal block of era %d at slot %d", f.Era(), slot)
+	}
+	if blk.Block().Slot() != slot {
+		return nil, [32]byte{}, errors.Wrapf(ErrMalformedFile, "block of era %d at slot %d is for slot %d", f.Era(), slot, blk.Block().Slot())
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if root != bytesutil.ToBytes32(roots[slot%params.BeaconConfig().SlotsPerHistoricalRoot]) {
+		return nil, [32]byte{}, errors.Wrapf(ErrChainMismatch, "block %#x of era %d at slot %d is not in the block roots of the state", root, f.Era(), slot)
+	}
+	return blk, root, nil
+}
@@ -0,0 +1,140 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// exportTestChain builds the test chain, and exports its finalized eras.
+func exportTestChain(t *testing.T) (*testChain, []string) {
+	c := newTestChain(t, testBlockSlots...)
+	d := c.saveFinalized(t, testFinalizedEpoch)
+	paths, err := Export(context.Background(), d, t.TempDir(), 0, 2)
+	require.NoError(t, err)
+	require.NoError(t, d.Close())
+	return c, paths
+}
+
+func TestImport_Genesis(t *testing.T) {
+	c, paths := exportTestChain(t)
+	ctx := context.Background()
+	d := testDB.SetupDB(t)
+	require.NoError(t, Import(ctx, d, paths))
+
+	genesisRoot, err := d.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, c.roots[0], genesisRoot)
+	for i, root := range c.roots {
+		imported := c.blocks[i].Block().Slot() < StateSlot(2)
+		assert.Equal(t, imported, d.HasBlock(ctx, root), "Unexpected block at slot %d", c.blocks[i].Block().Slot())
+		assert.Equal(t, imported, d.IsFinalizedBlock(ctx, root), "Unexpected finalized block at slot %d", c.blocks[i].Block().Slot())
+	}
+
+	// The state of era 2 is the finalized checkpoint and the head, held by the latest block of era 2.
+	latest := c.roots[c.blockAt(StateSlot(2)-1)]
+	cp, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testFinalizedEpoch, cp.Epoch)
+	assert.Equal(t, latest, bytesutil.ToBytes32(cp.Root))
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, latest, headRoot)
+	for era := uint64(1); era <= 2; era++ {
+		st, err := d.State(ctx, c.roots[c.blockAt(StateSlot(era)-1)])
+		require.NoError(t, err)
+		want, err := c.eraStates[era].HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "Unexpected state of era %d", era)
+	}
+
+	status := backfill.NewStatus(d)
+	require.NoError(t, status.Reload(ctx))
+	assert.Equal(t, true, status.Complete())
+}
+
+func TestImport_Backfill(t *testing.T) {
+	c, paths := exportTestChain(t)
+	ctx := context.Background()
+	// The node is initialized via checkpoint sync from the block at slot 9000.
+	origin := c.blockAt(9000)
+	originRoot := c.roots[origin]
+	d, ok := testDB.SetupDB(t).(*kv.Store)
+	require.Equal(t, true, ok)
+	require.NoError(t, d.SaveGenesisData(ctx, c.genesis))
+	require.NoError(t, d.SaveBlock(ctx, c.blocks[origin]))
+	require.NoError(t, d.SaveState(ctx, c.states[originRoot], originRoot))
+	require.NoError(t, d.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	require.NoError(t, d.SaveBackfillBlockRoot(ctx, originRoot))
+
+	// Era 2 is needed to backfill down from the origin block.
+	require.ErrorContains(t, "era 2 is missing", Import(ctx, d, paths[1:2]))
+
+	// Era 2 holds the blocks down to slot 8193.
+	require.NoError(t, Import(ctx, d, paths[2:]))
+	status := backfill.NewStatus(d)
+	require.NoError(t, status.Reload(ctx))
+	assert.Equal(t, false, status.Complete())
+	assert.Equal(t, primitives.Slot(8193), status.EndGap())
+	// The state of era 2 is above the origin block.
+	assert.Equal(t, false, d.HasState(ctx, c.roots[c.blockAt(StateSlot(2)-1)]))
+
+	// Era 1 holds the blocks down to genesis, and era 2 is skipped.
+	require.NoError(t, Import(ctx, d, paths))
+	require.NoError(t, status.Reload(ctx))
+	assert.Equal(t, true, status.Complete())
+	for i, root := range c.roots[1:origin] {
+		assert.Equal(t, true, d.HasBlock(ctx, root), "Missing block at slot %d", c.blocks[i+1].Block().Slot())
+		assert.Equal(t, true, d.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", c.blocks[i+1].Block().Slot())
+	}
+	st, err := d.State(ctx, c.roots[c.blockAt(StateSlot(1)-1)])
+	require.NoError(t, err)
+	want, err := c.eraStates[1].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// There is nothing left to import.
+	require.NoError(t, Import(ctx, d, paths))
+}
+
+func TestImport_ChainMismatch(t *testing.T) {
+	c, paths := exportTestChain(t)
+	ctx := context.Background()
+
+	// Era 1 is rewritten without the block at slot 8000.
+	path := filepath.Join(t.TempDir(), "era1.era")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w, err := NewWriter(f, 1)
+	require.NoError(t, err)
+	for _, blk := range c.blocks {
+		if slot := blk.Block().Slot(); slot < StateSlot(1) && slot != 8000 {
+			enc, err := blk.MarshalSSZ()
+			require.NoError(t, err)
+			require.NoError(t, w.WriteBlock(slot, enc))
+		}
+	}
+	enc, err := c.eraStates[1].MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, w.WriteState(enc))
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	err = Import(ctx, testDB.SetupDB(t), []string{paths[0], path})
+	require.ErrorIs(t, err, ErrChainMismatch)
+}
@@ -17,5 +17,11 @@ var ErrNotFoundOriginBlockRoot = kv.ErrNotFoundOriginBlockRoot
 // ErrNotFoundBackfillBlockRoot wraps ErrNotFound for an error specific to the backfill block root.
 var ErrNotFoundBackfillBlockRoot = kv.ErrNotFoundBackfillBlockRoot
 
+// ErrNotFoundStateReconstructionRoot wraps ErrNotFound for an error specific to the state reconstruction progress.
+var ErrNotFoundStateReconstructionRoot = kv.ErrNotFoundStateReconstructionRoot
+
 // ErrNotFoundGenesisBlockRoot means no genesis block root was found, indicating the db was not initialized with genesis
 var ErrNotFoundGenesisBlockRoot = kv.ErrNotFoundGenesisBlockRoot
+
+// ErrNotFoundForkChoiceSnapshot wraps ErrNotFound for an error specific to the fork choice snapshot.
+var ErrNotFoundForkChoiceSnapshot = kv.ErrNotFoundForkChoiceSnapshot
@@ -54,9 +54,19 @@ type ReadOnlyDatabase interface {
 	// Fee recipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	BuilderPreferences(ctx context.Context) (map[primitives.ValidatorIndex]*ethpb.BuilderPreferences, error)
+	// Builder bid audit log.
+	BuilderBidRecords(ctx context.Context, startSlot, endSlot primitives.Slot) ([]*ethpb.BuilderBidRecord, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	StateReconstructionRoot(ctx context.Context) ([32]byte, error)
+	// Pruning support.
+	EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error)
+	LowestFinalizedBlockRootAtOrAbove(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error)
+	HighestArchivedBlockRootAtOrBelow(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error)
+	// Fork choice persistence.
+	ForkChoiceSnapshot(ctx context.Context) (*ethpb.ForkChoiceSnapshot, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -88,6 +98,9 @@ type NoHeadAccessDatabase interface {
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	SaveBuilderPreferencesByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, prefs []*ethpb.BuilderPreferences) error
+	// Builder bid audit log.
+	SaveBuilderBidRecord(ctx context.Context, record *ethpb.BuilderBidRecord) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
 }
@@ -108,6 +121,15 @@ type HeadAccessDatabase interface {
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillFinalizedBlockRoots(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	SaveStateReconstructionRoot(ctx context.Context, blockRoot [32]byte) error
+
+	// Pruning of finalized history.
+	SaveEarliestAvailableSlot(ctx context.Context, slot primitives.Slot) error
+	PruneBlocksBefore(ctx context.Context, slot primitives.Slot) (int, error)
+
+	// Fork choice persistence.
+	SaveForkChoiceSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
@@ -150,10 +172,18 @@ type SlasherDatabase interface {
 	PruneProposalsAtEpoch(
 		ctx context.Context, maxEpoch primitives.Epoch,
 	) (numPruned uint, err error)
+	PruneSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte,
+	) (numPruned uint, err error)
+	PruneLastEpochsWrittenForValidators(
+		ctx context.Context, maxEpoch primitives.Epoch, validatorIndices []primitives.ValidatorIndex,
+	) error
 	HighestAttestations(
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	BucketStats(ctx context.Context) ([]*ethpb.SlasherBucketStats, error)
+	DatabaseSize() (uint64, error)
 	DatabasePath() string
 	ClearDB() error
 }
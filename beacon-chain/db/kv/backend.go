@@ -0,0 +1,201 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// PebbleDirName is the name of the directory of the beacon node database when it is stored with pebble.
+	PebbleDirName = "beaconchain.pebble"
+
+	// migrationDirName is the name of the directory holding the database being written by MigrateBackend.
+	migrationDirName = "migrate-backend"
+)
+
+var errDatabaseLocked = errors.New("cannot obtain database lock, database may be in use by another process")
+
+type storeConfig struct {
+	backend backend.Kind
+}
+
+// Option configures how NewKVStore opens a database.
+type Option func(c *storeConfig)
+
+// WithBackend sets the backend a new database is stored with. Opening an existing database stored with another
+// backend fails, as it must be migrated first.
+func WithBackend(kind backend.Kind) Option {
+	return func(c *storeConfig) {
+		c.backend = kind
+	}
+}
+
+// StoragePath returns the path of the file or directory holding the beacon node database stored with the given
+// backend in the given directory.
+func StoragePath(dirPath string, kind backend.Kind) string {
+	if kind == backend.Pebble {
+		return path.Join(dirPath, PebbleDirName)
+	}
+	return KVStoreDatafilePath(dirPath)
+}
+
+// DetectBackend returns the backend the database in the given directory is stored with, and false if the directory
+// holds no database.
+func DetectBackend(dirPath string) (backend.Kind, bool, error) {
+	var found []backend.Kind
+	if file.FileExists(StoragePath(dirPath, backend.Bolt)) {
+		found = append(found, backend.Bolt)
+	}
+	hasPebble, err := file.HasDir(StoragePath(dirPath, backend.Pebble))
+	if err != nil {
+		return "", false, err
+	}
+	if hasPebble {
+		found = append(found, backend.Pebble)
+	}
+	switch len(found) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return found[0], true, nil
+	default:
+		return "", false, errors.Errorf("%s holds a database for each of the backends %v", dirPath, found)
+	}
+}
+
+// storeBackend returns the backend to open the database in the given directory with.
+func storeBackend(dirPath string, requested backend.Kind) (backend.Kind, error) {
+	kind, exists, err := DetectBackend(dirPath)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case !exists && requested != "":
+		return requested, nil
+	case !exists:
+		return backend.Bolt, nil
+	case requested != "" && requested != kind:
+		return "", errors.Errorf("the database in %s is stored with %s rather than %s, it can be converted with "+
+			"prysmctl db migrate-backend", dirPath, kind, requested)
+	default:
+		return kind, nil
+	}
+}
+
+func openBackend(dirPath string, kind backend.Kind) (backend.DB, error) {
+	switch kind {
+	case backend.Bolt:
+		return openBolt(KVStoreDatafilePath(dirPath))
+	case backend.Pebble:
+		dir := StoragePath(dirPath, kind)
+		log.Infof("Opening Pebble DB at %s", dir)
+		db, err := backend.OpenPebble(dir)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open pebble database, it may be in use by another process")
+		}
+		return db, nil
+	default:
+		return nil, errors.Wrapf(backend.ErrUnknownKind, "%q", kind)
+	}
+}
+
+func openBolt(datafile string) (backend.DB, error) {
+	if err := compactIfPending(datafile); err != nil {
+		return nil, err
+	}
+	log.Infof("Opening Bolt DB at %s", datafile)
+	boltDB, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout:         1 * time.Second,
+			InitialMmapSize: mmapSize,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errDatabaseLocked
+		}
+		return nil, err
+	}
+	boltDB.AllocSize = boltAllocSize
+	return backend.WrapBolt(boltDB), nil
+}
+
+// MigrateBackend converts the database in the given directory, which must not be in use, to the given backend. The
+// database is copied into a new database stored with that backend, which replaces the original once complete.
+func MigrateBackend(ctx context.Context, dirPath string, to backend.Kind) error {
+	from, exists, err := DetectBackend(dirPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("no database found in %s", dirPath)
+	}
+	if from == to {
+		return errors.Errorf("the database in %s is already stored with %s", dirPath, to)
+	}
+	// The new database is written in a temporary directory, so that an interrupted migration leaves the original
+	// database untouched.
+	tmpDir := path.Join(dirPath, migrationDirName)
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	if err := file.MkdirAll(tmpDir); err != nil {
+		return err
+	}
+	if err := copyBackend(ctx, dirPath, from, tmpDir, to); err != nil {
+		return err
+	}
+	if err := os.Rename(StoragePath(tmpDir, to), StoragePath(dirPath, to)); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"from": from,
+		"to":   to,
+	}).Info("Migrated database, removing the original")
+	return os.RemoveAll(StoragePath(dirPath, from))
+}
+
+func copyBackend(ctx context.Context, srcDir string, from backend.Kind, dstDir string, to backend.Kind) (err error) {
+	src, err := openBackend(srcDir, from)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := src.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	dst, err := openBackend(dstDir, to)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dst.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	start := time.Now()
+	log.WithFields(logrus.Fields{
+		"from": src.Path(),
+		"to":   dst.Path(),
+	}).Info("Copying database, this may take a while")
+	if err := backend.Copy(ctx, dst, src); err != nil {
+		return errors.Wrap(err, "could not copy database")
+	}
+	log.WithField("duration", time.Since(start)).Info("Copied database")
+	return nil
+}
@@ -0,0 +1,86 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// otherBackend returns a backend other than the one the tests are run with.
+func otherBackend() backend.Kind {
+	if testBackend == backend.Bolt {
+		return backend.Pebble
+	}
+	return backend.Bolt
+}
+
+func TestNewKVStore_Backend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	_, exists, err := DetectBackend(dir)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	db, err := NewKVStore(ctx, dir, WithBackend(testBackend))
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	kind, exists, err := DetectBackend(dir)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, testBackend, kind)
+
+	// An existing database is opened with its backend.
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, testBackend, db.db.Kind())
+	require.NoError(t, db.Close())
+
+	_, err = NewKVStore(ctx, dir, WithBackend(otherBackend()))
+	require.ErrorContains(t, "migrate-backend", err)
+}
+
+func TestMigrateBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, WithBackend(testBackend))
+	require.NoError(t, err)
+	blks := makeBlocks(t, 0, 10, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := blks[9].Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(9))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, db.Close())
+
+	require.ErrorContains(t, "already stored", MigrateBackend(ctx, dir, testBackend))
+	require.NoError(t, MigrateBackend(ctx, dir, otherBackend()))
+	// The original database is removed, otherwise the backend of the directory would be ambiguous.
+	kind, _, err := DetectBackend(dir)
+	require.NoError(t, err)
+	assert.Equal(t, otherBackend(), kind)
+
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	assert.Equal(t, otherBackend(), db.db.Kind())
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+	}
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blks[9].Block().Slot(), head.Block().Slot())
+	saved, err := db.State(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), saved.Slot())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
@@ -53,6 +54,9 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 		return err
 	}
 	copyDB.AllocSize = boltAllocSize
+	// Backups are always bolt files, whatever the backend of the database, so that they can be restored by copying
+	// them in place of the database file.
+	dst := backend.WrapBolt(copyDB)
 
 	defer func() {
 		if err := copyDB.Close(); err != nil {
@@ -63,8 +67,8 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	// bucket to use less memory usage when backing up.
 	var bucketKeys [][]byte
 	bucketMap := make(map[string][][]byte)
-	err = s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+	err = s.db.View(func(tx backend.Tx) error {
+		return tx.ForEach(func(name []byte, b backend.Bucket) error {
 			newName := make([]byte, len(name))
 			copy(newName, name)
 			bucketKeys = append(bucketKeys, newName)
@@ -96,9 +100,9 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 		log.Debugf("Copying bucket %s\n", k)
 		innerKeys := bucketMap[string(k)]
 		for _, ik := range innerKeys {
-			err = s.db.View(func(tx *bolt.Tx) error {
+			err = s.db.View(func(tx backend.Tx) error {
 				bkt := tx.Bucket(k)
-				return copyDB.Update(func(tx2 *bolt.Tx) error {
+				return dst.Update(func(tx2 backend.Tx) error {
 					b2, err := tx2.CreateBucketIfNotExists(k)
 					if err != nil {
 						return err
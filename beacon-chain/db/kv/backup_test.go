@@ -13,7 +13,7 @@ import (
 )
 
 func TestStore_Backup(t *testing.T) {
-	db, err := NewKVStore(context.Background(), t.TempDir())
+	db, err := NewKVStore(context.Background(), t.TempDir(), WithBackend(testBackend))
 	require.NoError(t, err, "Failed to instantiate DB")
 	ctx := context.Background()
 
@@ -53,7 +53,7 @@ func TestStore_Backup(t *testing.T) {
 }
 
 func TestStore_BackupMultipleBuckets(t *testing.T) {
-	db, err := NewKVStore(context.Background(), t.TempDir())
+	db, err := NewKVStore(context.Background(), t.TempDir(), WithBackend(testBackend))
 	require.NoError(t, err, "Failed to instantiate DB")
 	ctx := context.Background()
 
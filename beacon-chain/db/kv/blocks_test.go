@@ -108,6 +108,21 @@ func TestStore_SaveBackfillBlockRoot(t *testing.T) {
 
 }
 
+func TestStore_SaveStateReconstructionRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.StateReconstructionRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFoundStateReconstructionRoot)
+
+	var expected [32]byte
+	copy(expected[:], []byte{0x42})
+	require.NoError(t, db.SaveStateReconstructionRoot(ctx, expected))
+	actual, err := db.StateReconstructionRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
 func TestStore_SaveBlock_NoDuplicates(t *testing.T) {
 	BlockCacheSize = 1
 	slot := primitives.Slot(20)
@@ -961,3 +976,34 @@ func TestStore_RegistrationsByValidatorID(t *testing.T) {
 	want := errors.Wrap(ErrNotFoundFeeRecipient, "validator id 3")
 	require.Equal(t, want.Error(), err.Error())
 }
+
+func TestStore_BuilderPreferences(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	ids := []primitives.ValidatorIndex{0, 0}
+	prefs := []*ethpb.BuilderPreferences{{}}
+	require.ErrorContains(t, "ids and preferences must be the same length", db.SaveBuilderPreferencesByValidatorIDs(ctx, ids, prefs))
+
+	saved, err := db.BuilderPreferences(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(saved))
+
+	ids = []primitives.ValidatorIndex{0, 1}
+	prefs = []*ethpb.BuilderPreferences{
+		{Relays: []string{"https://a.relay"}, MinBid: 1},
+		{MinBid: 2},
+	}
+	require.NoError(t, db.SaveBuilderPreferencesByValidatorIDs(ctx, ids, prefs))
+	saved, err = db.BuilderPreferences(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(saved))
+	require.DeepEqual(t, prefs[0], saved[0])
+	require.DeepEqual(t, prefs[1], saved[1])
+
+	// Nil preferences delete the saved ones.
+	require.NoError(t, db.SaveBuilderPreferencesByValidatorIDs(ctx, []primitives.ValidatorIndex{0}, []*ethpb.BuilderPreferences{nil}))
+	saved, err = db.BuilderPreferences(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	require.DeepEqual(t, prefs[1], saved[1])
+}
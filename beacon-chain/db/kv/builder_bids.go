@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// BuilderBidRecordsRetention is the number of epochs of builder bid audit records kept in the db.
+const BuilderBidRecordsRetention = primitives.Epoch(4096)
+
+// SaveBuilderBidRecord saves the builder bid audit record of a proposal, replacing any record for the same slot. The
+// records older than the retention period are deleted.
+func (s *Store) SaveBuilderBidRecord(ctx context.Context, record *ethpb.BuilderBidRecord) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBuilderBidRecord")
+	defer span.End()
+
+	if record == nil {
+		return errors.New("nil builder bid record")
+	}
+	enc, err := encode(ctx, record)
+	if err != nil {
+		return err
+	}
+	retention := primitives.Slot(BuilderBidRecordsRetention) * params.BeaconConfig().SlotsPerEpoch
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(builderBidsBucket)
+		if err := bkt.Put(bytesutil.SlotToBytesBigEndian(record.Slot), enc); err != nil {
+			return err
+		}
+		if record.Slot <= retention {
+			return nil
+		}
+		var expired [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < record.Slot-retention; k, _ = c.Next() {
+			expired = append(expired, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range expired {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// BuilderBidRecords returns the builder bid audit records of proposals between the start and end slot, inclusive,
+// in ascending slot order.
+func (s *Store) BuilderBidRecords(ctx context.Context, startSlot, endSlot primitives.Slot) ([]*ethpb.BuilderBidRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BuilderBidRecords")
+	defer span.End()
+
+	if endSlot < startSlot {
+		return nil, errInvalidSlotRange
+	}
+	records := make([]*ethpb.BuilderBidRecord, 0)
+	err := s.db.View(func(tx backend.Tx) error {
+		c := tx.Bucket(builderBidsBucket).Cursor()
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(startSlot)); k != nil && bytesutil.BytesToSlotBigEndian(k) <= endSlot; k, v = c.Next() {
+			record := &ethpb.BuilderBidRecord{}
+			if err := decode(ctx, v, record); err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
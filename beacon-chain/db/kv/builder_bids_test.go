@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_BuilderBidRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.ErrorContains(t, "nil builder bid record", db.SaveBuilderBidRecord(ctx, nil))
+	require.NoError(t, db.SaveBuilderBidRecord(ctx, &ethpb.BuilderBidRecord{
+		Slot: 1,
+		Bids: []*ethpb.RelayBidRecord{{Relay: "relay.example", Value: "1"}},
+	}))
+	for _, slot := range []uint64{3, 1, 2, 10} {
+		require.NoError(t, db.SaveBuilderBidRecord(ctx, &ethpb.BuilderBidRecord{
+			Slot: primitives.Slot(slot),
+			Bids: []*ethpb.RelayBidRecord{{Relay: "relay.example", Value: "2", Chosen: true}},
+		}))
+	}
+
+	records, err := db.BuilderBidRecords(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	for i, r := range records {
+		assert.Equal(t, primitives.Slot(i+1), r.Slot)
+		// Later records for a slot replace earlier ones.
+		assert.Equal(t, "2", r.Bids[0].Value)
+	}
+
+	records, err = db.BuilderBidRecords(ctx, 4, 9)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	records, err = db.BuilderBidRecords(ctx, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+
+	_, err = db.BuilderBidRecords(ctx, 2, 1)
+	require.ErrorIs(t, err, errInvalidSlotRange)
+
+	// Records older than the retention period are deleted.
+	retention := primitives.Slot(BuilderBidRecordsRetention) * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.SaveBuilderBidRecord(ctx, &ethpb.BuilderBidRecord{Slot: retention + 3}))
+	records, err = db.BuilderBidRecords(ctx, 0, retention+3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, primitives.Slot(3), records[0].Slot)
+}
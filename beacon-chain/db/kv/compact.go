@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/io/boltutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Compact compacts the beacon database in the given directory, which must not be in use, giving the pages freed by
+// deletions back to the file system.
+func Compact(dirPath string) error {
+	kind, exists, err := DetectBackend(dirPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("no database found in %s", dirPath)
+	}
+	if kind == backend.Bolt {
+		return compact(KVStoreDatafilePath(dirPath))
+	}
+	db, err := openBackend(dirPath, kind)
+	if err != nil {
+		return err
+	}
+	err = compactBackend(db)
+	if closeErr := db.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// compactIfPending compacts the database file at the given path if pruning freed pages in it.
+func compactIfPending(datafile string) error {
+	if !file.FileExists(datafile) {
+		return nil
+	}
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return errDatabaseLocked
+		}
+		return err
+	}
+	var pending bool
+	err = src.View(func(tx *bolt.Tx) error {
+		if bkt := tx.Bucket(chainMetadataBucket); bkt != nil {
+			pending = bkt.Get(compactionPendingKey) != nil
+		}
+		return nil
+	})
+	if closeErr := src.Close(); closeErr != nil {
+		return closeErr
+	}
+	if err != nil || !pending {
+		return err
+	}
+	return compact(datafile)
+}
+
+func compact(datafile string) error {
+	_, _, err := boltutil.CompactFile(datafile, boltAllocSize, func(path [][]byte, k []byte) bool {
+		// The compacted database no longer needs compaction.
+		return len(path) == 1 && bytes.Equal(path[0], chainMetadataBucket) && bytes.Equal(k, compactionPendingKey)
+	})
+	return err
+}
+
+// compactIfPending compacts an open database whose backend can be compacted in place, if pruning freed space in it.
+func (s *Store) compactIfPending() error {
+	if _, ok := s.db.(backend.Compacter); !ok {
+		return nil
+	}
+	var pending bool
+	if err := s.db.View(func(tx backend.Tx) error {
+		pending = tx.Bucket(chainMetadataBucket).Get(compactionPendingKey) != nil
+		return nil
+	}); err != nil || !pending {
+		return err
+	}
+	if err := compactBackend(s.db); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(compactionPendingKey)
+	})
+}
+
+func compactBackend(db backend.DB) error {
+	c, ok := db.(backend.Compacter)
+	if !ok {
+		return errors.Errorf("the %s backend cannot be compacted in place", db.Kind())
+	}
+	start := time.Now()
+	log.WithField("path", db.Path()).Info("Compacting database, this may take a while")
+	if err := c.Compact(); err != nil {
+		return err
+	}
+	log.WithField("duration", time.Since(start)).Info("Compacted database")
+	return nil
+}
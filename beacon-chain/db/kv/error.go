@@ -19,5 +19,11 @@ var ErrNotFoundGenesisBlockRoot = errors.Wrap(ErrNotFound, "OriginGenesisRoot")
 // ErrNotFoundBackfillBlockRoot is an error specifically for the origin block root getter
 var ErrNotFoundBackfillBlockRoot = errors.Wrap(ErrNotFound, "BackfillBlockRoot")
 
+// ErrNotFoundStateReconstructionRoot is an error specifically for the state reconstruction progress getter
+var ErrNotFoundStateReconstructionRoot = errors.Wrap(ErrNotFound, "StateReconstructionRoot")
+
+// ErrNotFoundForkChoiceSnapshot is an error specifically for the fork choice snapshot getter
+var ErrNotFoundForkChoiceSnapshot = errors.Wrap(ErrNotFound, "ForkChoiceSnapshot")
+
 // ErrNotFoundFeeRecipient is a not found error specifically for the fee recipient getter
 var ErrNotFoundFeeRecipient = errors.Wrap(ErrNotFound, "fee recipient")
@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -37,7 +37,7 @@ var containerFinalizedButNotCanonical = []byte("recent block needs reindexing to
 //
 // This method ensures that all blocks from the current finalized epoch are considered "final" while
 // maintaining only canonical and finalized blocks older than the current finalized epoch.
-func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, checkpoint *ethpb.Checkpoint) error {
+func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx backend.Tx, checkpoint *ethpb.Checkpoint) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.updateFinalizedBlockRoots")
 	defer span.End()
 
@@ -172,7 +172,7 @@ func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	defer span.End()
 
 	var exists bool
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		exists = tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:]) != nil
 		// Check genesis block root.
 		if !exists {
@@ -195,7 +195,7 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	defer span.End()
 
 	var blk interfaces.ReadOnlySignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		blkBytes := tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:])
 		if blkBytes == nil {
 			return nil
@@ -219,3 +219,42 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	tracing.AnnotateError(span, err)
 	return blk, err
 }
+
+// SaveBackfillFinalizedBlockRoots adds backfilled blocks to the finalized block roots index. The blocks must be
+// ordered by slot and form a chain whose last block is the parent of the current backfill block, as backfill walks
+// the chain backwards from the origin checkpoint block towards genesis.
+func (s *Store) SaveBackfillFinalizedBlockRoots(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillFinalizedBlockRoots")
+	defer span.End()
+
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childRoot := tx.Bucket(blocksBucket).Get(backfillBlockRootKey)
+		for i := len(blks) - 1; i >= 0; i-- {
+			if err := blocks.BeaconBlockIsNil(blks[i]); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			root, err := blks[i].Block().HashTreeRoot()
+			if err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			parentRoot := blks[i].Block().ParentRoot()
+			enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+				ParentRoot: parentRoot[:],
+				ChildRoot:  childRoot,
+			})
+			if err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			if err := bkt.Put(root[:], enc); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			childRoot = root[:]
+		}
+		return nil
+	})
+}
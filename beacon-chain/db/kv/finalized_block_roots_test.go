@@ -234,3 +234,31 @@ func makeBlocksAltair(t *testing.T, startIdx, num uint64, previousRoot [32]byte)
 	}
 	return ifaceBlocks
 }
+
+func TestStore_SaveBackfillFinalizedBlockRoots(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 10, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	// The last block is the origin block, and backfill saved the blocks below it in two batches.
+	originRoot, err := blks[9].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, originRoot))
+	require.NoError(t, db.SaveBackfillFinalizedBlockRoots(ctx, blks[5:9]))
+	lowRoot, err := blks[5].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, lowRoot))
+	require.NoError(t, db.SaveBackfillFinalizedBlockRoots(ctx, blks[:5]))
+
+	for i := 0; i < 9; i++ {
+		root, err := blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+		child, err := db.FinalizedChildBlock(ctx, root)
+		require.NoError(t, err)
+		require.NotNil(t, child, "Block at index %d has no finalized child", i)
+		assert.Equal(t, blks[i+1].Block().Slot(), child.Block().Slot())
+	}
+}
@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceSnapshot saves the snapshot of the fork choice store, replacing the previous one.
+func (s *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceSnapshot")
+	defer span.End()
+
+	if snapshot == nil {
+		return errors.New("nil fork choice snapshot")
+	}
+	enc, err := encode(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(forkChoiceSnapshotKey, enc)
+	})
+}
+
+// ForkChoiceSnapshot returns the last saved snapshot of the fork choice store, or ErrNotFoundForkChoiceSnapshot
+// if none was saved.
+func (s *Store) ForkChoiceSnapshot(ctx context.Context) (*ethpb.ForkChoiceSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceSnapshot")
+	defer span.End()
+
+	var snapshot *ethpb.ForkChoiceSnapshot
+	err := s.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(forkChoiceSnapshotKey)
+		if enc == nil {
+			return ErrNotFoundForkChoiceSnapshot
+		}
+		snapshot = &ethpb.ForkChoiceSnapshot{}
+		return decode(ctx, enc, snapshot)
+	})
+	return snapshot, err
+}
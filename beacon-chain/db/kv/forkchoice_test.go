@@ -0,0 +1,40 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_ForkChoiceSnapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.ForkChoiceSnapshot(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorContains(t, "nil fork choice snapshot", db.SaveForkChoiceSnapshot(ctx, nil))
+
+	root := [32]byte{'a'}
+	snapshot := &ethpb.ForkChoiceSnapshot{
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: root[:]},
+		Nodes:               []*ethpb.ForkChoiceNodeSnapshot{{Slot: 32, Root: root[:], Optimistic: true}},
+		Balances:            []uint64{10, 20},
+		SlashedIndices:      []primitives.ValidatorIndex{1},
+		VoteRoots:           [][]byte{root[:]},
+		Votes:               []byte{0, 0, 1, 0, 0, 1},
+	}
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, snapshot))
+	got, err := db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, snapshot, got)
+
+	// A new snapshot replaces the previous one.
+	snapshot.Nodes = nil
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, snapshot))
+	got, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got.Nodes))
+}
@@ -1,5 +1,5 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, stored with bolt or pebble.
 package kv
 
 import (
@@ -7,16 +7,15 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
-	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
 	bolt "go.etcd.io/bbolt"
@@ -82,9 +81,10 @@ var blockedBuckets = [][]byte{
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using a key-value backend, BoltDB by default, as the underlying
+// persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
@@ -129,12 +129,20 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	builderPreferencesBucket,
+	builderBidsBucket,
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
-func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
+// An existing database is opened with the backend it is stored with,
+// while a new one is stored with bolt unless WithBackend says otherwise.
+func NewKVStore(ctx context.Context, dirPath string, opts ...Option) (*Store, error) {
+	cfg := &storeConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
 	hasDir, err := file.HasDir(dirPath)
 	if err != nil {
 		return nil, err
@@ -144,23 +152,14 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			return nil, err
 		}
 	}
-	datafile := KVStoreDatafilePath(dirPath)
-	log.Infof("Opening Bolt DB at %s", datafile)
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: mmapSize,
-		},
-	)
+	kind, err := storeBackend(dirPath, cfg.backend)
+	if err != nil {
+		return nil, err
+	}
+	db, err := openBackend(dirPath, kind)
 	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -180,19 +179,23 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  db,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
 		ctx:                 ctx,
 	}
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.db.Update(func(tx backend.Tx) error {
 		return createBuckets(tx, Buckets...)
 	}); err != nil {
 		return nil, err
 	}
-	if err = prometheus.Register(createBoltCollector(kv.db)); err != nil {
+	if b, ok := backend.AsBolt(kv.db); ok {
+		if err = prometheus.Register(createBoltCollector(b)); err != nil {
+			return nil, err
+		}
+	} else if err := kv.compactIfPending(); err != nil {
 		return nil, err
 	}
 	// Setup the type of block storage used depending on whether or not this is a fresh database.
@@ -207,16 +210,20 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	if b, ok := backend.AsBolt(s.db); ok {
+		prometheus.Unregister(createBoltCollector(b))
+	}
+	if err := os.RemoveAll(StoragePath(s.databasePath, s.db.Kind())); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	if b, ok := backend.AsBolt(s.db); ok {
+		prometheus.Unregister(createBoltCollector(b))
+	}
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
@@ -243,7 +250,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	saveFull := features.Get().SaveFullExecutionPayloads
 
 	var saveBlinded bool
-	if err := s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.db.Update(func(tx backend.Tx) error {
 		// If we have a key stating we wish to save blinded beacon blocks, then we set saveBlinded to true.
 		metadataBkt := tx.Bucket(chainMetadataBucket)
 		keyExists := len(metadataBkt.Get(saveBlindedBeaconBlocksKey)) > 0
@@ -287,7 +294,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	return nil
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -3,19 +3,35 @@ package kv
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
-	bolt "go.etcd.io/bbolt"
 )
 
+// testBackend is the backend the databases of the tests are stored with, the tests being run once for each backend.
+var testBackend = backend.Bolt
+
+func TestMain(m *testing.M) {
+	code := 0
+	for _, kind := range backend.Kinds {
+		testBackend = kind
+		log.WithField("backend", kind).Info("Running tests")
+		if c := m.Run(); c != 0 {
+			code = c
+		}
+	}
+	os.Exit(code)
+}
+
 // setupDB instantiates and returns a Store instance.
 func setupDB(t testing.TB) *Store {
-	db, err := NewKVStore(context.Background(), t.TempDir())
+	db, err := NewKVStore(context.Background(), t.TempDir(), WithBackend(testBackend))
 	require.NoError(t, err, "Failed to instantiate DB")
 	t.Cleanup(func() {
 		require.NoError(t, db.Close(), "Failed to close database")
@@ -72,7 +88,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with blinded blocks but no key in metadata bucket should continue storing blinded blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Put(saveBlindedBeaconBlocksKey, []byte{1})
 		}))
 
@@ -91,7 +107,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.DeepEqual(t, wrappedBlock, retrievedBlk)
 
 		// We then delete the key from the bucket.
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
@@ -100,7 +116,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.NoError(t, err)
 
 		var shouldSaveBlinded bool
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			bkt := tx.Bucket(chainMetadataBucket)
 			shouldSaveBlinded = len(bkt.Get(saveBlindedBeaconBlocksKey)) > 0
 			return nil
@@ -126,7 +142,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with full blocks type should continue storing full blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
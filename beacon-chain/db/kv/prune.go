@@ -0,0 +1,328 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the maximum number of blocks, or legacy attestation records, deleted in a single bolt
+// transaction, so that pruning never holds the write lock of the database for long.
+const pruneBatchSize = 64
+
+// ErrPruneAboveFinalized is returned when pruning is requested above the finalized block.
+var ErrPruneAboveFinalized = errors.New("cannot prune blocks above the finalized block")
+
+// legacyAttestationBuckets are no longer written to, and are emptied by pruning.
+var legacyAttestationBuckets = [][]byte{
+	attestationsBucket,
+	attestationHeadBlockRootBucket,
+	attestationSourceRootIndicesBucket,
+	attestationSourceEpochIndicesBucket,
+	attestationTargetRootIndicesBucket,
+	attestationTargetEpochIndicesBucket,
+}
+
+// EarliestAvailableSlot returns the slot below which blocks were pruned from the db, or 0 if the db was never pruned.
+func (s *Store) EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.EarliestAvailableSlot")
+	defer span.End()
+	var slot primitives.Slot
+	err := s.db.View(func(tx backend.Tx) error {
+		if enc := tx.Bucket(blocksBucket).Get(earliestAvailableSlotKey); enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// SaveEarliestAvailableSlot records the slot below which blocks are pruned from the db.
+func (s *Store) SaveEarliestAvailableSlot(ctx context.Context, slot primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveEarliestAvailableSlot")
+	defer span.End()
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(blocksBucket).Put(earliestAvailableSlotKey, bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
+// LowestFinalizedBlockRootAtOrAbove returns the slot and root of the lowest finalized block at or above the given
+// slot. It returns ErrNotFound if there is none.
+func (s *Store) LowestFinalizedBlockRootAtOrAbove(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LowestFinalizedBlockRootAtOrAbove")
+	defer span.End()
+	var found primitives.Slot
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot)); k != nil; k, v = c.Next() {
+			for i := 0; i+hashLength <= len(v); i += hashLength {
+				if finalized.Get(v[i:i+hashLength]) != nil {
+					found = bytesutil.BytesToSlotBigEndian(k)
+					copy(root[:], v[i:i+hashLength])
+					return nil
+				}
+			}
+		}
+		return ErrNotFound
+	})
+	return found, root, err
+}
+
+// HighestArchivedBlockRootAtOrBelow returns the slot and root of the highest finalized block whose state is saved in
+// the db, with a state slot at or below the given slot. Stategen replays the blocks above it from that state. It
+// returns ErrNotFound if there is none.
+func (s *Store) HighestArchivedBlockRootAtOrBelow(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestArchivedBlockRootAtOrBelow")
+	defer span.End()
+	var found primitives.Slot
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		blocksBkt := tx.Bucket(blocksBucket)
+		statesBkt := tx.Bucket(stateBucket)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		genesisRoot := blocksBkt.Get(genesisBlockRootKey)
+		originRoot := blocksBkt.Get(originCheckpointBlockRootKey)
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot))
+		if k == nil {
+			k, v = c.Last()
+		} else if bytesutil.BytesToSlotBigEndian(k) > slot {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			for i := 0; i+hashLength <= len(v); i += hashLength {
+				r := v[i : i+hashLength]
+				if statesBkt.Get(r) == nil {
+					continue
+				}
+				if finalized.Get(r) == nil && !bytes.Equal(r, genesisRoot) && !bytes.Equal(r, originRoot) {
+					continue
+				}
+				enc := blocksBkt.Get(r)
+				if enc == nil {
+					continue
+				}
+				// The block of an epoch boundary state may be below the slot of the state.
+				blk, err := unmarshalBlock(ctx, enc)
+				if err != nil {
+					return errors.Wrapf(err, "could not unmarshal block with key %#x", r)
+				}
+				found = blk.Block().Slot()
+				copy(root[:], r)
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+	return found, root, err
+}
+
+// PruneBlocksBefore deletes the blocks below the given slot, along with their state summaries, archived states, and
+// slot, parent root and finalized root indices, as well as the records of the legacy attestation buckets. The
+// genesis and origin checkpoint blocks and states are kept. Blocks are deleted in bounded transactions, so that the
+// node keeps running while pruning, and pruning resumes from the slot it last reached. Slots above the finalized block
+// cannot be pruned. The freed pages are given back to the file system when the database is compacted, on the next
+// start of the node. It returns the number of blocks deleted.
+func (s *Store) PruneBlocksBefore(ctx context.Context, slot primitives.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBlocksBefore")
+	defer span.End()
+
+	var genesisRoot, originRoot []byte
+	var finalizedSlot primitives.Slot
+	// Genesis is never pruned, start from the slot right above it.
+	next := primitives.Slot(1)
+	err := s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		genesisRoot = bytesutil.SafeCopyBytes(bkt.Get(genesisBlockRootKey))
+		originRoot = bytesutil.SafeCopyBytes(bkt.Get(originCheckpointBlockRootKey))
+		if enc := tx.Bucket(chainMetadataBucket).Get(prunedBeforeSlotKey); enc != nil {
+			next = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+		if enc == nil {
+			return ErrPruneAboveFinalized
+		}
+		finalized := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, finalized); err != nil {
+			return err
+		}
+		enc = bkt.Get(finalized.Root)
+		if enc == nil {
+			return errors.Wrapf(ErrNotFound, "finalized block %#x", finalized.Root)
+		}
+		blk, err := unmarshalBlock(ctx, enc)
+		if err != nil {
+			return err
+		}
+		finalizedSlot = blk.Block().Slot()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if slot > finalizedSlot {
+		return 0, errors.Wrapf(ErrPruneAboveFinalized, "slot %d, finalized slot %d", slot, finalizedSlot)
+	}
+
+	pruned := 0
+	for next < slot {
+		if ctx.Err() != nil {
+			return pruned, ctx.Err()
+		}
+		var n int
+		err := s.db.Update(func(tx backend.Tx) error {
+			var err error
+			n, next, err = s.pruneBatch(ctx, tx, next, slot, genesisRoot, originRoot)
+			if err != nil {
+				return err
+			}
+			return tx.Bucket(chainMetadataBucket).Put(prunedBeforeSlotKey, bytesutil.SlotToBytesBigEndian(next))
+		})
+		if err != nil {
+			return pruned, err
+		}
+		pruned += n
+	}
+	if err := s.pruneLegacyAttestations(ctx); err != nil {
+		return pruned, err
+	}
+	if pruned > 0 {
+		if err := s.db.Update(func(tx backend.Tx) error {
+			return tx.Bucket(chainMetadataBucket).Put(compactionPendingKey, []byte{1})
+		}); err != nil {
+			return pruned, err
+		}
+	}
+	return pruned, nil
+}
+
+// pruneBatch deletes up to pruneBatchSize blocks from the slot index, starting from the given slot and up to the end
+// slot, exclusive. It returns the number of blocks deleted and the slot to resume from.
+func (s *Store) pruneBatch(
+	ctx context.Context,
+	tx backend.Tx,
+	from, end primitives.Slot,
+	genesisRoot, originRoot []byte,
+) (int, primitives.Slot, error) {
+	type entry struct {
+		slot primitives.Slot
+		root []byte
+	}
+	var batch []entry
+	next := end
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(from)); k != nil; k, v = c.Next() {
+		sl := bytesutil.BytesToSlotBigEndian(k)
+		if sl >= end {
+			break
+		}
+		if len(batch) >= pruneBatchSize {
+			next = sl
+			break
+		}
+		for i := 0; i+hashLength <= len(v); i += hashLength {
+			batch = append(batch, entry{slot: sl, root: bytesutil.SafeCopyBytes(v[i : i+hashLength])})
+		}
+	}
+
+	blocksBkt := tx.Bucket(blocksBucket)
+	pruned := 0
+	for _, e := range batch {
+		if bytes.Equal(e.root, genesisRoot) || bytes.Equal(e.root, originRoot) {
+			continue
+		}
+		enc := blocksBkt.Get(e.root)
+		if enc == nil {
+			// The slot index is stale, only clean it up.
+			if err := deleteValueForIndices(ctx, map[string][]byte{
+				string(blockSlotIndicesBucket): bytesutil.SlotToBytesBigEndian(e.slot),
+			}, e.root, tx); err != nil {
+				return 0, 0, err
+			}
+			continue
+		}
+		blk, err := unmarshalBlock(ctx, enc)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "could not unmarshal block with key %#x", e.root)
+		}
+		if err := deleteValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block()), e.root, tx); err != nil {
+			return 0, 0, errors.Wrap(err, "could not delete root for DB indices")
+		}
+		if err := s.pruneState(ctx, tx, e.root); err != nil {
+			return 0, 0, err
+		}
+		for _, b := range [][]byte{blocksBucket, stateSummaryBucket, finalizedBlockRootsIndexBucket} {
+			if err := tx.Bucket(b).Delete(e.root); err != nil {
+				return 0, 0, err
+			}
+		}
+		root := bytesutil.ToBytes32(e.root)
+		s.stateSummaryCache.delete(root)
+		s.blockCache.Del(string(e.root))
+		pruned++
+	}
+	return pruned, next, nil
+}
+
+// pruneState deletes the state saved for the given block root, if any, with its slot index and validator hashes.
+func (s *Store) pruneState(ctx context.Context, tx backend.Tx, root []byte) error {
+	bkt := tx.Bucket(stateBucket)
+	if bkt.Get(root) == nil {
+		return nil
+	}
+	// Epoch boundary states are indexed by their own slot rather than the slot of their block.
+	slot, err := s.slotByBlockRoot(ctx, tx, root)
+	if err != nil {
+		return err
+	}
+	if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, slot), root, tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+	if err := tx.Bucket(blockRootValidatorHashesBucket).Delete(root); err != nil {
+		return err
+	}
+	return bkt.Delete(root)
+}
+
+// pruneLegacyAttestations empties the attestation buckets, which are no longer written to, in bounded transactions.
+func (s *Store) pruneLegacyAttestations(ctx context.Context) error {
+	for _, name := range legacyAttestationBuckets {
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			deleted := 0
+			if err := s.db.Update(func(tx backend.Tx) error {
+				bkt := tx.Bucket(name)
+				// Collect the keys before deleting them, as cursors do not see the deletes of their own
+				// transaction on every backend.
+				keys := make([][]byte, 0, pruneBatchSize)
+				c := bkt.Cursor()
+				for k, _ := c.First(); k != nil && len(keys) < pruneBatchSize; k, _ = c.Next() {
+					keys = append(keys, bytesutil.SafeCopyBytes(k))
+				}
+				for _, k := range keys {
+					if err := bkt.Delete(k); err != nil {
+						return err
+					}
+				}
+				deleted = len(keys)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if deleted < pruneBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestStore_PruneBlocksBefore(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	dir := t.TempDir()
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, dir, WithBackend(testBackend))
+	require.NoError(t, err)
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*4, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(6))
+	require.NoError(t, db.SaveState(ctx, st, roots[5]))
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 6, Root: roots[5][:]}))
+
+	// Nothing can be pruned until a checkpoint is finalized.
+	_, err = db.PruneBlocksBefore(ctx, 10)
+	require.ErrorIs(t, err, ErrPruneAboveFinalized)
+
+	finalizedRoot := roots[slotsPerEpoch*2]
+	require.NoError(t, db.SaveState(ctx, st, finalizedRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}))
+
+	slot, root, err := db.LowestFinalizedBlockRootAtOrAbove(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), slot)
+	assert.Equal(t, roots[9], root)
+
+	_, err = db.PruneBlocksBefore(ctx, primitives.Slot(slotsPerEpoch*3))
+	require.ErrorIs(t, err, ErrPruneAboveFinalized)
+
+	pruned, err := db.PruneBlocksBefore(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 9, pruned)
+	require.NoError(t, db.db.View(func(tx backend.Tx) error {
+		assert.DeepEqual(t, bytesutil.SlotToBytesBigEndian(10), tx.Bucket(chainMetadataBucket).Get(prunedBeforeSlotKey))
+		return nil
+	}))
+	for i, r := range roots[:9] {
+		assert.Equal(t, false, db.HasBlock(ctx, r), "Block at slot %d was not pruned", i+1)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, r), "Block at slot %d is still finalized", i+1)
+	}
+	assert.Equal(t, false, db.HasState(ctx, roots[5]))
+	assert.Equal(t, false, db.HasStateSummary(ctx, roots[5]))
+	assert.Equal(t, true, db.HasBlock(ctx, roots[9]))
+	assert.Equal(t, true, db.HasState(ctx, finalizedRoot))
+
+	// The slot and parent root indices no longer point to pruned blocks.
+	found, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(20))
+	require.NoError(t, err)
+	assert.Equal(t, 11, len(found))
+	found, _, err = db.Blocks(ctx, filters.NewFilter().SetParentRoot(roots[3][:]))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found))
+
+	earliest, err := db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(0), earliest)
+	require.NoError(t, db.SaveEarliestAvailableSlot(ctx, 10))
+	earliest, err = db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), earliest)
+
+	// The db is compacted when opened again.
+	require.NoError(t, db.Close())
+	before, err := dirSize(StoragePath(dir, testBackend))
+	require.NoError(t, err)
+	db, err = NewKVStore(ctx, dir, WithBackend(testBackend))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	after, err := dirSize(StoragePath(dir, testBackend))
+	require.NoError(t, err)
+	assert.Equal(t, true, after <= before)
+	require.NoError(t, db.db.View(func(tx backend.Tx) error {
+		assert.DeepEqual(t, []byte(nil), tx.Bucket(chainMetadataBucket).Get(compactionPendingKey))
+		return nil
+	}))
+	assert.Equal(t, true, db.HasBlock(ctx, roots[9]))
+	assert.Equal(t, true, db.HasState(ctx, finalizedRoot))
+	earliest, err = db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), earliest)
+	found, _, err = db.Blocks(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(20))
+	require.NoError(t, err)
+	assert.Equal(t, 11, len(found))
+}
+
+func TestStore_HighestArchivedBlockRootAtOrBelow(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 64, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		var err error
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(8))
+	require.NoError(t, db.SaveState(ctx, st, roots[7]))
+	// The state of an epoch boundary slot whose block is at slot 30.
+	require.NoError(t, st.SetSlot(32))
+	require.NoError(t, db.SaveState(ctx, st, roots[29]))
+
+	// Only the states of finalized blocks are archived.
+	_, _, err = db.HighestArchivedBlockRootAtOrBelow(ctx, 20)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[40][:]}))
+
+	_, _, err = db.HighestArchivedBlockRootAtOrBelow(ctx, 5)
+	require.ErrorIs(t, err, ErrNotFound)
+	for _, c := range []struct {
+		slot     primitives.Slot
+		expected primitives.Slot
+		root     [32]byte
+	}{
+		{slot: 8, expected: 8, root: roots[7]},
+		{slot: 31, expected: 8, root: roots[7]},
+		{slot: 32, expected: 30, root: roots[29]},
+		{slot: 1000, expected: 30, root: roots[29]},
+	} {
+		slot, root, err := db.HighestArchivedBlockRootAtOrBelow(ctx, c.slot)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, slot, "Unexpected archived block at or below slot %d", c.slot)
+		assert.Equal(t, c.root, root, "Unexpected archived block at or below slot %d", c.slot)
+	}
+
+	// Pruning resumes from the slot it last reached.
+	pruned, err := db.PruneBlocksBefore(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 7, pruned)
+	pruned, err = db.PruneBlocksBefore(ctx, 30)
+	require.NoError(t, err)
+	assert.Equal(t, 22, pruned)
+	assert.Equal(t, false, db.HasState(ctx, roots[7]))
+	assert.Equal(t, true, db.HasState(ctx, roots[29]))
+}
+
+func TestStore_pruneLegacyAttestations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	numKeys := 2*pruneBatchSize + 3
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		for i := 0; i < numKeys; i++ {
+			if err := tx.Bucket(attestationsBucket).Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), []byte{1}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.pruneLegacyAttestations(ctx))
+	require.NoError(t, db.db.View(func(tx backend.Tx) error {
+		k, _ := tx.Bucket(attestationsBucket).Cursor().First()
+		assert.DeepEqual(t, []byte(nil), k)
+		return nil
+	}))
+}
+
+// dirSize returns the size of a file, or of the files of a directory.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -7,19 +7,21 @@ package kv
 // it easy to scan for keys that have a certain shard number as a prefix and return those
 // corresponding attestations.
 var (
-	attestationsBucket      = []byte("attestations")
-	blocksBucket            = []byte("blocks")
-	stateBucket             = []byte("state")
-	stateSummaryBucket      = []byte("state-summary")
-	proposerSlashingsBucket = []byte("proposer-slashings")
-	attesterSlashingsBucket = []byte("attester-slashings")
-	voluntaryExitsBucket    = []byte("voluntary-exits")
-	chainMetadataBucket     = []byte("chain-metadata")
-	checkpointBucket        = []byte("check-point")
-	powchainBucket          = []byte("powchain")
-	stateValidatorsBucket   = []byte("state-validators")
-	feeRecipientBucket      = []byte("fee-recipient")
-	registrationBucket      = []byte("registration")
+	attestationsBucket       = []byte("attestations")
+	blocksBucket             = []byte("blocks")
+	stateBucket              = []byte("state")
+	stateSummaryBucket       = []byte("state-summary")
+	proposerSlashingsBucket  = []byte("proposer-slashings")
+	attesterSlashingsBucket  = []byte("attester-slashings")
+	voluntaryExitsBucket     = []byte("voluntary-exits")
+	chainMetadataBucket      = []byte("chain-metadata")
+	checkpointBucket         = []byte("check-point")
+	powchainBucket           = []byte("powchain")
+	stateValidatorsBucket    = []byte("state-validators")
+	feeRecipientBucket       = []byte("fee-recipient")
+	registrationBucket       = []byte("registration")
+	builderPreferencesBucket = []byte("builder-preferences")
+	builderBidsBucket        = []byte("builder-bids")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -59,6 +61,16 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
+	// block root of the latest state saved by historical state reconstruction, which resumes from there
+	stateReconstructionRootKey = []byte("state-reconstruction-root")
+	// slot below which blocks were pruned from the database
+	earliestAvailableSlotKey = []byte("earliest-available-slot")
+	// slot below which the block slot index was fully pruned, from which the next pruning resumes
+	prunedBeforeSlotKey = []byte("pruned-before-slot")
+	// marks that the database has free pages to give back to the file system, on the next start of the node
+	compactionPendingKey = []byte("compaction-pending")
+	// snapshot of the fork choice store, restored on the next start of the node
+	forkChoiceSnapshotKey = []byte("forkchoice-snapshot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
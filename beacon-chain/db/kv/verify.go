@@ -0,0 +1,204 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Verify walks the blocks, state summaries, states and their indices, as well as the head and checkpoint references,
+// and returns the entries which are not consistent with each other. Dangling index entries and state summaries, and
+// blocks missing from the indices, can be repaired with Repair. The other inconsistencies are only reported.
+func (s *Store) Verify(ctx context.Context) ([]*backend.Inconsistency, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Verify")
+	defer span.End()
+
+	var issues []*backend.Inconsistency
+	report := func(bucket []byte, key []byte, fix func(tx backend.Tx) error, format string, args ...interface{}) {
+		issues = append(issues, &backend.Inconsistency{
+			Bucket: string(bucket),
+			Key:    bytesutil.SafeCopyBytes(key),
+			Reason: fmt.Sprintf(format, args...),
+			Fix:    fix,
+		})
+	}
+	err := s.db.View(func(tx backend.Tx) error {
+		slots, err := verifyBlocks(ctx, tx, report)
+		if err != nil {
+			return err
+		}
+		verifySlotIndex(ctx, tx, slots, report)
+		verifyStateSummaries(ctx, tx, slots, report)
+		verifyStates(tx, slots, report)
+		verifyFinalizedIndex(tx, slots, report)
+		verifyReferences(ctx, tx, slots, report)
+		return nil
+	})
+	return issues, err
+}
+
+// Repair fixes the inconsistencies returned by Verify which can be repaired, and returns their number.
+func (s *Store) Repair(issues []*backend.Inconsistency) (int, error) {
+	return backend.Repair(s.db, issues)
+}
+
+type reportFunc func(bucket []byte, key []byte, fix func(tx backend.Tx) error, format string, args ...interface{})
+
+// verifyBlocks checks that every block is in the slot and parent root indices, and returns the slots of the blocks by
+// root.
+func verifyBlocks(ctx context.Context, tx backend.Tx, report reportFunc) (map[[32]byte]primitives.Slot, error) {
+	slots := make(map[[32]byte]primitives.Slot)
+	c := tx.Bucket(blocksBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// The bucket also holds metadata, such as the head block root, which is not keyed by block root.
+		if len(k) != hashLength {
+			continue
+		}
+		blk, err := unmarshalBlock(ctx, v)
+		if err != nil {
+			report(blocksBucket, k, nil, "block cannot be decoded: %v", err)
+			continue
+		}
+		slots[bytesutil.ToBytes32(k)] = blk.Block().Slot()
+		root := bytesutil.SafeCopyBytes(k)
+		for bucket, idx := range createBlockIndicesFromBlock(ctx, blk.Block()) {
+			if indexed(tx.Bucket([]byte(bucket)).Get(idx), root) {
+				continue
+			}
+			indices := map[string][]byte{bucket: bytesutil.SafeCopyBytes(idx)}
+			report(blocksBucket, root, func(tx backend.Tx) error {
+				return updateValueForIndices(ctx, indices, root, tx)
+			}, "block is missing from the %s bucket at %#x", bucket, idx)
+		}
+	}
+	return slots, nil
+}
+
+// verifySlotIndex checks that every root of the slot index is a block at that slot.
+func verifySlotIndex(ctx context.Context, tx backend.Tx, slots map[[32]byte]primitives.Slot, report reportFunc) {
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(v)%hashLength != 0 {
+			report(blockSlotIndicesBucket, k, nil, "value of length %d is not a list of roots", len(v))
+			continue
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		for i := 0; i < len(v); i += hashLength {
+			root := bytesutil.SafeCopyBytes(v[i : i+hashLength])
+			blockSlot, ok := slots[bytesutil.ToBytes32(root)]
+			if ok && blockSlot == slot {
+				continue
+			}
+			indices := map[string][]byte{string(blockSlotIndicesBucket): bytesutil.SafeCopyBytes(k)}
+			fix := func(tx backend.Tx) error {
+				return deleteValueForIndices(ctx, indices, root, tx)
+			}
+			if ok {
+				report(blockSlotIndicesBucket, k, fix, "block %#x is at slot %d", root, blockSlot)
+			} else {
+				report(blockSlotIndicesBucket, k, fix, "block %#x is missing", root)
+			}
+		}
+	}
+}
+
+// verifyStateSummaries checks that every state summary is for a block, or for a saved state.
+func verifyStateSummaries(ctx context.Context, tx backend.Tx, slots map[[32]byte]primitives.Slot, report reportFunc) {
+	states := tx.Bucket(stateBucket)
+	c := tx.Bucket(stateSummaryBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		summary := &ethpb.StateSummary{}
+		if err := decode(ctx, v, summary); err != nil {
+			report(stateSummaryBucket, k, nil, "state summary cannot be decoded: %v", err)
+			continue
+		}
+		blockSlot, ok := slots[bytesutil.ToBytes32(k)]
+		if !ok {
+			if states.Get(k) != nil {
+				continue
+			}
+			key := bytesutil.SafeCopyBytes(k)
+			report(stateSummaryBucket, k, func(tx backend.Tx) error {
+				return tx.Bucket(stateSummaryBucket).Delete(key)
+			}, "neither the block nor the state of the summary is saved")
+			continue
+		}
+		if summary.Slot < blockSlot {
+			report(stateSummaryBucket, k, nil, "state summary slot %d is below its block slot %d", summary.Slot, blockSlot)
+		}
+	}
+}
+
+// verifyStates checks that every state has a block or a state summary, without which it cannot be looked up by slot.
+func verifyStates(tx backend.Tx, slots map[[32]byte]primitives.Slot, report reportFunc) {
+	summaries := tx.Bucket(stateSummaryBucket)
+	c := tx.Bucket(stateBucket).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if _, ok := slots[bytesutil.ToBytes32(k)]; ok || summaries.Get(k) != nil {
+			continue
+		}
+		report(stateBucket, k, nil, "state has neither a block nor a state summary")
+	}
+}
+
+// verifyFinalizedIndex checks that every root of the finalized block roots index is a block.
+func verifyFinalizedIndex(tx backend.Tx, slots map[[32]byte]primitives.Slot, report reportFunc) {
+	c := tx.Bucket(finalizedBlockRootsIndexBucket).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		// The bucket also holds the previous finalized checkpoint, which is not keyed by block root.
+		if len(k) != hashLength {
+			continue
+		}
+		if _, ok := slots[bytesutil.ToBytes32(k)]; ok {
+			continue
+		}
+		key := bytesutil.SafeCopyBytes(k)
+		report(finalizedBlockRootsIndexBucket, k, func(tx backend.Tx) error {
+			return tx.Bucket(finalizedBlockRootsIndexBucket).Delete(key)
+		}, "finalized block is missing")
+	}
+}
+
+// verifyReferences checks that the head block, and the blocks of the justified and finalized checkpoints, are saved.
+func verifyReferences(ctx context.Context, tx backend.Tx, slots map[[32]byte]primitives.Slot, report reportFunc) {
+	if head := tx.Bucket(blocksBucket).Get(headBlockRootKey); head != nil {
+		if _, ok := slots[bytesutil.ToBytes32(head)]; !ok {
+			report(blocksBucket, headBlockRootKey, nil, "head block %#x is missing", head)
+		}
+	}
+	bkt := tx.Bucket(checkpointBucket)
+	for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+		enc := bkt.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			report(checkpointBucket, key, nil, "checkpoint cannot be decoded: %v", err)
+			continue
+		}
+		root := bytesutil.ToBytes32(cp.Root)
+		if _, ok := slots[root]; !ok && root != [32]byte{} {
+			report(checkpointBucket, key, nil, "checkpoint block %#x is missing", cp.Root)
+		}
+	}
+}
+
+// indexed reports whether the given root is in the list of roots of an index.
+func indexed(roots []byte, root []byte) bool {
+	for i := 0; i+hashLength <= len(roots); i += hashLength {
+		if bytes.Equal(roots[i:i+hashLength], root) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestStore_Verify(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*2, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		var err error
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	finalizedRoot := roots[slotsPerEpoch]
+	require.NoError(t, db.SaveState(ctx, st, finalizedRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	headRoot := roots[len(roots)-1]
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: blks[len(blks)-1].Block().Slot(), Root: headRoot[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, headRoot))
+
+	issues, err := db.Verify(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(issues), "Unexpected inconsistencies %v", issues)
+
+	// Leave dangling entries behind a deleted block, and remove a block from the slot index.
+	missing := bytesutil.ToBytes32([]byte("missing"))
+	justified, err := encode(ctx, &ethpb.Checkpoint{Epoch: 1, Root: missing[:]})
+	require.NoError(t, err)
+	summary, err := encode(ctx, &ethpb.StateSummary{Slot: 3, Root: missing[:]})
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		if err := tx.Bucket(blocksBucket).Delete(roots[2][:]); err != nil {
+			return err
+		}
+		if err := tx.Bucket(blockSlotIndicesBucket).Delete(bytesutil.SlotToBytesBigEndian(5)); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateSummaryBucket).Put(missing[:], summary); err != nil {
+			return err
+		}
+		corrupt := bytesutil.ToBytes32([]byte("corrupt"))
+		if err := tx.Bucket(stateSummaryBucket).Put(corrupt[:], []byte{0x01}); err != nil {
+			return err
+		}
+		return tx.Bucket(checkpointBucket).Put(justifiedCheckpointKey, justified)
+	}))
+
+	issues, err = db.Verify(ctx)
+	require.NoError(t, err)
+	// The slot and finalized indices of the deleted block, the block missing from the slot index, the dangling state
+	// summary, the state summary which cannot be decoded, and the justified checkpoint.
+	byBucket := make(map[string]int)
+	for _, i := range issues {
+		byBucket[i.Bucket]++
+	}
+	assert.Equal(t, 1, byBucket[string(blockSlotIndicesBucket)])
+	assert.Equal(t, 1, byBucket[string(finalizedBlockRootsIndexBucket)])
+	assert.Equal(t, 1, byBucket[string(blocksBucket)])
+	assert.Equal(t, 2, byBucket[string(stateSummaryBucket)])
+	assert.Equal(t, 1, byBucket[string(checkpointBucket)])
+
+	repaired, err := db.Repair(issues)
+	require.NoError(t, err)
+	assert.Equal(t, 4, repaired)
+	issues, err = db.Verify(ctx)
+	require.NoError(t, err)
+	// The undecodable state summary and the justified checkpoint cannot be repaired.
+	assert.Equal(t, 2, len(issues))
+	hasRoots, found, err := db.BlockRootsBySlot(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, hasRoots)
+	assert.DeepEqual(t, [][32]byte{roots[4]}, found)
+}
@@ -0,0 +1,21 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	earliestAvailableSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruner_earliest_available_slot",
+		Help: "Slot below which the finalized history was pruned.",
+	})
+	prunedBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_blocks_total",
+		Help: "Number of blocks deleted by the pruner.",
+	})
+	pruneDuration = promauto.NewSummary(prometheus.SummaryOpts{
+		Name: "pruner_prune_milliseconds",
+		Help: "Milliseconds it takes to prune the finalized history below the retention horizon.",
+	})
+)
@@ -0,0 +1,199 @@
+// Package pruner deletes the finalized history of a non-archive beacon node below a retention horizon, so that the
+// database does not grow without bounds.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+// minEpochsForBlockRequests is MIN_EPOCHS_FOR_BLOCK_REQUESTS from the p2p specification, the number of epochs of
+// blocks peers are expected to serve.
+const minEpochsForBlockRequests = primitives.Epoch(33024)
+
+// Database describes the set of DB methods that the pruner Service needs to function.
+type Database interface {
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+	LowestFinalizedBlockRootAtOrAbove(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error)
+	HighestArchivedBlockRootAtOrBelow(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error)
+	PruneBlocksBefore(ctx context.Context, slot primitives.Slot) (int, error)
+}
+
+// Status keeps track of the slot below which history is pruned, so that the node stops serving and backfilling it.
+type Status interface {
+	Prune(ctx context.Context, earliest primitives.Slot, root [32]byte) error
+}
+
+// Config to set up the pruner service.
+type Config struct {
+	DB     Database
+	Status Status
+	// PruneBeforeSlot is the slot below which finalized history is pruned.
+	PruneBeforeSlot primitives.Slot
+	// RetentionEpochs is the number of epochs of finalized history kept, if not 0.
+	RetentionEpochs primitives.Epoch
+	// Interval between checks of the finalized checkpoint. It defaults to one epoch.
+	Interval time.Duration
+}
+
+// Service deletes the finalized blocks, state summaries, archived states and their indices below a horizon, which is
+// either a fixed slot, or trails the finalized checkpoint by a number of epochs, or the highest of both. It checks the
+// finalized checkpoint periodically, and prunes once it moved the horizon. The horizon is rounded down to the lowest
+// finalized block above it, which becomes the earliest available block of the node.
+type Service struct {
+	cfg     *Config
+	ctx     context.Context
+	cancel  context.CancelFunc
+	horizon primitives.Slot
+	err     error
+}
+
+// NewService configures the pruner service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.Interval == 0 {
+		cfg.Interval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Second
+	}
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start pruning.
+func (s *Service) Start() {
+	if s.cfg.RetentionEpochs != 0 && s.cfg.RetentionEpochs < minEpochsForBlockRequests {
+		log.WithFields(logrus.Fields{
+			"retentionEpochs":           s.cfg.RetentionEpochs,
+			"minEpochsForBlockRequests": minEpochsForBlockRequests,
+		}).Warn("Keeping fewer epochs of history than peers are expected to serve")
+	}
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.prune(); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			s.err = err
+			log.WithError(err).Error("Could not prune finalized history")
+		} else {
+			s.err = nil
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop pruning.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of pruning.
+func (s *Service) Status() error {
+	return s.err
+}
+
+// prune deletes the history below the current horizon, if it moved since the last time.
+func (s *Service) prune() error {
+	ctx := s.ctx
+	cp, err := s.cfg.DB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalized, err := s.cfg.DB.Block(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return errors.Wrapf(err, "could not get finalized block with root %#x", cp.Root)
+	}
+	if finalized == nil || finalized.IsNil() {
+		// Nothing is finalized yet, or the node was just started from a checkpoint and syncs its finalized block.
+		return nil
+	}
+	horizon := s.horizonBelow(finalized.Block().Slot())
+	if horizon <= s.horizon {
+		return nil
+	}
+	earliest, root, err := s.earliestKept(ctx, horizon, finalized.Block().Slot(), bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	// The node stops serving the history before deleting it.
+	if err := s.cfg.Status.Prune(ctx, earliest, root); err != nil {
+		return errors.Wrap(err, "could not update the earliest available slot")
+	}
+	pruned, err := s.cfg.DB.PruneBlocksBefore(ctx, earliest)
+	prunedBlocksCount.Add(float64(pruned))
+	if err != nil {
+		return errors.Wrapf(err, "could not prune blocks before slot %d", earliest)
+	}
+	s.horizon = horizon
+	earliestAvailableSlot.Set(float64(earliest))
+	pruneDuration.Observe(float64(time.Since(start).Milliseconds()))
+	if pruned > 0 {
+		log.WithFields(logrus.Fields{
+			"earliestAvailableSlot": earliest,
+			"blocks":                pruned,
+			"duration":              time.Since(start),
+		}).Info("Pruned finalized history")
+	}
+	return nil
+}
+
+// earliestKept returns the slot and root of the lowest block kept when pruning below the horizon. The horizon is
+// rounded down to the highest archived state below it, so that stategen can still replay every slot above the
+// horizon. Without such a state, such as for the blocks backfilled below the origin state, it is rounded up to the
+// next finalized block.
+func (s *Service) earliestKept(
+	ctx context.Context, horizon, finalizedSlot primitives.Slot, finalizedRoot [32]byte,
+) (primitives.Slot, [32]byte, error) {
+	earliest, root, err := s.cfg.DB.HighestArchivedBlockRootAtOrBelow(ctx, horizon)
+	if err == nil {
+		return earliest, root, nil
+	}
+	if !errors.Is(err, db.ErrNotFound) {
+		return 0, [32]byte{}, errors.Wrapf(err, "could not get highest archived state below slot %d", horizon)
+	}
+	earliest, root, err = s.cfg.DB.LowestFinalizedBlockRootAtOrAbove(ctx, horizon)
+	if errors.Is(err, db.ErrNotFound) || (err == nil && earliest > finalizedSlot) {
+		return finalizedSlot, finalizedRoot, nil
+	} else if err != nil {
+		return 0, [32]byte{}, errors.Wrapf(err, "could not get lowest finalized block above slot %d", horizon)
+	}
+	return earliest, root, nil
+}
+
+// horizonBelow returns the slot below which history is pruned, which is never above the given finalized slot.
+func (s *Service) horizonBelow(finalizedSlot primitives.Slot) primitives.Slot {
+	horizon := s.cfg.PruneBeforeSlot
+	if s.cfg.RetentionEpochs != 0 {
+		retention := primitives.Slot(s.cfg.RetentionEpochs) * params.BeaconConfig().SlotsPerEpoch
+		if finalizedSlot > retention && finalizedSlot-retention > horizon {
+			horizon = finalizedSlot - retention
+		}
+	}
+	if horizon > finalizedSlot {
+		horizon = finalizedSlot
+	}
+	return horizon
+}
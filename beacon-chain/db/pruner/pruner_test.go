@@ -0,0 +1,159 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// mockDB holds finalized blocks at the given slots, the last of which is the finalized checkpoint, and archived
+// states at the given archived slots.
+type mockDB struct {
+	slots       []primitives.Slot
+	archived    []primitives.Slot
+	prunedBelow primitives.Slot
+	prunes      int
+}
+
+func (m *mockDB) FinalizedCheckpoint(_ context.Context) (*ethpb.Checkpoint, error) {
+	root := rootAt(m.slots[len(m.slots)-1])
+	return &ethpb.Checkpoint{Root: root[:]}, nil
+}
+
+func (m *mockDB) Block(_ context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	for _, sl := range m.slots {
+		if rootAt(sl) == root {
+			b := util.NewBeaconBlock()
+			b.Block.Slot = sl
+			return blocks.NewSignedBeaconBlock(b)
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockDB) LowestFinalizedBlockRootAtOrAbove(_ context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	for _, sl := range m.slots {
+		if sl >= slot {
+			return sl, rootAt(sl), nil
+		}
+	}
+	return 0, [32]byte{}, db.ErrNotFound
+}
+
+func (m *mockDB) HighestArchivedBlockRootAtOrBelow(_ context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	for i := len(m.archived) - 1; i >= 0; i-- {
+		if m.archived[i] <= slot {
+			return m.archived[i], rootAt(m.archived[i]), nil
+		}
+	}
+	return 0, [32]byte{}, db.ErrNotFound
+}
+
+func (m *mockDB) PruneBlocksBefore(_ context.Context, slot primitives.Slot) (int, error) {
+	m.prunedBelow = slot
+	m.prunes++
+	return 1, nil
+}
+
+type mockStatus struct {
+	earliest primitives.Slot
+	root     [32]byte
+}
+
+func (m *mockStatus) Prune(_ context.Context, earliest primitives.Slot, root [32]byte) error {
+	m.earliest, m.root = earliest, root
+	return nil
+}
+
+func rootAt(sl primitives.Slot) [32]byte {
+	return [32]byte{byte(sl), byte(sl >> 8), 0x01}
+}
+
+func TestService_Prune(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	mdb := &mockDB{slots: []primitives.Slot{0, 5, 40, 70, 5 * slotsPerEpoch}}
+	status := &mockStatus{}
+	s := NewService(context.Background(), &Config{DB: mdb, Status: status, PruneBeforeSlot: 30, RetentionEpochs: 4})
+
+	// The retention horizon is at slot 32, rounded to the next finalized block.
+	require.NoError(t, s.prune())
+	assert.Equal(t, primitives.Slot(40), status.earliest)
+	assert.Equal(t, rootAt(40), status.root)
+	assert.Equal(t, primitives.Slot(40), mdb.prunedBelow)
+
+	// Nothing is pruned until the horizon moves.
+	require.NoError(t, s.prune())
+	assert.Equal(t, 1, mdb.prunes)
+
+	mdb.slots = append(mdb.slots, 6*slotsPerEpoch)
+	require.NoError(t, s.prune())
+	assert.Equal(t, primitives.Slot(70), status.earliest)
+	assert.Equal(t, 2, mdb.prunes)
+
+	// The horizon is rounded down to the highest archived state below it.
+	mdb.archived = []primitives.Slot{0, 70, 5 * slotsPerEpoch}
+	mdb.slots = append(mdb.slots, 7*slotsPerEpoch)
+	require.NoError(t, s.prune())
+	assert.Equal(t, primitives.Slot(70), status.earliest)
+	assert.Equal(t, rootAt(70), status.root)
+	assert.Equal(t, primitives.Slot(70), mdb.prunedBelow)
+	mdb.slots = append(mdb.slots, 10*slotsPerEpoch)
+	require.NoError(t, s.prune())
+	assert.Equal(t, 5*slotsPerEpoch, status.earliest)
+}
+
+func TestService_horizonBelow(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	cases := []struct {
+		name      string
+		cfg       *Config
+		finalized primitives.Slot
+		horizon   primitives.Slot
+	}{
+		{
+			name:      "fixed slot",
+			cfg:       &Config{PruneBeforeSlot: 100},
+			finalized: 1000,
+			horizon:   100,
+		},
+		{
+			name:      "fixed slot above finalized",
+			cfg:       &Config{PruneBeforeSlot: 2000},
+			finalized: 1000,
+			horizon:   1000,
+		},
+		{
+			name:      "retention",
+			cfg:       &Config{RetentionEpochs: 2},
+			finalized: 1000,
+			horizon:   1000 - 2*slotsPerEpoch,
+		},
+		{
+			name:      "retention longer than history",
+			cfg:       &Config{RetentionEpochs: 100},
+			finalized: 1000,
+			horizon:   0,
+		},
+		{
+			name:      "highest of both",
+			cfg:       &Config{PruneBeforeSlot: 100, RetentionEpochs: 100},
+			finalized: 1000,
+			horizon:   100,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewService(context.Background(), c.cfg)
+			assert.Equal(t, c.horizon, s.horizonBelow(c.finalized))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
@@ -22,7 +23,11 @@ func Restore(cliCtx *cli.Context) error {
 	targetDir := cliCtx.String(cmd.RestoreTargetDirFlag.Name)
 
 	restoreDir := path.Join(targetDir, kv.BeaconNodeDbDirName)
-	if file.FileExists(path.Join(restoreDir, kv.DatabaseFileName)) {
+	kind, exists, err := kv.DetectBackend(restoreDir)
+	if err != nil {
+		return err
+	}
+	if exists {
 		resp, err := prompt.ValidatePrompt(
 			os.Stdin, dbExistsYesNoPrompt, prompt.ValidateYesOrNo,
 		)
@@ -37,6 +42,12 @@ func Restore(cliCtx *cli.Context) error {
 	if err := file.MkdirAll(restoreDir); err != nil {
 		return err
 	}
+	// Backups are bolt files, which replace a database stored with another backend.
+	if exists && kind != backend.Bolt {
+		if err := os.RemoveAll(kv.StoragePath(restoreDir, kind)); err != nil {
+			return err
+		}
+	}
 	if err := file.CopyFile(sourceFile, path.Join(restoreDir, kv.DatabaseFileName)); err != nil {
 		return err
 	}
@@ -14,4 +14,8 @@ var (
 		Name: "slasher_proposals_pruned_total",
 		Help: "Total number of old proposals pruned by slasher",
 	})
+	slasherChunksPrunedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_chunks_pruned_total",
+		Help: "Total number of stale min and max span chunks pruned by slasher",
+	})
 )
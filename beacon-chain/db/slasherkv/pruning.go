@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 
 	fssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	bolt "go.etcd.io/bbolt"
@@ -156,6 +157,64 @@ func (s *Store) PruneProposalsAtEpoch(
 	return
 }
 
+// PruneSlasherChunks deletes the min or max span chunks of the given kind stored under the
+// specified disk keys, such as the chunks of validators which have not attested within the
+// history length of slasher. It returns the number of chunks which were stored and deleted.
+func (s *Store) PruneSlasherChunks(
+	ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte,
+) (numPruned uint, err error) {
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slasherChunksBucket)
+		for _, chunkKey := range chunkKeys {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			key := append(fssz.MarshalUint8(make([]byte, 0), uint8(kind)), chunkKey...)
+			if bkt.Get(key) == nil {
+				continue
+			}
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+			slasherChunksPrunedTotal.Inc()
+			numPruned++
+		}
+		return nil
+	})
+	return
+}
+
+// PruneLastEpochsWrittenForValidators deletes the latest epoch written of the given validators,
+// if it is less than or equal to the specified epoch, such as for validators whose chunks were pruned.
+func (s *Store) PruneLastEpochsWrittenForValidators(
+	ctx context.Context, maxEpoch primitives.Epoch, validatorIndices []primitives.ValidatorIndex,
+) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestedEpochsByValidator)
+		for _, valIdx := range validatorIndices {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			key := encodeValidatorIndex(valIdx)
+			epochBytes := bkt.Get(key)
+			if epochBytes == nil {
+				continue
+			}
+			var epoch primitives.Epoch
+			if err := epoch.UnmarshalSSZ(epochBytes); err != nil {
+				return err
+			}
+			if epoch > maxEpoch {
+				continue
+			}
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func slotFromProposalKey(key []byte) primitives.Slot {
 	return primitives.Slot(binary.LittleEndian.Uint64(key[:8]))
 }
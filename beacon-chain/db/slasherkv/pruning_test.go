@@ -216,3 +216,45 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 		}
 	})
 }
+
+func TestStore_PruneSlasherChunks(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	keys := [][]byte{{0}, {1}, {2}}
+	chunks := [][]uint16{{1, 2}, {3, 4}, {5, 6}}
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, keys, chunks))
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MaxSpan, keys, chunks))
+
+	// Keys which are not stored are not counted as pruned.
+	numPruned, err := beaconDB.PruneSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{0}, {1}, {3}})
+	require.NoError(t, err)
+	require.Equal(t, uint(2), numPruned)
+
+	_, exists, err := beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, keys)
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{false, false, true}, exists)
+	// Chunks of the other kind are left untouched.
+	_, exists, err = beaconDB.LoadSlasherChunks(ctx, slashertypes.MaxSpan, keys)
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, true, true}, exists)
+}
+
+func TestStore_PruneLastEpochsWrittenForValidators(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	require.NoError(t, beaconDB.SaveLastEpochsWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{
+		0: 1,
+		1: 2,
+		2: 3,
+	}))
+	require.NoError(t, beaconDB.PruneLastEpochsWrittenForValidators(ctx, 2, []primitives.ValidatorIndex{0, 2, 3}))
+
+	// Only the epochs at or before the max epoch are deleted.
+	epochs, err := beaconDB.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{0, 1, 2})
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(0), epochs[0].Epoch)
+	require.Equal(t, primitives.Epoch(2), epochs[1].Epoch)
+	require.Equal(t, primitives.Epoch(3), epochs[2].Epoch)
+}
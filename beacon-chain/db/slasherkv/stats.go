@@ -0,0 +1,40 @@
+package slasherkv
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BucketStats returns the number of keys and the bytes of the pages used by each bucket of
+// the slasher database. It walks every page of the database, so it is not meant to be called
+// on a hot path.
+func (s *Store) BucketStats(ctx context.Context) ([]*ethpb.SlasherBucketStats, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+	stats := make([]*ethpb.SlasherBucketStats, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			bs := b.Stats()
+			stats = append(stats, &ethpb.SlasherBucketStats{
+				Name:    string(name),
+				NumKeys: uint64(bs.KeyN),
+				Size:    uint64(bs.BranchInuse + bs.LeafInuse),
+			})
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// DatabaseSize returns the size of the slasher database on disk, in bytes.
+func (s *Store) DatabaseSize() (uint64, error) {
+	var size int64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	return uint64(size), err
+}
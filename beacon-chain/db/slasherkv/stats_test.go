@@ -0,0 +1,32 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_BucketStats(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	keys := [][]byte{{0}, {1}, {2}}
+	chunks := [][]uint16{{1, 2}, {3, 4}, {5, 6}}
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, keys, chunks))
+
+	stats, err := beaconDB.BucketStats(ctx)
+	require.NoError(t, err)
+	numKeys := make(map[string]uint64)
+	for _, s := range stats {
+		numKeys[s.Name] = s.NumKeys
+	}
+	require.Equal(t, 5, len(numKeys))
+	require.Equal(t, uint64(3), numKeys[string(slasherChunksBucket)])
+	require.Equal(t, uint64(0), numKeys[string(proposalRecordsBucket)])
+
+	size, err := beaconDB.DatabaseSize()
+	require.NoError(t, err)
+	require.Equal(t, true, size > 0)
+}
@@ -572,6 +572,13 @@ func (f *ForkChoice) updateJustifiedBalances(ctx context.Context, root [32]byte)
 	if err != nil {
 		return errors.Wrap(err, "could not get justified balances")
 	}
+	f.setJustifiedBalances(balances)
+	return nil
+}
+
+// setJustifiedBalances sets the validators balances on the justified checkpoint, and the committee weight
+// and number of active validators derived from them.
+func (f *ForkChoice) setJustifiedBalances(balances []uint64) {
 	f.justifiedBalances = balances
 	f.store.committeeWeight = 0
 	f.numActiveValidators = 0
@@ -582,5 +589,4 @@ func (f *ForkChoice) updateJustifiedBalances(ctx context.Context, root [32]byte)
 		}
 	}
 	f.store.committeeWeight /= uint64(params.BeaconConfig().SlotsPerEpoch)
-	return nil
 }
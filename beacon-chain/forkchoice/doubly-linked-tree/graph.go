@@ -0,0 +1,115 @@
+package doublylinkedtree
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// ForkChoiceGraph returns the fork choice tree with the weight and number of votes of each node,
+// the path followed from the justified checkpoint to the head and the late block reorg decisions
+// for the next slot. The latest vote of every validator is included if includeVotes is set.
+// The caller of this function must hold a read lock in forkchoice.
+func (f *ForkChoice) ForkChoiceGraph(ctx context.Context, includeVotes bool) (*ethpb.ForkChoiceGraph, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, ErrNilNode
+	}
+	currentSlot := slots.CurrentSlot(s.genesisTime)
+	currentEpoch := slots.ToEpoch(currentSlot)
+	proposerHead, _ := f.proposerHead()
+	graph := &ethpb.ForkChoiceGraph{
+		Slot:                          currentSlot,
+		JustifiedCheckpoint:           snapshotCheckpoint(s.justifiedCheckpoint),
+		UnrealizedJustifiedCheckpoint: snapshotCheckpoint(s.unrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: snapshotCheckpoint(s.unrealizedFinalizedCheckpoint),
+		FinalizedCheckpoint:           snapshotCheckpoint(s.finalizedCheckpoint),
+		ProposerBoostRoot:             bytesutil.SafeCopyBytes(s.proposerBoostRoot[:]),
+		PreviousProposerBoostRoot:     bytesutil.SafeCopyBytes(s.previousProposerBoostRoot[:]),
+		PreviousProposerBoostScore:    s.previousProposerBoostScore,
+		CommitteeWeight:               s.committeeWeight,
+		ProposerHeadRoot:              proposerHead[:],
+		ShouldOverrideFcu:             f.evaluateReorg(ethpb.ReorgDecision_OVERRIDE_FCU).Reorg,
+		Nodes:                         make([]*ethpb.ForkChoiceGraphNode, 0, len(s.nodeByRoot)),
+	}
+
+	ballots := make(map[[32]byte]uint64, len(s.nodeByRoot))
+	for i, v := range f.votes {
+		if _, ok := s.nodeByRoot[v.currentRoot]; !ok {
+			continue
+		}
+		ballots[v.currentRoot]++
+		if !includeVotes {
+			continue
+		}
+		var balance uint64
+		if i < len(f.balances) {
+			balance = f.balances[i]
+		}
+		graph.Votes = append(graph.Votes, &ethpb.ForkChoiceGraphVote{
+			ValidatorIndex: primitives.ValidatorIndex(i),
+			Root:           bytesutil.SafeCopyBytes(v.currentRoot[:]),
+			NextRoot:       bytesutil.SafeCopyBytes(v.nextRoot[:]),
+			TargetEpoch:    v.nextEpoch,
+			Balance:        balance,
+		})
+	}
+
+	// Walk the tree breadth first, so that parents come before their children.
+	queue := []*Node{s.treeRootNode}
+	for len(queue) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		n := queue[0]
+		queue = queue[1:]
+		gn := &ethpb.ForkChoiceGraphNode{
+			Slot:                     n.slot,
+			Root:                     bytesutil.SafeCopyBytes(n.root[:]),
+			ExecutionBlockHash:       bytesutil.SafeCopyBytes(n.payloadHash[:]),
+			JustifiedEpoch:           n.justifiedEpoch,
+			UnrealizedJustifiedEpoch: n.unrealizedJustifiedEpoch,
+			FinalizedEpoch:           n.finalizedEpoch,
+			UnrealizedFinalizedEpoch: n.unrealizedFinalizedEpoch,
+			Balance:                  n.balance,
+			Weight:                   n.weight,
+			Ballots:                  ballots[n.root],
+			Optimistic:               n.optimistic,
+			ViableForHead:            n.viableForHead(s.justifiedCheckpoint.Epoch, currentEpoch),
+			Timestamp:                n.timestamp,
+		}
+		if n.parent != nil {
+			gn.ParentRoot = bytesutil.SafeCopyBytes(n.parent.root[:])
+		}
+		if n.bestDescendant != nil {
+			gn.BestDescendant = bytesutil.SafeCopyBytes(n.bestDescendant.root[:])
+		}
+		graph.Nodes = append(graph.Nodes, gn)
+		queue = append(queue, n.children...)
+	}
+
+	if s.headNode != nil {
+		graph.HeadRoot = bytesutil.SafeCopyBytes(s.headNode.root[:])
+		graph.HeadPath = s.headPath()
+	}
+	return graph, nil
+}
+
+// headPath returns the roots of the nodes from the justified node, or the root of the tree
+// if the justified node is not in the store, down to the head node.
+func (s *Store) headPath() [][]byte {
+	var path [][]byte
+	for n := s.headNode; n != nil; n = n.parent {
+		path = append(path, bytesutil.SafeCopyBytes(n.root[:]))
+		if n.root == s.justifiedCheckpoint.Root {
+			break
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
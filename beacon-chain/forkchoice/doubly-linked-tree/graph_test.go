@@ -0,0 +1,66 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestForkChoice_ForkChoiceGraph(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	// Insert two competing branches:
+	//   0 <- 1 <- 2
+	//    \
+	//     <- 3
+	st, root, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 3, indexToHash(3), params.BeaconConfig().ZeroHash, [32]byte{'C'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+
+	f.justifiedBalances = []uint64{10, 20, 30}
+	f.numActiveValidators = 3
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 0)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(3), 0)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), head)
+
+	graph, err := f.ForkChoiceGraph(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(graph.Nodes))
+	require.Equal(t, 0, len(graph.Votes))
+	assert.DeepEqual(t, head[:], graph.HeadRoot)
+	zero := params.BeaconConfig().ZeroHash
+	want := indexToHash(3)
+	assert.DeepEqual(t, [][]byte{zero[:], want[:]}, graph.HeadPath)
+
+	nodes := make(map[[32]byte]*ethpb.ForkChoiceGraphNode)
+	for _, n := range graph.Nodes {
+		assert.Equal(t, true, n.ViableForHead)
+		nodes[bytesutil.ToBytes32(n.Root)] = n
+	}
+	assert.Equal(t, uint64(0), nodes[indexToHash(1)].Ballots)
+	assert.Equal(t, uint64(1), nodes[indexToHash(2)].Ballots)
+	assert.Equal(t, uint64(2), nodes[indexToHash(3)].Ballots)
+	assert.Equal(t, uint64(50), nodes[indexToHash(3)].Weight)
+	assert.DeepEqual(t, want[:], nodes[zero].BestDescendant)
+
+	graph, err = f.ForkChoiceGraph(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(graph.Votes))
+	assert.Equal(t, primitives.ValidatorIndex(2), graph.Votes[2].ValidatorIndex)
+	assert.DeepEqual(t, want[:], graph.Votes[2].Root)
+	assert.Equal(t, uint64(30), graph.Votes[2].Balance)
+}
@@ -270,7 +270,7 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	headRoot, err := f.Head(ctx)
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
-	early, err := f.store.headNode.arrivedEarly(f.store.genesisTime)
+	early, err := f.store.headNode.arrivedEarly(f.store.genesisTime, f.currentReorgConfig().LateBlockCutoff)
 	require.NoError(t, err)
 	require.Equal(t, true, early)
 	late, err := f.store.headNode.arrivedAfterOrphanCheck(f.store.genesisTime)
@@ -278,7 +278,7 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	require.Equal(t, false, late)
 
 	// late block
-	driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
+	driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
 	root = [32]byte{'b'}
 	state, blkRoot, err = prepareForkchoiceState(ctx, 2, root, [32]byte{'a'}, [32]byte{'B'}, 0, 0)
 	require.NoError(t, err)
@@ -286,7 +286,7 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	headRoot, err = f.Head(ctx)
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
-	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime)
+	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime, f.currentReorgConfig().LateBlockCutoff)
 	require.NoError(t, err)
 	require.Equal(t, false, early)
 	late, err = f.store.headNode.arrivedAfterOrphanCheck(f.store.genesisTime)
@@ -302,7 +302,7 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	headRoot, err = f.Head(ctx)
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
-	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime)
+	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime, f.currentReorgConfig().LateBlockCutoff)
 	require.NoError(t, err)
 	require.Equal(t, false, early)
 	late, err = f.store.headNode.arrivedAfterOrphanCheck(f.store.genesisTime)
@@ -317,7 +317,7 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	headRoot, err = f.Head(ctx)
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
-	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime)
+	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime, f.currentReorgConfig().LateBlockCutoff)
 	require.ErrorContains(t, "invalid timestamp", err)
 	require.Equal(t, true, early)
 	late, err = f.store.headNode.arrivedAfterOrphanCheck(f.store.genesisTime)
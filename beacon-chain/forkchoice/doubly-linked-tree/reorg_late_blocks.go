@@ -3,14 +3,31 @@ package doublylinkedtree
 import (
 	"time"
 
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
 
-// orphanLateBlockProposingEarly determines the maximum threshold that we
-// consider the node is proposing early and sure to receive proposer boost
-const orphanLateBlockProposingEarly = 2
+// The reasons recorded in the reorg decision log for orphaning, or not, the head.
+const (
+	reorgReasonDisabled        = "late block reorgs are disabled"
+	reorgReasonNilHead         = "there is no head"
+	reorgReasonNotPreviousSlot = "head is not from the slot before the proposal"
+	reorgReasonEpochBoundary   = "proposal is at an epoch boundary"
+	reorgReasonArrivalUnknown  = "could not check if the head arrived early"
+	reorgReasonArrivedEarly    = "head arrived early"
+	reorgReasonNotFinalizing   = "chain is not finalizing"
+	reorgReasonNilParent       = "head has no parent"
+	reorgReasonSkippedSlot     = "head is not from the slot after its parent"
+	reorgReasonStrongHead      = "head is strong"
+	reorgReasonWeakParent      = "parent is weak"
+	reorgReasonTimingUnknown   = "could not check if proposing early"
+	reorgReasonProposingLate   = "proposing too late to orphan the head"
+	reorgReasonWeakHead        = "head arrived late and is weak"
+)
 
 // ShouldOverrideFCU returns whether the current forkchoice head is weak
 // and thus may be reorged when proposing the next block.
@@ -32,57 +49,10 @@ const orphanLateBlockProposingEarly = 2
 // the engine's view of head with the parent block or the incoming block. It
 // does not guarantee an attempted reorg. This will only be decided later at
 // proposal time by calling GetProposerHead.
-func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
-	override = false
-
-	// We only need to override FCU if our current head is from the current
-	// slot. This differs from the spec implementation in that we assume
-	// that we will call this function in the previous slot to proposing.
-	head := f.store.headNode
-	if head == nil {
-		return
-	}
-
-	if head.slot != slots.CurrentSlot(f.store.genesisTime) {
-		return
-	}
-
-	// Do not reorg on epoch boundaries
-	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		return
-	}
-	// Only reorg blocks that arrive late
-	early, err := head.arrivedEarly(f.store.genesisTime)
-	if err != nil {
-		log.WithError(err).Error("could not check if block arrived early")
-		return
-	}
-	if early {
-		return
-	}
-	// Only reorg if we have been finalizing
-	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
-	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
-		return
-	}
-	// Only orphan a single block
-	parent := head.parent
-	if parent == nil {
-		return
-	}
-	if head.slot > parent.slot+1 {
-		return
-	}
-	// Do not orphan a block that has higher justification than the parent
-	// if head.unrealizedJustifiedEpoch > parent.unrealizedJustifiedEpoch {
-	//		return
-	// }
-
-	// Only orphan a block if the head LMD vote is weak
-	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
-		return
-	}
-	return true
+func (f *ForkChoice) ShouldOverrideFCU() bool {
+	decision := f.evaluateReorg(ethpb.ReorgDecision_OVERRIDE_FCU)
+	f.reorgLog.record(decision)
+	return decision.Reorg
 }
 
 // GetProposerHead returns the block root that has to be used as ParentRoot by a
@@ -93,58 +63,162 @@ func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 // This function needs to be called only when proposing a block and all
 // attestation processing has already happened.
 func (f *ForkChoice) GetProposerHead() [32]byte {
+	root, decision := f.proposerHead()
+	f.reorgLog.record(decision)
+	return root
+}
+
+// proposerHead returns the block root to propose on, without recording the decision.
+func (f *ForkChoice) proposerHead() ([32]byte, *ethpb.ReorgDecision) {
 	if features.Get().DisableReorgLateBlocks {
-		return f.CachedHeadRoot()
+		decision := f.newReorgDecision(ethpb.ReorgDecision_PROPOSER_HEAD)
+		decision.Reason = reorgReasonDisabled
+		root := f.CachedHeadRoot()
+		decision.HeadRoot = root[:]
+		return root, decision
+	}
+	decision := f.evaluateReorg(ethpb.ReorgDecision_PROPOSER_HEAD)
+	if decision.Reorg {
+		return f.store.headNode.parent.root, decision
 	}
+	return f.CachedHeadRoot(), decision
+}
+
+// evaluateReorg decides whether the head is a late block weak enough to be orphaned. The proposal
+// is in the next slot when overriding the forkchoice update, and in the current slot when
+// proposing, as ShouldOverrideFCU is called during the slot before proposing.
+func (f *ForkChoice) evaluateReorg(evaluation ethpb.ReorgDecision_Evaluation) *ethpb.ReorgDecision {
+	cfg := f.currentReorgConfig()
+	decision := f.newReorgDecision(evaluation)
 	head := f.store.headNode
 	if head == nil {
-		return [32]byte{}
+		decision.Reason = reorgReasonNilHead
+		return decision
+	}
+	decision.HeadRoot = bytesutil.SafeCopyBytes(head.root[:])
+	decision.HeadSlot = head.slot
+	decision.HeadWeight = head.weight
+	if secs, err := slots.SecondsSinceSlotStart(head.slot, f.store.genesisTime, head.timestamp); err == nil {
+		decision.HeadArrivalSeconds = secs
+	}
+	parent := head.parent
+	if parent != nil {
+		decision.ParentRoot = bytesutil.SafeCopyBytes(parent.root[:])
+		decision.ParentSlot = parent.slot
+		decision.ParentWeight = parent.weight
 	}
 
-	// Only reorg blocks from the previous slot.
-	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
-		return head.root
+	proposalSlot := decision.Slot
+	if evaluation == ethpb.ReorgDecision_OVERRIDE_FCU {
+		proposalSlot++
+	}
+	// Only reorg blocks from the slot before the proposal.
+	if head.slot+1 != proposalSlot {
+		decision.Reason = reorgReasonNotPreviousSlot
+		return decision
 	}
 	// Do not reorg on epoch boundaries
-	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		return head.root
+	if proposalSlot%params.BeaconConfig().SlotsPerEpoch == 0 {
+		decision.Reason = reorgReasonEpochBoundary
+		return decision
 	}
 	// Only reorg blocks that arrive late
-	early, err := head.arrivedEarly(f.store.genesisTime)
+	early, err := head.arrivedEarly(f.store.genesisTime, cfg.LateBlockCutoff)
 	if err != nil {
 		log.WithError(err).Error("could not check if block arrived early")
-		return head.root
+		decision.Reason = reorgReasonArrivalUnknown
+		return decision
 	}
 	if early {
-		return head.root
+		decision.Reason = reorgReasonArrivedEarly
+		return decision
 	}
 	// Only reorg if we have been finalizing
-	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
-	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
-		return head.root
+	if slots.ToEpoch(proposalSlot) > f.store.finalizedCheckpoint.Epoch+cfg.MaxEpochsSinceFinalization {
+		decision.Reason = reorgReasonNotFinalizing
+		return decision
 	}
 	// Only orphan a single block
-	parent := head.parent
 	if parent == nil {
-		return head.root
+		decision.Reason = reorgReasonNilParent
+		return decision
 	}
 	if head.slot > parent.slot+1 {
-		return head.root
+		decision.Reason = reorgReasonSkippedSlot
+		return decision
 	}
+	// Do not orphan a block that has higher justification than the parent
+	// if head.unrealizedJustifiedEpoch > parent.unrealizedJustifiedEpoch {
+	//		return
+	// }
 
 	// Only orphan a block if the head LMD vote is weak
-	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
-		return head.root
+	if head.weight*100 > f.store.committeeWeight*cfg.HeadWeightThreshold {
+		decision.Reason = reorgReasonStrongHead
+		return decision
+	}
+	// Only build on a parent with a strong LMD vote
+	if cfg.ParentWeightThreshold > 0 && parent.weight*100 <= f.store.committeeWeight*cfg.ParentWeightThreshold {
+		decision.Reason = reorgReasonWeakParent
+		return decision
+	}
+	if evaluation == ethpb.ReorgDecision_PROPOSER_HEAD {
+		// Only reorg if we are proposing early
+		secs, err := slots.SecondsSinceSlotStart(proposalSlot, f.store.genesisTime, decision.Timestamp)
+		if err != nil {
+			log.WithError(err).Error("could not check if proposing early")
+			decision.Reason = reorgReasonTimingUnknown
+			return decision
+		}
+		decision.ProposalSeconds = secs
+		if secs >= cfg.ProposingEarlyCutoff {
+			decision.Reason = reorgReasonProposingLate
+			return decision
+		}
+	}
+	decision.Reorg = true
+	decision.Reason = reorgReasonWeakHead
+	return decision
+}
+
+func (f *ForkChoice) newReorgDecision(evaluation ethpb.ReorgDecision_Evaluation) *ethpb.ReorgDecision {
+	return &ethpb.ReorgDecision{
+		Evaluation:      evaluation,
+		Slot:            slots.CurrentSlot(f.store.genesisTime),
+		Timestamp:       uint64(time.Now().Unix()),
+		CommitteeWeight: f.store.committeeWeight,
+		FinalizedEpoch:  f.store.finalizedCheckpoint.Epoch,
 	}
+}
 
-	// Only reorg if we are proposing early
-	secs, err := slots.SecondsSinceSlotStart(head.slot+1, f.store.genesisTime, uint64(time.Now().Unix()))
-	if err != nil {
-		log.WithError(err).Error("could not check if proposing early")
-		return head.root
+// SetReorgConfig sets the thresholds used to decide whether a late head block is orphaned.
+func (f *ForkChoice) SetReorgConfig(cfg *forkchoicetypes.ReorgConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	f.reorgConfig = cfg
+	return nil
+}
+
+// currentReorgConfig returns the reorg config set on forkchoice, or the default one of the active network.
+func (f *ForkChoice) currentReorgConfig() *forkchoicetypes.ReorgConfig {
+	if f.reorgConfig == nil {
+		return forkchoicetypes.DefaultReorgConfig()
 	}
-	if secs >= orphanLateBlockProposingEarly {
-		return head.root
+	return f.reorgConfig
+}
+
+// ReorgDecisions returns the reorg config in use and the most recent late block reorg evaluations.
+func (f *ForkChoice) ReorgDecisions() *ethpb.ReorgDecisions {
+	cfg := f.currentReorgConfig()
+	return &ethpb.ReorgDecisions{
+		Policy: &ethpb.ReorgPolicy{
+			HeadWeightThreshold:         cfg.HeadWeightThreshold,
+			ParentWeightThreshold:       cfg.ParentWeightThreshold,
+			MaxEpochsSinceFinalization:  cfg.MaxEpochsSinceFinalization,
+			LateBlockCutoffSeconds:      cfg.LateBlockCutoff,
+			ProposingEarlyCutoffSeconds: cfg.ProposingEarlyCutoff,
+		},
+		Decisions: f.reorgLog.list(),
 	}
-	return parent.root
 }
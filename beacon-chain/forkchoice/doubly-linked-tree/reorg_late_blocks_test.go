@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 )
 
@@ -24,7 +27,7 @@ func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
 	require.NoError(t, f.InsertNode(ctx, st, root))
 	f.ProcessAttestation(ctx, []uint64{0, 1, 2}, root, 0)
 
-	driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
+	driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
 	st, root, err = prepareForkchoiceState(ctx, 2, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, 0, 0)
 	require.NoError(t, err)
 	require.NoError(t, f.InsertNode(ctx, st, root))
@@ -44,14 +47,14 @@ func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
 	t.Run("head is not from current slot", func(t *testing.T) {
 		driftGenesisTime(f, 3, 0)
 		require.Equal(t, false, f.ShouldOverrideFCU())
-		driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
+		driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
 	})
 	t.Run("head is from epoch boundary", func(t *testing.T) {
 		saved := f.store.headNode.slot
 		driftGenesisTime(f, params.BeaconConfig().SlotsPerEpoch-1, 0)
 		f.store.headNode.slot = params.BeaconConfig().SlotsPerEpoch - 1
 		require.Equal(t, false, f.ShouldOverrideFCU())
-		driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
+		driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
 		f.store.headNode.slot = saved
 	})
 	t.Run("head is early", func(t *testing.T) {
@@ -63,10 +66,10 @@ func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
 	t.Run("chain not finalizing", func(t *testing.T) {
 		saved := f.store.headNode.slot
 		f.store.headNode.slot = 97
-		driftGenesisTime(f, 97, orphanLateBlockFirstThreshold+1)
+		driftGenesisTime(f, 97, f.currentReorgConfig().LateBlockCutoff+1)
 		require.Equal(t, false, f.ShouldOverrideFCU())
 		f.store.headNode.slot = saved
-		driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
+		driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
 	})
 	t.Run("Not single block reorg", func(t *testing.T) {
 		saved := f.store.headNode.parent.slot
@@ -111,7 +114,7 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 	headRoot, err := f.Head(ctx)
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
-	f.store.headNode.timestamp -= params.BeaconConfig().SecondsPerSlot - orphanLateBlockFirstThreshold
+	f.store.headNode.timestamp -= params.BeaconConfig().SecondsPerSlot - f.currentReorgConfig().LateBlockCutoff
 	t.Run("head is weak", func(t *testing.T) {
 		require.Equal(t, parentRoot, f.GetProposerHead())
 
@@ -166,3 +169,70 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 		require.Equal(t, childRoot, f.GetProposerHead())
 	})
 }
+
+func TestForkChoice_ReorgDecisions(t *testing.T) {
+	f := setup(0, 0)
+	f.numActiveValidators = 640
+	f.justifiedBalances = make([]uint64, f.numActiveValidators)
+	for i := range f.justifiedBalances {
+		f.justifiedBalances[i] = uint64(10)
+		f.store.committeeWeight += uint64(10)
+	}
+	f.store.committeeWeight /= uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	driftGenesisTime(f, 1, 0)
+	parentRoot := [32]byte{'a'}
+	st, root, err := prepareForkchoiceState(ctx, 1, parentRoot, [32]byte{}, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	f.ProcessAttestation(ctx, []uint64{0, 1, 2}, root, 0)
+
+	driftGenesisTime(f, 2, f.currentReorgConfig().LateBlockCutoff+1)
+	st, root, err = prepareForkchoiceState(ctx, 2, [32]byte{'b'}, parentRoot, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	_, err = f.Head(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, true, f.ShouldOverrideFCU())
+	cfg := forkchoicetypes.DefaultReorgConfig()
+	cfg.ParentWeightThreshold = 160
+	require.NoError(t, f.SetReorgConfig(cfg))
+	require.Equal(t, false, f.ShouldOverrideFCU())
+	cfg = forkchoicetypes.DefaultReorgConfig()
+	cfg.HeadWeightThreshold = 101
+	require.ErrorContains(t, "head weight threshold", f.SetReorgConfig(cfg))
+
+	decisions := f.ReorgDecisions()
+	require.Equal(t, uint64(160), decisions.Policy.ParentWeightThreshold)
+	require.Equal(t, 2, len(decisions.Decisions))
+	d := decisions.Decisions[0]
+	require.Equal(t, ethpb.ReorgDecision_OVERRIDE_FCU, d.Evaluation)
+	require.Equal(t, true, d.Reorg)
+	require.Equal(t, reorgReasonWeakHead, d.Reason)
+	require.DeepEqual(t, root[:], d.HeadRoot)
+	require.Equal(t, primitives.Slot(2), d.HeadSlot)
+	require.DeepEqual(t, parentRoot[:], d.ParentRoot)
+	require.Equal(t, f.store.nodeByRoot[parentRoot].weight, d.ParentWeight)
+	require.Equal(t, f.store.committeeWeight, d.CommitteeWeight)
+	require.Equal(t, f.currentReorgConfig().LateBlockCutoff+1, d.HeadArrivalSeconds)
+	d = decisions.Decisions[1]
+	require.Equal(t, false, d.Reorg)
+	require.Equal(t, reorgReasonWeakParent, d.Reason)
+
+	// Computing the graph does not record decisions.
+	_, err = f.ForkChoiceGraph(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(f.ReorgDecisions().Decisions))
+}
+
+func TestReorgDecisionLog(t *testing.T) {
+	l := &reorgDecisionLog{}
+	for i := 0; i < reorgDecisionLogSize+3; i++ {
+		l.record(&ethpb.ReorgDecision{Slot: primitives.Slot(i)})
+	}
+	decisions := l.list()
+	require.Equal(t, reorgDecisionLogSize, len(decisions))
+	require.Equal(t, primitives.Slot(3), decisions[0].Slot)
+	require.Equal(t, primitives.Slot(reorgDecisionLogSize+2), decisions[len(decisions)-1].Slot)
+}
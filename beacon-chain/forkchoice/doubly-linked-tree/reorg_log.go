@@ -0,0 +1,39 @@
+package doublylinkedtree
+
+import (
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// reorgDecisionLogSize is the number of late block reorg evaluations kept in the decision log.
+const reorgDecisionLogSize = 256
+
+// reorgDecisionLog is a ring buffer of the most recent late block reorg evaluations. It has its own
+// lock as evaluations happen while only holding a read lock in forkchoice.
+type reorgDecisionLog struct {
+	sync.Mutex
+	decisions []*ethpb.ReorgDecision
+	next      int // the position of the oldest decision once the buffer is full.
+}
+
+// record adds a decision to the log, overwriting the oldest one if the log is full.
+func (l *reorgDecisionLog) record(decision *ethpb.ReorgDecision) {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.decisions) < reorgDecisionLogSize {
+		l.decisions = append(l.decisions, decision)
+		return
+	}
+	l.decisions[l.next] = decision
+	l.next = (l.next + 1) % reorgDecisionLogSize
+}
+
+// list returns the decisions in the log, oldest first.
+func (l *reorgDecisionLog) list() []*ethpb.ReorgDecision {
+	l.Lock()
+	defer l.Unlock()
+	decisions := make([]*ethpb.ReorgDecision, 0, len(l.decisions))
+	decisions = append(decisions, l.decisions[l.next:]...)
+	return append(decisions, l.decisions[:l.next]...)
+}
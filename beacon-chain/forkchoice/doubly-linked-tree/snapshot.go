@@ -0,0 +1,284 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// Snapshot returns a copy of the fork choice store, with its nodes, checkpoints, proposer boost, latest
+// messages and balances, that can be restored with LoadSnapshot. The node weights are not part of the
+// snapshot as they are recomputed from the latest messages and balances.
+// The caller of this function must hold a read lock in forkchoice.
+func (f *ForkChoice) Snapshot() (*ethpb.ForkChoiceSnapshot, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, ErrNilNode
+	}
+	snapshot := &ethpb.ForkChoiceSnapshot{
+		JustifiedCheckpoint:           snapshotCheckpoint(s.justifiedCheckpoint),
+		UnrealizedJustifiedCheckpoint: snapshotCheckpoint(s.unrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: snapshotCheckpoint(s.unrealizedFinalizedCheckpoint),
+		PreviousJustifiedCheckpoint:   snapshotCheckpoint(s.prevJustifiedCheckpoint),
+		FinalizedCheckpoint:           snapshotCheckpoint(s.finalizedCheckpoint),
+		ProposerBoostRoot:             bytesutil.SafeCopyBytes(s.proposerBoostRoot[:]),
+		PreviousProposerBoostRoot:     bytesutil.SafeCopyBytes(s.previousProposerBoostRoot[:]),
+		PreviousProposerBoostScore:    s.previousProposerBoostScore,
+		Balances:                      make([]uint64, len(f.balances)),
+		Nodes:                         make([]*ethpb.ForkChoiceNodeSnapshot, 0, len(s.nodeByRoot)),
+		SlashedIndices:                make([]primitives.ValidatorIndex, 0, len(s.slashedIndices)),
+	}
+	if s.headNode != nil {
+		snapshot.HeadRoot = bytesutil.SafeCopyBytes(s.headNode.root[:])
+	}
+	// Walk the tree breadth first, so that parents come before their children.
+	queue := []*Node{s.treeRootNode}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		snapshot.Nodes = append(snapshot.Nodes, n.snapshot())
+		queue = append(queue, n.children...)
+	}
+	for index := range s.slashedIndices {
+		snapshot.SlashedIndices = append(snapshot.SlashedIndices, index)
+	}
+	copy(snapshot.Balances, f.balances)
+	snapshot.VoteRoots, snapshot.Votes = encodeVotes(f.votes)
+	return snapshot, nil
+}
+
+// LoadSnapshot replaces the fork choice store with the one saved in the given snapshot. The genesis time,
+// origin root and balances handler of the current store are kept. The node weights are recomputed from the
+// latest messages and balances of the snapshot, and the balances of the justified checkpoint are fetched
+// again. The store is left unchanged if the snapshot does not describe a valid tree or the justified
+// balances cannot be fetched.
+// The caller of this function must hold a lock in forkchoice.
+func (f *ForkChoice) LoadSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.LoadSnapshot")
+	defer span.End()
+
+	if snapshot == nil || len(snapshot.Nodes) == 0 {
+		return ErrNilNode
+	}
+	s := &Store{
+		proposerBoostRoot:          bytesutil.ToBytes32(snapshot.ProposerBoostRoot),
+		previousProposerBoostRoot:  bytesutil.ToBytes32(snapshot.PreviousProposerBoostRoot),
+		previousProposerBoostScore: snapshot.PreviousProposerBoostScore,
+		nodeByRoot:                 make(map[[fieldparams.RootLength]byte]*Node, len(snapshot.Nodes)),
+		nodeByPayload:              make(map[[fieldparams.RootLength]byte]*Node, len(snapshot.Nodes)),
+		slashedIndices:             make(map[primitives.ValidatorIndex]bool, len(snapshot.SlashedIndices)),
+		originRoot:                 f.store.originRoot,
+		genesisTime:                f.store.genesisTime,
+	}
+	var err error
+	if s.justifiedCheckpoint, err = checkpointFromSnapshot(snapshot.JustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.unrealizedJustifiedCheckpoint, err = checkpointFromSnapshot(snapshot.UnrealizedJustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.unrealizedFinalizedCheckpoint, err = checkpointFromSnapshot(snapshot.UnrealizedFinalizedCheckpoint); err != nil {
+		return err
+	}
+	if s.prevJustifiedCheckpoint, err = checkpointFromSnapshot(snapshot.PreviousJustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.finalizedCheckpoint, err = checkpointFromSnapshot(snapshot.FinalizedCheckpoint); err != nil {
+		return err
+	}
+
+	for i, ns := range snapshot.Nodes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		n := nodeFromSnapshot(ns)
+		if _, ok := s.nodeByRoot[n.root]; ok {
+			return errors.Errorf("duplicate node %#x in fork choice snapshot", n.root)
+		}
+		if i == 0 {
+			s.treeRootNode = n
+			s.highestReceivedNode = n
+		} else {
+			parent, ok := s.nodeByRoot[bytesutil.ToBytes32(ns.ParentRoot)]
+			if !ok {
+				return errors.Wrapf(errInvalidParentRoot, "node %#x", n.root)
+			}
+			n.parent = parent
+			parent.children = append(parent.children, n)
+		}
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+		if n.slot > s.highestReceivedNode.slot {
+			s.highestReceivedNode = n
+		}
+	}
+	s.headNode = s.treeRootNode
+	if head, ok := s.nodeByRoot[bytesutil.ToBytes32(snapshot.HeadRoot)]; ok {
+		s.headNode = head
+	}
+	for _, index := range snapshot.SlashedIndices {
+		s.slashedIndices[index] = true
+	}
+	votes, err := decodeVotes(snapshot.VoteRoots, snapshot.Votes)
+	if err != nil {
+		return err
+	}
+	balances := make([]uint64, len(snapshot.Balances))
+	copy(balances, snapshot.Balances)
+	if err := s.restoreWeights(ctx, votes, balances); err != nil {
+		return errors.Wrap(err, "could not restore node weights")
+	}
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(s.genesisTime))
+	if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedCheckpoint.Epoch, s.finalizedCheckpoint.Epoch, currentEpoch); err != nil {
+		return errors.Wrap(err, "could not update best descendant")
+	}
+
+	justifiedBalances, err := f.balancesByRoot(ctx, s.justifiedCheckpoint.Root)
+	if err != nil {
+		return errors.Wrap(err, "could not get justified balances")
+	}
+	f.store = s
+	f.votes = votes
+	f.balances = balances
+	f.setJustifiedBalances(justifiedBalances)
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+// restoreWeights sets the balance of every node to the balances of the validators whose latest message was
+// last applied to it, plus the proposer boost, and recomputes the node weights from them. Votes of slashed
+// validators are not counted, as they were removed from the nodes when the validators were slashed.
+func (s *Store) restoreWeights(ctx context.Context, votes []Vote, balances []uint64) error {
+	for index, vote := range votes {
+		if s.slashedIndices[primitives.ValidatorIndex(index)] || index >= len(balances) {
+			continue
+		}
+		if vote.currentRoot == params.BeaconConfig().ZeroHash {
+			continue
+		}
+		if n, ok := s.nodeByRoot[vote.currentRoot]; ok {
+			n.balance += balances[index]
+		}
+	}
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if n, ok := s.nodeByRoot[s.previousProposerBoostRoot]; ok {
+			n.balance += s.previousProposerBoostScore
+		}
+	}
+	return s.treeRootNode.applyWeightChanges(ctx)
+}
+
+// encodeVotes encodes the latest messages of the validators as the distinct roots they vote for, and the
+// uvarint encoded indices of their current and next roots in that list followed by their next epoch.
+func encodeVotes(votes []Vote) ([][]byte, []byte) {
+	roots := make([][]byte, 0)
+	rootIndices := make(map[[fieldparams.RootLength]byte]uint64)
+	rootIndex := func(root [fieldparams.RootLength]byte) uint64 {
+		i, ok := rootIndices[root]
+		if !ok {
+			i = uint64(len(roots))
+			rootIndices[root] = i
+			roots = append(roots, bytesutil.SafeCopyBytes(root[:]))
+		}
+		return i
+	}
+	encoded := make([]byte, 0, 3*len(votes))
+	for _, vote := range votes {
+		encoded = binary.AppendUvarint(encoded, rootIndex(vote.currentRoot))
+		encoded = binary.AppendUvarint(encoded, rootIndex(vote.nextRoot))
+		encoded = binary.AppendUvarint(encoded, uint64(vote.nextEpoch))
+	}
+	return roots, encoded
+}
+
+// decodeVotes decodes the latest messages of the validators encoded by encodeVotes.
+func decodeVotes(roots [][]byte, encoded []byte) ([]Vote, error) {
+	votes := make([]Vote, 0)
+	next := func() (uint64, error) {
+		v, n := binary.Uvarint(encoded)
+		if n <= 0 {
+			return 0, errInvalidVotes
+		}
+		encoded = encoded[n:]
+		return v, nil
+	}
+	root := func() ([fieldparams.RootLength]byte, error) {
+		i, err := next()
+		if err != nil {
+			return [fieldparams.RootLength]byte{}, err
+		}
+		if i >= uint64(len(roots)) {
+			return [fieldparams.RootLength]byte{}, errors.Wrapf(errInvalidVotes, "unknown root index %d", i)
+		}
+		return bytesutil.ToBytes32(roots[i]), nil
+	}
+	for len(encoded) > 0 {
+		var vote Vote
+		var err error
+		if vote.currentRoot, err = root(); err != nil {
+			return nil, err
+		}
+		if vote.nextRoot, err = root(); err != nil {
+			return nil, err
+		}
+		epoch, err := next()
+		if err != nil {
+			return nil, err
+		}
+		vote.nextEpoch = primitives.Epoch(epoch)
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+func (n *Node) snapshot() *ethpb.ForkChoiceNodeSnapshot {
+	ns := &ethpb.ForkChoiceNodeSnapshot{
+		Slot:                     n.slot,
+		Root:                     bytesutil.SafeCopyBytes(n.root[:]),
+		PayloadHash:              bytesutil.SafeCopyBytes(n.payloadHash[:]),
+		JustifiedEpoch:           n.justifiedEpoch,
+		UnrealizedJustifiedEpoch: n.unrealizedJustifiedEpoch,
+		FinalizedEpoch:           n.finalizedEpoch,
+		UnrealizedFinalizedEpoch: n.unrealizedFinalizedEpoch,
+		Optimistic:               n.optimistic,
+		Timestamp:                n.timestamp,
+	}
+	if n.parent != nil {
+		ns.ParentRoot = bytesutil.SafeCopyBytes(n.parent.root[:])
+	}
+	return ns
+}
+
+func nodeFromSnapshot(ns *ethpb.ForkChoiceNodeSnapshot) *Node {
+	return &Node{
+		slot:                     ns.Slot,
+		root:                     bytesutil.ToBytes32(ns.Root),
+		payloadHash:              bytesutil.ToBytes32(ns.PayloadHash),
+		justifiedEpoch:           ns.JustifiedEpoch,
+		unrealizedJustifiedEpoch: ns.UnrealizedJustifiedEpoch,
+		finalizedEpoch:           ns.FinalizedEpoch,
+		unrealizedFinalizedEpoch: ns.UnrealizedFinalizedEpoch,
+		optimistic:               ns.Optimistic,
+		timestamp:                ns.Timestamp,
+	}
+}
+
+func snapshotCheckpoint(cp *forkchoicetypes.Checkpoint) *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root[:])}
+}
+
+func checkpointFromSnapshot(cp *ethpb.Checkpoint) (*forkchoicetypes.Checkpoint, error) {
+	if cp == nil {
+		return nil, errInvalidNilCheckpoint
+	}
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}, nil
+}
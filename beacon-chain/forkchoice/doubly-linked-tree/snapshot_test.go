@@ -0,0 +1,121 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestForkChoice_SnapshotAndLoad(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	// Insert two competing branches:
+	//   0 <- 1 <- 2
+	//    \
+	//     <- 3
+	st, root, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 3, indexToHash(3), params.BeaconConfig().ZeroHash, [32]byte{'C'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(1)))
+
+	f.justifiedBalances = []uint64{10, 20, 30}
+	f.numActiveValidators = 3
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 0)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(3), 0)
+	f.InsertSlashedIndex(ctx, 0)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), head)
+
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(snapshot.Nodes))
+	assert.DeepEqual(t, params.BeaconConfig().ZeroHash[:], snapshot.Nodes[0].Root)
+
+	loaded := New()
+	loaded.SetGenesisTime(f.store.genesisTime)
+	loaded.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return []uint64{10, 20, 30}, nil })
+	require.NoError(t, loaded.LoadSnapshot(ctx, snapshot))
+	require.Equal(t, f.NodeCount(), loaded.NodeCount())
+	require.Equal(t, indexToHash(3), loaded.CachedHeadRoot())
+	for _, r := range [][32]byte{params.BeaconConfig().ZeroHash, indexToHash(1), indexToHash(2), indexToHash(3)} {
+		want, err := f.Weight(r)
+		require.NoError(t, err)
+		got, err := loaded.Weight(r)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	require.Equal(t, uint64(50), loaded.store.nodeByRoot[indexToHash(3)].weight)
+	optimistic, err := loaded.IsOptimistic(indexToHash(1))
+	require.NoError(t, err)
+	require.Equal(t, false, optimistic)
+	require.DeepEqual(t, f.votes, loaded.votes)
+	require.DeepEqual(t, f.balances, loaded.balances)
+	require.DeepEqual(t, f.justifiedBalances, loaded.justifiedBalances)
+	require.Equal(t, f.numActiveValidators, loaded.numActiveValidators)
+	require.Equal(t, uint64(60/params.BeaconConfig().SlotsPerEpoch), loaded.store.committeeWeight)
+	require.DeepEqual(t, f.store.slashedIndices, loaded.store.slashedIndices)
+	require.DeepEqual(t, f.JustifiedCheckpoint(), loaded.JustifiedCheckpoint())
+	require.DeepEqual(t, f.FinalizedCheckpoint(), loaded.FinalizedCheckpoint())
+	require.Equal(t, primitives.Slot(3), loaded.HighestReceivedBlockSlot())
+
+	// The restored store computes the same head as the original one, before and after new votes.
+	head, err = f.Head(ctx)
+	require.NoError(t, err)
+	loadedHead, err := loaded.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, head, loadedHead)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(2), 1)
+	loaded.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(2), 1)
+	head, err = f.Head(ctx)
+	require.NoError(t, err)
+	loadedHead, err = loaded.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(2), head)
+	require.Equal(t, head, loadedHead)
+}
+
+func TestForkChoice_LoadSnapshot_Invalid(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	st, root, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, params.BeaconConfig().ZeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+
+	loaded := setup(0, 0)
+	require.ErrorIs(t, loaded.LoadSnapshot(ctx, &ethpb.ForkChoiceSnapshot{}), ErrNilNode)
+
+	orphan := indexToHash(2)
+	snapshot.Nodes[1].ParentRoot = orphan[:]
+	require.ErrorIs(t, loaded.LoadSnapshot(ctx, snapshot), errInvalidParentRoot)
+
+	snapshot.Nodes[1].ParentRoot = params.BeaconConfig().ZeroHash[:]
+	snapshot.FinalizedCheckpoint = nil
+	require.ErrorIs(t, loaded.LoadSnapshot(ctx, snapshot), errInvalidNilCheckpoint)
+
+	snapshot.FinalizedCheckpoint = snapshot.JustifiedCheckpoint
+	snapshot.Votes = []byte{0, 1, 0}
+	require.ErrorIs(t, loaded.LoadSnapshot(ctx, snapshot), errInvalidVotes)
+
+	snapshot.Votes = nil
+	loaded.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return nil, errors.New("no state") })
+	require.ErrorContains(t, "could not get justified balances", loaded.LoadSnapshot(ctx, snapshot))
+
+	// The store is unchanged after a failed load.
+	require.Equal(t, 1, loaded.NodeCount())
+	require.Equal(t, false, loaded.HasNode(indexToHash(1)))
+}
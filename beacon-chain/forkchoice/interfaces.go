@@ -8,6 +8,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 // BalancesByRooter is a handler to obtain the effective balances of the state
@@ -25,6 +26,7 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Getter               // to retrieve fork choice information.
 	Setter               // to set fork choice information.
+	Persister            // to save and restore fork choice across restarts.
 }
 
 // HeadRetriever retrieves head root and optimistic info of the current chain.
@@ -61,10 +63,18 @@ type Getter interface {
 	HighestReceivedBlockSlot() primitives.Slot
 	ReceivedBlocksLastEpoch() (uint64, error)
 	ForkChoiceDump(context.Context) (*v1.ForkChoiceDump, error)
+	ForkChoiceGraph(ctx context.Context, includeVotes bool) (*ethpb.ForkChoiceGraph, error)
 	Weight(root [32]byte) (uint64, error)
 	Tips() ([][32]byte, []primitives.Slot)
 	IsOptimistic(root [32]byte) (bool, error)
 	ShouldOverrideFCU() bool
+	ReorgDecisions() *ethpb.ReorgDecisions
+}
+
+// Persister saves the fork choice store in a snapshot and restores it from one.
+type Persister interface {
+	Snapshot() (*ethpb.ForkChoiceSnapshot, error)
+	LoadSnapshot(context.Context, *ethpb.ForkChoiceSnapshot) error
 }
 
 // Setter allows to set forkchoice information
@@ -78,4 +88,5 @@ type Setter interface {
 	NewSlot(context.Context, primitives.Slot) error
 	SetBalancesByRooter(BalancesByRooter)
 	InsertSlashedIndex(context.Context, primitives.ValidatorIndex)
+	SetReorgConfig(*forkchoicetypes.ReorgConfig) error
 }
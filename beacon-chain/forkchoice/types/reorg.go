@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// ReorgConfig defines when a late head block is weak enough to be orphaned by proposing on top of its parent.
+type ReorgConfig struct {
+	// HeadWeightThreshold is the percentage of the committee weight the head must not exceed to be orphaned.
+	HeadWeightThreshold uint64
+	// ParentWeightThreshold is the percentage of the committee weight the parent must exceed to be proposed on.
+	// Zero disables the check.
+	ParentWeightThreshold uint64
+	// MaxEpochsSinceFinalization is the number of epochs since the finalized checkpoint after which blocks are not orphaned.
+	MaxEpochsSinceFinalization primitives.Epoch
+	// LateBlockCutoff is the number of seconds into its slot from which a block is late.
+	LateBlockCutoff uint64
+	// ProposingEarlyCutoff is the number of seconds into the slot before which a proposer is sure to receive the
+	// proposer boost, and thus able to orphan the head.
+	ProposingEarlyCutoff uint64
+}
+
+// DefaultReorgConfig returns the reorg config of the active network.
+func DefaultReorgConfig() *ReorgConfig {
+	cfg := params.BeaconConfig()
+	intervalDuration := cfg.SecondsPerSlot / cfg.IntervalsPerSlot
+	return &ReorgConfig{
+		HeadWeightThreshold:        cfg.ReorgWeightThreshold,
+		MaxEpochsSinceFinalization: cfg.ReorgMaxEpochsSinceFinalization,
+		LateBlockCutoff:            intervalDuration,
+		ProposingEarlyCutoff:       intervalDuration / 2,
+	}
+}
+
+// Validate returns an error if the config could never or would always orphan a late block.
+func (c *ReorgConfig) Validate() error {
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	if c.HeadWeightThreshold > 100 {
+		return errors.Errorf("head weight threshold %d is more than 100%% of the committee weight", c.HeadWeightThreshold)
+	}
+	if c.LateBlockCutoff == 0 || c.LateBlockCutoff >= secondsPerSlot {
+		return errors.Errorf("late block cutoff of %d seconds must be within the %d seconds of a slot", c.LateBlockCutoff, secondsPerSlot)
+	}
+	if c.ProposingEarlyCutoff == 0 || c.ProposingEarlyCutoff >= secondsPerSlot {
+		return errors.Errorf("proposing early cutoff of %d seconds must be within the %d seconds of a slot", c.ProposingEarlyCutoff, secondsPerSlot)
+	}
+	return nil
+}
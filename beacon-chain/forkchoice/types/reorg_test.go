@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestDefaultReorgConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	cfg := DefaultReorgConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, uint64(20), cfg.HeadWeightThreshold)
+	assert.Equal(t, uint64(0), cfg.ParentWeightThreshold)
+	assert.Equal(t, uint64(4), cfg.LateBlockCutoff)
+	assert.Equal(t, uint64(2), cfg.ProposingEarlyCutoff)
+
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	cfg = DefaultReorgConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, uint64(2), cfg.LateBlockCutoff)
+	assert.Equal(t, uint64(1), cfg.ProposingEarlyCutoff)
+}
+
+func TestReorgConfig_Validate(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+
+	cfg := DefaultReorgConfig()
+	cfg.HeadWeightThreshold = 101
+	require.ErrorContains(t, "head weight threshold 101", cfg.Validate())
+
+	cfg = DefaultReorgConfig()
+	cfg.LateBlockCutoff = 12
+	require.ErrorContains(t, "late block cutoff of 12 seconds", cfg.Validate())
+
+	cfg = DefaultReorgConfig()
+	cfg.ProposingEarlyCutoff = 0
+	require.ErrorContains(t, "proposing early cutoff of 0 seconds", cfg.Validate())
+}
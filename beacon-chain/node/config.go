@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	fastssz "github.com/prysmaticlabs/fastssz"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -70,6 +71,45 @@ func configureBuilderCircuitBreaker(cliCtx *cli.Context) error {
 	return nil
 }
 
+func configureBuilderBidSelection(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.MinBuilderBid.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MinBuilderBid = cliCtx.Uint64(flags.MinBuilderBid.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.BuilderBoostFactor.Name) {
+		c := params.BeaconConfig().Copy()
+		c.BuilderBoostFactor = cliCtx.Uint64(flags.BuilderBoostFactor.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configureReorgLateBlocks returns the network's late block reorg config, with the thresholds set by flags.
+func configureReorgLateBlocks(cliCtx *cli.Context) *forkchoicetypes.ReorgConfig {
+	cfg := forkchoicetypes.DefaultReorgConfig()
+	if cliCtx.IsSet(flags.ReorgHeadWeightThreshold.Name) {
+		cfg.HeadWeightThreshold = cliCtx.Uint64(flags.ReorgHeadWeightThreshold.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgParentWeightThreshold.Name) {
+		cfg.ParentWeightThreshold = cliCtx.Uint64(flags.ReorgParentWeightThreshold.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalization.Name) {
+		cfg.MaxEpochsSinceFinalization = primitives.Epoch(cliCtx.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name))
+	}
+	if cliCtx.IsSet(flags.ReorgLateBlockCutoff.Name) {
+		cfg.LateBlockCutoff = cliCtx.Uint64(flags.ReorgLateBlockCutoff.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgProposingEarlyCutoff.Name) {
+		cfg.ProposingEarlyCutoff = cliCtx.Uint64(flags.ReorgProposingEarlyCutoff.Name)
+	}
+	return cfg
+}
+
 func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
 		c := params.BeaconConfig().Copy()
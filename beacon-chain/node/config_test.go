@@ -53,6 +53,41 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, primitives.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureBuilderBidSelection(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.MinBuilderBid.Name, 0, "")
+	set.Uint64(flags.BuilderBoostFactor.Name, 0, "")
+	require.NoError(t, set.Set(flags.MinBuilderBid.Name, "1000"))
+	require.NoError(t, set.Set(flags.BuilderBoostFactor.Name, "90"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureBuilderBidSelection(cliCtx))
+
+	assert.Equal(t, uint64(1000), params.BeaconConfig().MinBuilderBid)
+	assert.Equal(t, uint64(90), params.BeaconConfig().BuilderBoostFactor)
+}
+
+func TestConfigureReorgLateBlocks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ReorgParentWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgLateBlockCutoff.Name, 0, "")
+	require.NoError(t, set.Set(flags.ReorgParentWeightThreshold.Name, "160"))
+	require.NoError(t, set.Set(flags.ReorgLateBlockCutoff.Name, "3"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	cfg := configureReorgLateBlocks(cliCtx)
+	assert.Equal(t, params.BeaconConfig().ReorgWeightThreshold, cfg.HeadWeightThreshold)
+	assert.Equal(t, uint64(160), cfg.ParentWeightThreshold)
+	assert.Equal(t, uint64(3), cfg.LateBlockCutoff)
+	assert.Equal(t, uint64(2), cfg.ProposingEarlyCutoff)
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
@@ -15,6 +15,7 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	apigateway "github.com/prysmaticlabs/prysm/v4/api/gateway"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
@@ -23,7 +24,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v4/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
@@ -106,6 +109,7 @@ type BeaconNode struct {
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	router                  *mux.Router
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -135,6 +139,9 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := configureBuilderBidSelection(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureSlotsPerArchivedPoint(cliCtx); err != nil {
 		return nil, err
 	}
@@ -174,6 +181,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		router:                  mux.NewRouter(),
 	}
 
 	for _, opt := range opts {
@@ -183,6 +191,9 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	}
 
 	beacon.forkChoicer = doublylinkedtree.New()
+	if err := beacon.forkChoicer.SetReorgConfig(configureReorgLateBlocks(cliCtx)); err != nil {
+		return nil, errors.Wrap(err, "invalid late block reorg config")
+	}
 	depositAddress, err := execution.DepositContractAddress()
 	if err != nil {
 		return nil, err
@@ -238,7 +249,17 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	}
 
 	log.Debugln("Registering Sync Service")
-	if err := beacon.registerSyncService(); err != nil {
+	if err := beacon.registerSyncService(bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(bfs); err != nil {
 		return nil, err
 	}
 
@@ -358,7 +379,15 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
-	d, err := db.NewDB(b.ctx, dbPath)
+	var opts []kv.Option
+	if cliCtx.IsSet(flags.DBBackend.Name) {
+		kind, err := backend.ParseKind(cliCtx.String(flags.DBBackend.Name))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, kv.WithBackend(kind))
+	}
+	d, err := db.NewDB(b.ctx, dbPath, opts...)
 	if err != nil {
 		return err
 	}
@@ -380,7 +409,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
-		d, err = db.NewDB(b.ctx, dbPath)
+		d, err = db.NewDB(b.ctx, dbPath, opts...)
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
 		}
@@ -529,6 +558,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PTrustedPeers.Name)),
 		BootstrapNodeAddr: bootstrapNodeAddrs,
 		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
 		DataDir:           dataDir,
@@ -542,6 +572,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		ScoringConfigFile: cliCtx.String(cmd.P2PScoringConfig.Name),
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		StateNotifier:     b,
 		DB:                b.db,
@@ -649,7 +680,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
-func (b *BeaconNode) registerSyncService() error {
+func (b *BeaconNode) registerSyncService(bfs *backfill.Status) error {
 	var web3Service *execution.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
 		return err
@@ -665,8 +696,7 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
-	rs := regularsync.NewService(
-		b.ctx,
+	opts := []regularsync.Option{
 		regularsync.WithDatabase(b.db),
 		regularsync.WithP2P(b.fetchP2P()),
 		regularsync.WithChainService(chainService),
@@ -684,7 +714,12 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
-	)
+	}
+	// Blocks below the earliest available slot are only refused once history may be pruned.
+	if b.pruningEnabled() {
+		opts = append(opts, regularsync.WithAvailableBlocker(bfs))
+	}
+	rs := regularsync.NewService(b.ctx, opts...)
 	return b.services.RegisterService(rs)
 }
 
@@ -704,6 +739,60 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(bfs *backfill.Status) error {
+	reconstructStates := b.cliCtx.Bool(flags.ReconstructStates.Name)
+	if !b.cliCtx.Bool(flags.Backfill.Name) {
+		if reconstructStates {
+			return fmt.Errorf("--%s can only be used along with --%s", flags.ReconstructStates.Name, flags.Backfill.Name)
+		}
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var initSyncService *initialsync.Service
+	if err := b.services.FetchService(&initSyncService); err != nil {
+		return err
+	}
+
+	cfg := &backfill.Config{
+		P2P:             b.fetchP2P(),
+		DB:              b.db,
+		Chain:           chainService,
+		InitialSync:     initSyncService,
+		Status:          bfs,
+		BatchSize:       b.cliCtx.Uint64(flags.BackfillBatchSize.Name),
+		BlocksPerSecond: b.cliCtx.Uint64(flags.BackfillBlocksPerSecond.Name),
+	}
+	if reconstructStates {
+		cfg.StateReconstructor = stategen.NewReconstructor(b.db)
+	}
+	return b.services.RegisterService(backfill.NewService(b.ctx, cfg))
+}
+
+func (b *BeaconNode) registerPrunerService(bfs *backfill.Status) error {
+	if !b.pruningEnabled() {
+		return nil
+	}
+	pruneBeforeSlot := b.cliCtx.Uint64(flags.PruneBeforeSlot.Name)
+	retentionEpochs := b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)
+	if b.cliCtx.Bool(flags.ReconstructStates.Name) {
+		return fmt.Errorf("--%s cannot be used along with --%s or --%s", flags.ReconstructStates.Name, flags.PruneBeforeSlot.Name, flags.HistoryRetentionEpochs.Name)
+	}
+	return b.services.RegisterService(pruner.NewService(b.ctx, &pruner.Config{
+		DB:              b.db,
+		Status:          bfs,
+		PruneBeforeSlot: primitives.Slot(pruneBeforeSlot),
+		RetentionEpochs: primitives.Epoch(retentionEpochs),
+	}))
+}
+
+// pruningEnabled checks if the blocks and states below a slot are pruned from the database.
+func (b *BeaconNode) pruningEnabled() bool {
+	return b.cliCtx.Uint64(flags.PruneBeforeSlot.Name) != 0 || b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name) != 0
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -717,6 +806,18 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	var replay *slasher.ReplayConfig
+	if b.cliCtx.IsSet(flags.SlasherReplayStartEpoch.Name) {
+		replay = &slasher.ReplayConfig{
+			StartEpoch: primitives.Epoch(b.cliCtx.Uint64(flags.SlasherReplayStartEpoch.Name)),
+			EndEpoch:   params.BeaconConfig().FarFutureEpoch,
+			ReportPath: b.cliCtx.String(flags.SlasherReplayReport.Name),
+		}
+		if b.cliCtx.IsSet(flags.SlasherReplayEndEpoch.Name) {
+			replay.EndEpoch = primitives.Epoch(b.cliCtx.Uint64(flags.SlasherReplayEndEpoch.Name))
+		}
+	}
+
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -727,6 +828,8 @@ func (b *BeaconNode) registerSlasherService() error {
 		SlashingPoolInserter:    b.slashingsPool,
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
+		BeaconDatabase:          b.db,
+		Replay:                  replay,
 	})
 	if err != nil {
 		return err
@@ -782,6 +885,11 @@ func (b *BeaconNode) registerRPCService() error {
 
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	// Native HTTP handlers share the gateway's router, and are only served alongside the Beacon API.
+	var router *mux.Router
+	if !b.cliCtx.Bool(flags.DisableGRPCGateway.Name) && flags.EnableHTTPEthAPI(b.cliCtx.String(flags.HTTPModules.Name)) {
+		router = b.router
+	}
 
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
@@ -814,6 +922,8 @@ func (b *BeaconNode) registerRPCService() error {
 		SlashingsPool:                 b.slashingsPool,
 		BLSChangesPool:                b.blsToExecPool,
 		SlashingChecker:               slasherService,
+		SlasherAttestationsFeed:       b.slasherAttestationsFeed,
+		SlasherBlockHeadersFeed:       b.slasherBlockHeadersFeed,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
@@ -830,6 +940,7 @@ func (b *BeaconNode) registerRPCService() error {
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
 		BlockBuilder:                  b.fetchBuilderService(),
+		Router:                        router,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -892,6 +1003,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 		apigateway.WithMaxCallRecvMsgSize(maxCallSize),
 		apigateway.WithAllowedOrigins(allowedOrigins),
 		apigateway.WithTimeout(uint64(timeout)),
+		apigateway.WithRouter(b.router),
 	}
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
@@ -964,7 +1076,9 @@ func (b *BeaconNode) registerBuilderService() error {
 
 	opts := append(b.serviceFlagOpts.builderOpts,
 		builder.WithHeadFetcher(chainService),
-		builder.WithDatabase(b.db))
+		builder.WithTimeFetcher(chainService),
+		builder.WithDatabase(b.db),
+		builder.WithProposerCache(b.proposerIdsCache))
 	svc, err := builder.NewService(b.ctx, opts...)
 	if err != nil {
 		return err
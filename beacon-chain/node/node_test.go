@@ -173,6 +173,17 @@ func TestMonitor_RegisteredCorrectly(t *testing.T) {
 	require.Equal(t, false, mService.TrackedValidators[100])
 }
 
+func TestBackfill_ReconstructStatesRequiresBackfill(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, flags.Backfill.Apply(set))
+	require.NoError(t, flags.ReconstructStates.Apply(set))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, cliCtx.Set(flags.ReconstructStates.Name, "true"))
+	n := &BeaconNode{ctx: context.Background(), cliCtx: cliCtx, services: runtime.NewServiceRegistry()}
+	require.ErrorContains(t, "--reconstruct-states can only be used along with --backfill", n.registerBackfillService(nil))
+}
+
 func Test_hasNetworkFlag(t *testing.T) {
 	tests := []struct {
 		name         string
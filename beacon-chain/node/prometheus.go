@@ -3,6 +3,7 @@ package node
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -49,11 +50,21 @@ func (bc *bcnodeCollector) Collect(ch chan<- prometheus.Metric) {
 }
 
 func (bc *bcnodeCollector) getCurrentDbBytes() (float64, error) {
-	fs, err := os.Stat(bc.dbPath)
+	// The database is a directory of files when it is stored with pebble.
+	var size int64
+	err := filepath.Walk(bc.dbPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
 	if err != nil {
 		return 0, fmt.Errorf("could not collect database file size for prometheus, path=%s, err=%s", bc.dbPath, err)
 	}
-	return float64(fs.Size()), nil
+	return float64(size), nil
 }
 
 func (bc *bcnodeCollector) unregister() {
@@ -11,6 +11,7 @@ type Config struct {
 	NoDiscovery         bool
 	EnableUPnP          bool
 	StaticPeers         []string
+	TrustedPeers        []string
 	BootstrapNodeAddr   []string
 	Discv5BootStrapAddr []string
 	RelayNodeAddr       string
@@ -25,6 +26,7 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	ScoringConfigFile   string
 	StateNotifier       statefeed.Notifier
 	DB                  db.ReadOnlyDatabase
 }
@@ -32,6 +32,10 @@ func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
+	// Always allow dialing trusted peers, whatever the ip filters.
+	if s.peers.IsTrusted(pid) {
+		return true
+	}
 	// Disallow bad peers from dialing in.
 	if s.peers.IsBad(pid) {
 		return false
@@ -53,6 +57,11 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
+	// The peer is only known once the connection is secured, so when we have trusted peers, which
+	// are neither filtered nor limited, the peer limit and filters are checked in InterceptSecured instead.
+	if !s.trustedPeers.empty() {
+		return true
+	}
 	if s.isPeerAtLimit(true /* inbound */) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
@@ -63,8 +72,17 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Service) InterceptSecured(dir network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// Inbound peers are only checked here when we have trusted peers, see InterceptAccept.
+	if dir != network.DirInbound || s.trustedPeers.empty() || s.peers.IsTrusted(pid) {
+		return true
+	}
+	if s.isPeerAtLimit(true /* inbound */) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting inbound connection")
+		return false
+	}
+	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
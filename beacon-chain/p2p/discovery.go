@@ -315,20 +315,21 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 // determines whether our currently connected and
 // active peers are above our set max peer limit.
 func (s *Service) isPeerAtLimit(inbound bool) bool {
-	numOfConns := len(s.host.Network().Peers())
+	// Trusted peers have reserved slots, so they do not count towards the limits.
+	numOfConns := len(s.peers.Untrusted(s.host.Network().Peers()))
 	maxPeers := int(s.cfg.MaxPeers)
 	// If we are measuring the limit for inbound peers
 	// we apply the high watermark buffer.
 	if inbound {
 		maxPeers += highWatermarkBuffer
 		maxInbound := s.peers.InboundLimit() + highWatermarkBuffer
-		currInbound := len(s.peers.InboundConnected())
+		currInbound := len(s.peers.Untrusted(s.peers.InboundConnected()))
 		// Exit early if we are at the inbound limit.
 		if currInbound >= maxInbound {
 			return true
 		}
 	}
-	activePeers := len(s.Peers().Active())
+	activePeers := len(s.peers.Untrusted(s.Peers().Active()))
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
 
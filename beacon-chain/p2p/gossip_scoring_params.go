@@ -97,33 +97,41 @@ func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds)
 	return scoreParams, thresholds
 }
 
+// topicScoreParams returns the score parameters of a topic, with the topic weight overridden
+// by the scoring config if it sets one for the topic's gossip message.
 func (s *Service) topicScoreParams(topic string) (*pubsub.TopicScoreParams, error) {
 	activeValidators, err := s.retrieveActiveValidators()
 	if err != nil {
 		return nil, err
 	}
+	var message string
+	var scoreParams *pubsub.TopicScoreParams
 	switch {
 	case strings.Contains(topic, GossipBlockMessage):
-		return defaultBlockTopicParams(), nil
+		message, scoreParams = GossipBlockMessage, defaultBlockTopicParams()
 	case strings.Contains(topic, GossipAggregateAndProofMessage):
-		return defaultAggregateTopicParams(activeValidators), nil
+		message, scoreParams = GossipAggregateAndProofMessage, defaultAggregateTopicParams(activeValidators)
 	case strings.Contains(topic, GossipAttestationMessage):
-		return defaultAggregateSubnetTopicParams(activeValidators), nil
+		message, scoreParams = GossipAttestationMessage, defaultAggregateSubnetTopicParams(activeValidators)
 	case strings.Contains(topic, GossipSyncCommitteeMessage):
-		return defaultSyncSubnetTopicParams(activeValidators), nil
+		message, scoreParams = GossipSyncCommitteeMessage, defaultSyncSubnetTopicParams(activeValidators)
 	case strings.Contains(topic, GossipContributionAndProofMessage):
-		return defaultSyncContributionTopicParams(), nil
+		message, scoreParams = GossipContributionAndProofMessage, defaultSyncContributionTopicParams()
 	case strings.Contains(topic, GossipExitMessage):
-		return defaultVoluntaryExitTopicParams(), nil
+		message, scoreParams = GossipExitMessage, defaultVoluntaryExitTopicParams()
 	case strings.Contains(topic, GossipProposerSlashingMessage):
-		return defaultProposerSlashingTopicParams(), nil
+		message, scoreParams = GossipProposerSlashingMessage, defaultProposerSlashingTopicParams()
 	case strings.Contains(topic, GossipAttesterSlashingMessage):
-		return defaultAttesterSlashingTopicParams(), nil
+		message, scoreParams = GossipAttesterSlashingMessage, defaultAttesterSlashingTopicParams()
 	case strings.Contains(topic, GossipBlsToExecutionChangeMessage):
-		return defaultBlsToExecutionChangeTopicParams(), nil
+		message, scoreParams = GossipBlsToExecutionChangeMessage, defaultBlsToExecutionChangeTopicParams()
 	default:
 		return nil, errors.Errorf("unrecognized topic provided for parameter registration: %s", topic)
 	}
+	if weight, ok := s.currentScoringConfig().topicWeight(message); ok && scoreParams != nil {
+		scoreParams.TopicWeight = weight
+	}
+	return scoreParams, nil
 }
 
 func (s *Service) retrieveActiveValidators() (uint64, error) {
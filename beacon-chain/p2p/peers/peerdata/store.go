@@ -61,9 +61,11 @@ type PeerData struct {
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
 	// Gossip Scoring data.
-	TopicScores      map[string]*ethpb.TopicScoreSnapshot
-	GossipScore      float64
-	BehaviourPenalty float64
+	TopicScores        map[string]*ethpb.TopicScoreSnapshot
+	GossipScore        float64
+	BehaviourPenalty   float64
+	AppSpecificScore   float64
+	IPColocationFactor float64
 }
 
 // NewStore creates new peer data store.
@@ -83,6 +83,20 @@ func (s *BadResponsesScorer) Params() *BadResponsesScorerConfig {
 	return s.config
 }
 
+// Threshold returns the number of bad responses tolerated before a peer is deemed bad.
+func (s *BadResponsesScorer) Threshold() int {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.config.Threshold
+}
+
+// SetThreshold sets the number of bad responses tolerated before a peer is deemed bad.
+func (s *BadResponsesScorer) SetThreshold(threshold int) {
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.config.Threshold = threshold
+}
+
 // Count obtains the number of bad responses we have received from the given remote peer.
 func (s *BadResponsesScorer) Count(pid peer.ID) (int, error) {
 	s.store.RLock()
@@ -21,17 +21,24 @@ type GossipScorer struct {
 }
 
 // GossipScorerConfig holds configuration parameters for gossip scoring service.
-type GossipScorerConfig struct{}
+type GossipScorerConfig struct {
+	// Threshold is the gossip score below which a peer is deemed bad.
+	Threshold float64
+}
 
 // newGossipScorer creates new gossip scoring service.
 func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
 	if config == nil {
 		config = &GossipScorerConfig{}
 	}
-	return &GossipScorer{
+	scorer := &GossipScorer{
 		config: config,
 		store:  store,
 	}
+	if scorer.config.Threshold == 0 {
+		scorer.config.Threshold = gossipThreshold
+	}
+	return scorer
 }
 
 // Score returns calculated peer score.
@@ -63,7 +70,26 @@ func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
 	if !ok {
 		return false
 	}
-	return peerData.GossipScore < gossipThreshold
+	return peerData.GossipScore < s.config.Threshold
+}
+
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
+// Threshold returns the gossip score below which a peer is deemed bad.
+func (s *GossipScorer) Threshold() float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.config.Threshold
+}
+
+// SetThreshold sets the gossip score below which a peer is deemed bad.
+func (s *GossipScorer) SetThreshold(threshold float64) {
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.config.Threshold = threshold
 }
 
 // BadPeers returns the peers that are considered bad.
@@ -108,3 +134,25 @@ func (s *GossipScorer) gossipData(pid peer.ID) (float64, float64, map[string]*pb
 	}
 	return 0, 0, nil, peerdata.ErrPeerUnknown
 }
+
+// SetGossipPenalties sets the application specific score and the IP colocation factor of a peer,
+// as computed by gossipsub.
+func (s *GossipScorer) SetGossipPenalties(pid peer.ID, appSpecificScore, ipColocationFactor float64) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	peerData.AppSpecificScore = appSpecificScore
+	peerData.IPColocationFactor = ipColocationFactor
+}
+
+// GossipPenalties gets the application specific score and the IP colocation factor of the given remote peer.
+// This will error if the peer does not exist.
+func (s *GossipScorer) GossipPenalties(pid peer.ID) (float64, float64, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.AppSpecificScore, peerData.IPColocationFactor, nil
+	}
+	return 0, 0, peerdata.ErrPeerUnknown
+}
@@ -50,6 +50,31 @@ func TestScorers_Gossip_Score(t *testing.T) {
 				assert.Equal(t, uint64(100), topicMap["a"].TimeInMesh, "incorrect time in mesh")
 			},
 		},
+		{
+			name: "threshold override",
+			update: func(scorer *scorers.GossipScorer) {
+				scorer.SetGossipData("peer1", -50.0, 0, nil)
+				scorer.SetThreshold(-20.0)
+			},
+			check: func(scorer *scorers.GossipScorer) {
+				assert.Equal(t, -20.0, scorer.Threshold(), "Unexpected threshold")
+				assert.Equal(t, true, scorer.IsBadPeer("peer1"), "Unexpected good peer")
+			},
+		},
+		{
+			name: "gossip penalties",
+			update: func(scorer *scorers.GossipScorer) {
+				scorer.SetGossipPenalties("peer1", -5.0, 2.5)
+			},
+			check: func(scorer *scorers.GossipScorer) {
+				appSpecificScore, ipColocationFactor, err := scorer.GossipPenalties("peer1")
+				assert.NoError(t, err)
+				assert.Equal(t, -5.0, appSpecificScore, "Unexpected app specific score")
+				assert.Equal(t, 2.5, ipColocationFactor, "Unexpected IP colocation factor")
+				_, _, err = scorer.GossipPenalties("peer2")
+				assert.ErrorContains(t, "peer unknown", err)
+			},
+		},
 	}
 
 	for _, tt := range tests {
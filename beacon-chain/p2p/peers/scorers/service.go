@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/peerdata"
 )
 
@@ -40,6 +41,53 @@ type Service struct {
 	totalWeight float64
 }
 
+// Weights holds the relative weights of the scorers in the overall peer score. The contribution
+// of a scorer is its weight divided by the sum of all the weights.
+type Weights struct {
+	BadResponses  float64 `yaml:"bad_responses" json:"bad_responses"`
+	BlockProvider float64 `yaml:"block_provider" json:"block_provider"`
+	PeerStatus    float64 `yaml:"peer_status" json:"peer_status"`
+	Gossip        float64 `yaml:"gossip" json:"gossip"`
+}
+
+// DefaultWeights returns the weights the scorers are registered with.
+func DefaultWeights() *Weights {
+	return &Weights{
+		BadResponses:  0.3,
+		BlockProvider: 0.0,
+		PeerStatus:    0.3,
+		Gossip:        0.4,
+	}
+}
+
+// Validate checks that no weight is negative and that at least one is positive.
+func (w *Weights) Validate() error {
+	if w == nil {
+		return errors.New("nil scorer weights")
+	}
+	for _, weight := range []float64{w.BadResponses, w.BlockProvider, w.PeerStatus, w.Gossip} {
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return errors.Errorf("invalid scorer weight %f", weight)
+		}
+	}
+	if w.BadResponses+w.BlockProvider+w.PeerStatus+w.Gossip == 0 {
+		return errors.New("at least one scorer weight must be positive")
+	}
+	return nil
+}
+
+// Contribution is the part a scorer takes in the overall score of a peer.
+type Contribution struct {
+	// Scorer is the name of the scorer.
+	Scorer string
+	// Score is the score given by the scorer.
+	Score float64
+	// Weight is the share of the scorer in the overall score.
+	Weight float64
+	// IsBad is set if the scorer deems the peer bad.
+	IsBad bool
+}
+
 // Config holds configuration parameters for scoring service.
 type Config struct {
 	BadResponsesScorerConfig  *BadResponsesScorerConfig
@@ -51,19 +99,15 @@ type Config struct {
 // NewService provides fully initialized peer scoring service.
 func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Service {
 	s := &Service{
-		store:   store,
-		weights: make(map[Scorer]float64),
+		store: store,
 	}
 
 	// Register scorers.
 	s.scorers.badResponsesScorer = newBadResponsesScorer(store, config.BadResponsesScorerConfig)
-	s.setScorerWeight(s.scorers.badResponsesScorer, 0.3)
 	s.scorers.blockProviderScorer = newBlockProviderScorer(store, config.BlockProviderScorerConfig)
-	s.setScorerWeight(s.scorers.blockProviderScorer, 0.0)
 	s.scorers.peerStatusScorer = newPeerStatusScorer(store, config.PeerStatusScorerConfig)
-	s.setScorerWeight(s.scorers.peerStatusScorer, 0.3)
 	s.scorers.gossipScorer = newGossipScorer(store, config.GossipScorerConfig)
-	s.setScorerWeight(s.scorers.gossipScorer, 0.4)
+	s.setWeights(DefaultWeights())
 
 	// Start background tasks.
 	go s.loop(ctx)
@@ -93,6 +137,8 @@ func (s *Service) GossipScorer() *GossipScorer {
 
 // ActiveScorersCount returns number of scorers that can affect score (have non-zero weight).
 func (s *Service) ActiveScorersCount() int {
+	s.store.RLock()
+	defer s.store.RUnlock()
 	cnt := 0
 	for _, w := range s.weights {
 		if w > 0 {
@@ -122,6 +168,61 @@ func (s *Service) ScoreNoLock(pid peer.ID) float64 {
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
+// Contributions returns the score given to a peer by each scorer, along with the share of the scorer
+// in the overall score.
+func (s *Service) Contributions(pid peer.ID) []*Contribution {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return []*Contribution{
+		{
+			Scorer: "bad_responses",
+			Score:  s.scorers.badResponsesScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.badResponsesScorer),
+			IsBad:  s.scorers.badResponsesScorer.isBadPeer(pid),
+		},
+		{
+			Scorer: "block_provider",
+			Score:  s.scorers.blockProviderScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.blockProviderScorer),
+		},
+		{
+			Scorer: "peer_status",
+			Score:  s.scorers.peerStatusScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.peerStatusScorer),
+			IsBad:  s.scorers.peerStatusScorer.isBadPeer(pid),
+		},
+		{
+			Scorer: "gossip",
+			Score:  s.scorers.gossipScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.gossipScorer),
+			IsBad:  s.scorers.gossipScorer.isBadPeer(pid),
+		},
+	}
+}
+
+// Weights returns the weights of the scorers in the overall score.
+func (s *Service) Weights() *Weights {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return &Weights{
+		BadResponses:  s.weights[s.scorers.badResponsesScorer],
+		BlockProvider: s.weights[s.scorers.blockProviderScorer],
+		PeerStatus:    s.weights[s.scorers.peerStatusScorer],
+		Gossip:        s.weights[s.scorers.gossipScorer],
+	}
+}
+
+// SetWeights replaces the weights of the scorers in the overall score.
+func (s *Service) SetWeights(weights *Weights) error {
+	if err := weights.Validate(); err != nil {
+		return err
+	}
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.setWeights(weights)
+	return nil
+}
+
 // IsBadPeer traverses all the scorers to see if any of them classifies peer as bad.
 func (s *Service) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
@@ -197,6 +298,16 @@ func (s *Service) loop(ctx context.Context) {
 	}
 }
 
+// setWeights registers the weights of all the scorers.
+func (s *Service) setWeights(weights *Weights) {
+	s.weights = make(map[Scorer]float64)
+	s.totalWeight = 0
+	s.setScorerWeight(s.scorers.badResponsesScorer, weights.BadResponses)
+	s.setScorerWeight(s.scorers.blockProviderScorer, weights.BlockProvider)
+	s.setScorerWeight(s.scorers.peerStatusScorer, weights.PeerStatus)
+	s.setScorerWeight(s.scorers.gossipScorer, weights.Gossip)
+}
+
 // setScorerWeight adds scorer to map of known scorers.
 func (s *Service) setScorerWeight(scorer Scorer, weight float64) {
 	s.weights[scorer] = weight
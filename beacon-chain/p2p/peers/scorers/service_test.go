@@ -308,3 +308,46 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_SetWeights(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     5,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+	assert.DeepEqual(t, scorers.DefaultWeights(), s.Weights())
+	assert.Equal(t, 3, s.ActiveScorersCount())
+
+	peerStatuses.Add(nil, "peer1", nil, network.DirUnknown)
+	s.BadResponsesScorer().Increment("peer1")
+	assert.Equal(t, roundScore(-2*0.3), s.Score("peer1"))
+
+	assert.ErrorContains(t, "invalid scorer weight", s.SetWeights(&scorers.Weights{BadResponses: -1, Gossip: 1}))
+	assert.ErrorContains(t, "at least one scorer weight must be positive", s.SetWeights(&scorers.Weights{}))
+	assert.DeepEqual(t, scorers.DefaultWeights(), s.Weights())
+
+	weights := &scorers.Weights{BadResponses: 1, Gossip: 3}
+	assert.NoError(t, s.SetWeights(weights))
+	assert.DeepEqual(t, weights, s.Weights())
+	assert.Equal(t, 2, s.ActiveScorersCount())
+	assert.Equal(t, roundScore(-2*0.25), s.Score("peer1"))
+
+	contributions := s.Contributions("peer1")
+	assert.Equal(t, 4, len(contributions))
+	assert.Equal(t, "bad_responses", contributions[0].Scorer)
+	assert.Equal(t, -2.0, contributions[0].Score)
+	assert.Equal(t, 0.25, contributions[0].Weight)
+	assert.Equal(t, false, contributions[0].IsBad)
+	assert.Equal(t, "gossip", contributions[3].Scorer)
+	assert.Equal(t, 0.75, contributions[3].Weight)
+
+	s.BadResponsesScorer().SetThreshold(1)
+	assert.Equal(t, 1, s.BadResponsesScorer().Threshold())
+	assert.Equal(t, true, s.Contributions("peer1")[0].IsBad)
+	assert.Equal(t, true, s.IsBadPeer("peer1"))
+}
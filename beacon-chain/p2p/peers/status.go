@@ -82,6 +82,9 @@ type Status struct {
 	store     *peerdata.Store
 	ipTracker map[string]uint64
 	rand      *rand.Rand
+	// trusted holds the peers that are never deemed bad nor pruned, and that do not count
+	// towards the peer limits. It is guarded by the store lock.
+	trusted map[peer.ID]bool
 }
 
 // StatusConfig represents peer status service params.
@@ -104,7 +107,8 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		ipTracker: map[string]uint64{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
-		rand: rand.NewDeterministicGenerator(),
+		rand:    rand.NewDeterministicGenerator(),
+		trusted: map[peer.ID]bool{},
 	}
 }
 
@@ -113,6 +117,49 @@ func (p *Status) Scorers() *scorers.Service {
 	return p.scorers
 }
 
+// SetTrustedPeers replaces the set of trusted peers. Trusted peers are never deemed bad, their data is never
+// pruned and they have reserved connection slots, outside of the peer limits.
+func (p *Status) SetTrustedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	p.trusted = make(map[peer.ID]bool, len(pids))
+	for _, pid := range pids {
+		p.trusted[pid] = true
+	}
+}
+
+// IsTrusted checks if the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.trusted[pid]
+}
+
+// Trusted returns the trusted peers.
+func (p *Status) Trusted() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0, len(p.trusted))
+	for pid := range p.trusted {
+		peers = append(peers, pid)
+	}
+	return peers
+}
+
+// Untrusted returns the given peers without the trusted ones, to count the peers that take up connection slots.
+func (p *Status) Untrusted(pids []peer.ID) []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if !p.trusted[pid] {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
 // MaxPeerLimit returns the max peer limit stored in the current peer store.
 func (p *Status) MaxPeerLimit() int {
 	return p.store.Config().MaxPeers
@@ -212,9 +259,9 @@ func (p *Status) IsAboveInboundLimit() bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
 	totalInbound := 0
-	for _, peerData := range p.store.Peers() {
+	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trusted[pid] {
 			totalInbound += 1
 		}
 	}
@@ -325,7 +372,7 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 }
 
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
-// If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// If the peer is unknown or trusted this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
@@ -334,6 +381,9 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	if p.trusted[pid] {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
@@ -555,9 +605,9 @@ func (p *Status) Prune() {
 		score float64
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select disconnected peers with a smaller bad response count.
+	// Select disconnected peers with a smaller bad response count. The data of trusted peers is kept.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.Scorers().ScoreNoLock(pid),
@@ -696,8 +746,9 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch primitives.Epoch) (
 func (p *Status) PeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := uint64(p.InboundLimit())
-	activePeers := p.Active()
-	numInboundPeers := uint64(len(p.InboundConnected()))
+	// Trusted peers have reserved slots, so they are neither counted nor pruned.
+	activePeers := p.Untrusted(p.Active())
+	numInboundPeers := uint64(len(p.Untrusted(p.InboundConnected())))
 	// Exit early if we are still below our max
 	// limit.
 	if uint64(len(activePeers)) <= connLimit {
@@ -714,7 +765,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.scorers.ScoreNoLock(pid),
@@ -744,6 +744,43 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestTrustedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	var trusted []peer.ID
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		if i < 5 {
+			trusted = append(trusted, pid)
+		}
+	}
+	require.Equal(t, 3, len(p.PeersToPrune()))
+
+	p.SetTrustedPeers(trusted)
+	assert.Equal(t, 5, len(p.Trusted()))
+	assert.Equal(t, 28, len(p.Untrusted(p.Active())))
+	// Trusted peers have reserved slots, so the others are within the limits.
+	assert.Equal(t, 0, len(p.PeersToPrune()))
+
+	// Trusted peers are never bad.
+	p.Scorers().BadResponsesScorer().Increment(trusted[0])
+	assert.Equal(t, true, p.IsTrusted(trusted[0]))
+	assert.Equal(t, false, p.IsBad(trusted[0]))
+
+	p.SetTrustedPeers(nil)
+	assert.Equal(t, false, p.IsTrusted(trusted[0]))
+	assert.Equal(t, true, p.IsBad(trusted[0]))
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       primitives.Slot
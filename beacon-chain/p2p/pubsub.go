@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"encoding/hex"
+	"math"
 	"strings"
 	"time"
 
@@ -72,6 +73,7 @@ func (s *Service) LeaveTopic(topic string) error {
 			return err
 		}
 		delete(s.joinedTopics, topic)
+		s.setTopicScoringParams(topic, nil)
 	}
 	return nil
 }
@@ -114,6 +116,7 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 		if err := topicHandle.SetScoreParams(scoringParams); err != nil {
 			return nil, err
 		}
+		s.setTopicScoringParams(topic, scoringParams)
 		logGossipParameters(topic, scoringParams)
 	}
 	return topicHandle.Subscribe(opts...)
@@ -126,12 +129,14 @@ func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 	// relevant topics.
 	for pid, snap := range peerMap {
 		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
-			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
+			snap.BehaviourPenalty, s.convertTopicScores(snap.Topics))
+		s.peers.Scorers().GossipScorer().SetGossipPenalties(pid, snap.AppSpecificScore, snap.IPColocationFactor)
 	}
 }
 
 // Creates a list of pubsub options to configure out router with.
 func (s *Service) pubsubOptions() []pubsub.Option {
+	scoreParams, thresholds := peerScoringParams()
 	psOpts := []pubsub.Option{
 		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
 		pubsub.WithNoAuthor(),
@@ -142,7 +147,7 @@ func (s *Service) pubsubOptions() []pubsub.Option {
 		pubsub.WithPeerOutboundQueueSize(pubsubQueueSize),
 		pubsub.WithMaxMessageSize(int(params.BeaconNetworkConfig().GossipMaxSizeBellatrix)),
 		pubsub.WithValidateQueueSize(pubsubQueueSize),
-		pubsub.WithPeerScore(peerScoringParams()),
+		pubsub.WithPeerScore(scoreParams, s.scoringConfig.scoreThresholds(thresholds)),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
 		pubsub.WithRawTracer(gossipTracer{host: s.host}),
@@ -168,20 +173,47 @@ func setPubSubParameters() {
 	pubsub.TimeCacheDuration = 550 * gossipSubHeartbeatInterval
 }
 
-// convert from libp2p's internal schema to a compatible prysm protobuf format.
-func convertTopicScores(topicMap map[string]*pubsub.TopicScoreSnapshot) map[string]*pbrpc.TopicScoreSnapshot {
+// convert from libp2p's internal schema to a compatible prysm protobuf format, along with the
+// score components of the topics we have score parameters for.
+func (s *Service) convertTopicScores(topicMap map[string]*pubsub.TopicScoreSnapshot) map[string]*pbrpc.TopicScoreSnapshot {
 	newMap := make(map[string]*pbrpc.TopicScoreSnapshot, len(topicMap))
-	for t, s := range topicMap {
+	for t, snap := range topicMap {
 		newMap[t] = &pbrpc.TopicScoreSnapshot{
-			TimeInMesh:               uint64(s.TimeInMesh.Milliseconds()),
-			FirstMessageDeliveries:   float32(s.FirstMessageDeliveries),
-			MeshMessageDeliveries:    float32(s.MeshMessageDeliveries),
-			InvalidMessageDeliveries: float32(s.InvalidMessageDeliveries),
+			TimeInMesh:               uint64(snap.TimeInMesh.Milliseconds()),
+			FirstMessageDeliveries:   float32(snap.FirstMessageDeliveries),
+			MeshMessageDeliveries:    float32(snap.MeshMessageDeliveries),
+			InvalidMessageDeliveries: float32(snap.InvalidMessageDeliveries),
+		}
+		if scoreParams, ok := s.topicScoringParamsOf(t); ok {
+			setTopicScoreComponents(newMap[t], snap, scoreParams)
 		}
 	}
 	return newMap
 }
 
+// setTopicScoreComponents computes the weighted score components of a topic the way gossipsub does.
+// The mesh message deliveries are assumed to be scored once the peer has been in the mesh for the
+// activation period, and the mesh failure penalty is left out as it is not part of the snapshot.
+func setTopicScoreComponents(ts *pbrpc.TopicScoreSnapshot, snap *pubsub.TopicScoreSnapshot, p *pubsub.TopicScoreParams) {
+	var timeInMesh, firstDeliveries, meshDeliveries, invalidDeliveries float64
+	if snap.TimeInMesh > 0 && p.TimeInMeshQuantum > 0 {
+		timeInMesh = math.Min(float64(snap.TimeInMesh/p.TimeInMeshQuantum), p.TimeInMeshCap) * p.TimeInMeshWeight
+	}
+	firstDeliveries = snap.FirstMessageDeliveries * p.FirstMessageDeliveriesWeight
+	if snap.TimeInMesh >= p.MeshMessageDeliveriesActivation && snap.MeshMessageDeliveries < p.MeshMessageDeliveriesThreshold {
+		deficit := p.MeshMessageDeliveriesThreshold - snap.MeshMessageDeliveries
+		meshDeliveries = deficit * deficit * p.MeshMessageDeliveriesWeight
+	}
+	invalidDeliveries = snap.InvalidMessageDeliveries * snap.InvalidMessageDeliveries * p.InvalidMessageDeliveriesWeight
+
+	ts.TopicWeight = float32(p.TopicWeight)
+	ts.TimeInMeshScore = float32(timeInMesh)
+	ts.FirstMessageDeliveriesScore = float32(firstDeliveries)
+	ts.MeshMessageDeliveriesScore = float32(meshDeliveries)
+	ts.InvalidMessageDeliveriesScore = float32(invalidDeliveries)
+	ts.Score = float32((timeInMesh + firstDeliveries + meshDeliveries + invalidDeliveries) * p.TopicWeight)
+}
+
 // ExtractGossipDigest extracts the relevant fork digest from the gossip topic.
 // Topics are in the form of /eth2/{fork-digest}/{topic} and this method extracts the
 // fork digest from the topic string to a 4 byte array.
@@ -0,0 +1,286 @@
+package p2p
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// The gossip messages whose topic weight can be overridden in the scoring config.
+var scoredGossipMessages = []string{
+	GossipBlockMessage,
+	GossipAggregateAndProofMessage,
+	GossipAttestationMessage,
+	GossipSyncCommitteeMessage,
+	GossipContributionAndProofMessage,
+	GossipExitMessage,
+	GossipProposerSlashingMessage,
+	GossipAttesterSlashingMessage,
+	GossipBlsToExecutionChangeMessage,
+}
+
+// ScoringConfig holds the operator overrides of the peer scoring parameters. Unset values keep
+// their defaults. Everything but the gossip thresholds is applied again when the file it was
+// loaded from changes.
+type ScoringConfig struct {
+	// ScorerWeights are the relative weights of the scorers in the overall peer score.
+	ScorerWeights *scorers.Weights `yaml:"scorer_weights"`
+	// BadResponsesThreshold is the number of bad responses tolerated before a peer is deemed bad.
+	BadResponsesThreshold int `yaml:"bad_responses_threshold"`
+	// GossipScoreThreshold is the gossip score below which a peer is deemed bad.
+	GossipScoreThreshold float64 `yaml:"gossip_score_threshold"`
+	// TopicWeights are the weights of the topics in the gossip score, keyed by gossip message
+	// name such as beacon_block. The weight applies to every subnet of a subnet message.
+	TopicWeights map[string]float64 `yaml:"topic_weights"`
+	// GossipThresholds are the gossipsub score thresholds, only applied when the node starts.
+	GossipThresholds *GossipThresholds `yaml:"gossip_thresholds"`
+}
+
+// GossipThresholds are the gossipsub score thresholds below which a peer is ignored
+// in the different stages of the protocol. They replace the default thresholds as a whole.
+type GossipThresholds struct {
+	Gossip             float64 `yaml:"gossip"`
+	Publish            float64 `yaml:"publish"`
+	Graylist           float64 `yaml:"graylist"`
+	AcceptPX           float64 `yaml:"accept_px"`
+	OpportunisticGraft float64 `yaml:"opportunistic_graft"`
+}
+
+// LoadScoringConfig reads and validates the scoring config in the given YAML file.
+func LoadScoringConfig(path string) (*ScoringConfig, error) {
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read scoring config file")
+	}
+	cfg := &ScoringConfig{}
+	if err := yaml.UnmarshalStrict(enc, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse scoring config file")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid scoring config")
+	}
+	return cfg, nil
+}
+
+func (c *ScoringConfig) validate() error {
+	if c.ScorerWeights != nil {
+		if err := c.ScorerWeights.Validate(); err != nil {
+			return err
+		}
+	}
+	if c.BadResponsesThreshold < 0 {
+		return errors.New("bad responses threshold must not be negative")
+	}
+	if c.GossipScoreThreshold > 0 {
+		return errors.New("gossip score threshold must not be positive")
+	}
+	for message, weight := range c.TopicWeights {
+		if !isScoredGossipMessage(message) {
+			return errors.Errorf("unknown gossip message %s in topic weights", message)
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return errors.Errorf("invalid weight %f for gossip message %s", weight, message)
+		}
+	}
+	if t := c.GossipThresholds; t != nil {
+		if t.Gossip > 0 {
+			return errors.New("gossip threshold must not be positive")
+		}
+		if t.Publish > t.Gossip {
+			return errors.New("publish threshold must not be above the gossip threshold")
+		}
+		if t.Graylist > t.Publish {
+			return errors.New("graylist threshold must not be above the publish threshold")
+		}
+		if t.AcceptPX < 0 || t.OpportunisticGraft < 0 {
+			return errors.New("accept px and opportunistic graft thresholds must not be negative")
+		}
+	}
+	return nil
+}
+
+func isScoredGossipMessage(message string) bool {
+	for _, m := range scoredGossipMessages {
+		if m == message {
+			return true
+		}
+	}
+	return false
+}
+
+// topicWeight returns the overridden weight of the given gossip message, if any.
+func (c *ScoringConfig) topicWeight(message string) (float64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	weight, ok := c.TopicWeights[message]
+	return weight, ok
+}
+
+// applyScorerOverrides sets the scorer weights and thresholds of the config, or the defaults
+// for the ones the config does not set.
+func (s *Service) applyScorerOverrides(cfg *ScoringConfig) error {
+	weights := scorers.DefaultWeights()
+	badResponsesThreshold := maxBadResponses
+	gossipScoreThreshold := scorers.BadPeerScore
+	if cfg != nil {
+		if cfg.ScorerWeights != nil {
+			weights = cfg.ScorerWeights
+		}
+		if cfg.BadResponsesThreshold != 0 {
+			badResponsesThreshold = cfg.BadResponsesThreshold
+		}
+		if cfg.GossipScoreThreshold != 0 {
+			gossipScoreThreshold = cfg.GossipScoreThreshold
+		}
+	}
+	if err := s.peers.Scorers().SetWeights(weights); err != nil {
+		return err
+	}
+	s.peers.Scorers().BadResponsesScorer().SetThreshold(badResponsesThreshold)
+	s.peers.Scorers().GossipScorer().SetThreshold(gossipScoreThreshold)
+	return nil
+}
+
+// reloadScoringConfig reads the scoring config file again and applies it to the scorers and to
+// the topics we are subscribed to. The previous config is kept if the file is invalid.
+func (s *Service) reloadScoringConfig() error {
+	cfg, err := LoadScoringConfig(s.cfg.ScoringConfigFile)
+	if err != nil {
+		return err
+	}
+	s.scoringLock.Lock()
+	previous := s.scoringConfig
+	s.scoringConfig = cfg
+	s.scoringLock.Unlock()
+
+	if err := s.applyScorerOverrides(cfg); err != nil {
+		return err
+	}
+	if previous != nil && !gossipThresholdsEqual(previous.GossipThresholds, cfg.GossipThresholds) {
+		log.Warn("Gossip score thresholds changed in the scoring config, they will only be applied after a restart")
+	}
+
+	s.scoringLock.RLock()
+	topics := make([]string, 0, len(s.topicScoringParams))
+	for topic := range s.topicScoringParams {
+		topics = append(topics, topic)
+	}
+	s.scoringLock.RUnlock()
+	for _, topic := range topics {
+		if err := s.refreshTopicScoreParams(topic); err != nil {
+			log.WithError(err).WithField("topic", topic).Error("Could not update topic score parameters")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"file":   s.cfg.ScoringConfigFile,
+		"topics": len(topics),
+	}).Info("Applied peer scoring config")
+	return nil
+}
+
+// refreshTopicScoreParams recomputes the score parameters of a topic we are subscribed to and
+// sets them on the topic.
+func (s *Service) refreshTopicScoreParams(topic string) error {
+	s.joinedTopicsLock.Lock()
+	topicHandle, ok := s.joinedTopics[topic]
+	s.joinedTopicsLock.Unlock()
+	if !ok {
+		return nil
+	}
+	scoringParams, err := s.topicScoreParams(topic)
+	if err != nil {
+		return err
+	}
+	if err := topicHandle.SetScoreParams(scoringParams); err != nil {
+		return err
+	}
+	s.setTopicScoringParams(topic, scoringParams)
+	return nil
+}
+
+// watchScoringConfig applies the scoring config file again every time it changes.
+func (s *Service) watchScoringConfig() {
+	path := s.cfg.ScoringConfigFile
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	// Watch the directory rather than the file, as editors usually replace the file on save.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not add file %s to file watcher", path)
+		return
+	}
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != filepath.Clean(path) || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			if err := s.reloadScoringConfig(); err != nil {
+				log.WithError(err).Errorf("Could not reload scoring config from %s", path)
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", path)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) currentScoringConfig() *ScoringConfig {
+	s.scoringLock.RLock()
+	defer s.scoringLock.RUnlock()
+	return s.scoringConfig
+}
+
+func (s *Service) setTopicScoringParams(topic string, scoringParams *pubsub.TopicScoreParams) {
+	s.scoringLock.Lock()
+	defer s.scoringLock.Unlock()
+	if scoringParams == nil {
+		delete(s.topicScoringParams, topic)
+		return
+	}
+	s.topicScoringParams[topic] = scoringParams
+}
+
+func (s *Service) topicScoringParamsOf(topic string) (*pubsub.TopicScoreParams, bool) {
+	s.scoringLock.RLock()
+	defer s.scoringLock.RUnlock()
+	p, ok := s.topicScoringParams[topic]
+	return p, ok
+}
+
+// scoreThresholds returns the gossipsub score thresholds, with the overrides of the scoring config.
+func (c *ScoringConfig) scoreThresholds(thresholds *pubsub.PeerScoreThresholds) *pubsub.PeerScoreThresholds {
+	if c == nil || c.GossipThresholds == nil {
+		return thresholds
+	}
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             c.GossipThresholds.Gossip,
+		PublishThreshold:            c.GossipThresholds.Publish,
+		GraylistThreshold:           c.GossipThresholds.Graylist,
+		AcceptPXThreshold:           c.GossipThresholds.AcceptPX,
+		OpportunisticGraftThreshold: c.GossipThresholds.OpportunisticGraft,
+	}
+}
+
+func gossipThresholdsEqual(a, b *GossipThresholds) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,153 @@
+package p2p
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	pbrpc "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func writeScoringConfig(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestLoadScoringConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoring.yaml")
+	writeScoringConfig(t, path, `
+scorer_weights:
+  bad_responses: 0.2
+  peer_status: 0.2
+  gossip: 0.6
+bad_responses_threshold: 10
+gossip_score_threshold: -50
+topic_weights:
+  beacon_block: 0.5
+gossip_thresholds:
+  gossip: -1000
+  publish: -2000
+  graylist: -4000
+  accept_px: 100
+  opportunistic_graft: 5
+`)
+	cfg, err := LoadScoringConfig(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &scorers.Weights{BadResponses: 0.2, PeerStatus: 0.2, Gossip: 0.6}, cfg.ScorerWeights)
+	assert.Equal(t, 10, cfg.BadResponsesThreshold)
+	assert.Equal(t, -50.0, cfg.GossipScoreThreshold)
+	weight, ok := cfg.topicWeight(GossipBlockMessage)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 0.5, weight)
+	_, ok = cfg.topicWeight(GossipExitMessage)
+	assert.Equal(t, false, ok)
+
+	_, defaultThresholds := peerScoringParams()
+	thresholds := cfg.scoreThresholds(defaultThresholds)
+	assert.Equal(t, -1000.0, thresholds.GossipThreshold)
+	assert.Equal(t, -4000.0, thresholds.GraylistThreshold)
+	var noConfig *ScoringConfig
+	assert.Equal(t, defaultThresholds, noConfig.scoreThresholds(defaultThresholds))
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown field", content: "scorer_weight: {}", wantErr: "could not parse scoring config file"},
+		{name: "negative weight", content: "scorer_weights: {gossip: -1}", wantErr: "invalid scorer weight"},
+		{name: "positive gossip score threshold", content: "gossip_score_threshold: 1", wantErr: "gossip score threshold must not be positive"},
+		{name: "unknown topic", content: "topic_weights: {beacon_blob: 1}", wantErr: "unknown gossip message beacon_blob"},
+		{name: "unordered thresholds", content: "gossip_thresholds: {gossip: -10, publish: -5}", wantErr: "publish threshold must not be above"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeScoringConfig(t, path, tt.content)
+			_, err := LoadScoringConfig(path)
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+	_, err = LoadScoringConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, "could not read scoring config file", err)
+}
+
+func TestService_ReloadScoringConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "scoring.yaml")
+	writeScoringConfig(t, path, "topic_weights: {beacon_block: 0.1}")
+	s := &Service{
+		ctx:                  ctx,
+		cfg:                  &Config{ScoringConfigFile: path},
+		activeValidatorCount: 10000,
+		joinedTopics:         make(map[string]*pubsub.Topic),
+		topicScoringParams:   make(map[string]*pubsub.TopicScoreParams),
+		peers: peers.NewStatus(ctx, &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.scoringConfig, err = LoadScoringConfig(path)
+	require.NoError(t, err)
+	require.NoError(t, s.applyScorerOverrides(s.scoringConfig))
+	assert.DeepEqual(t, scorers.DefaultWeights(), s.peers.Scorers().Weights())
+	assert.Equal(t, maxBadResponses, s.peers.Scorers().BadResponsesScorer().Params().Threshold)
+
+	blockTopic := "/eth2/00000000/" + GossipBlockMessage + "/ssz_snappy"
+	blockParams, err := s.topicScoreParams(blockTopic)
+	require.NoError(t, err)
+	assert.Equal(t, 0.1, blockParams.TopicWeight)
+	exitParams, err := s.topicScoreParams("/eth2/00000000/" + GossipExitMessage + "/ssz_snappy")
+	require.NoError(t, err)
+	assert.Equal(t, defaultVoluntaryExitTopicParams().TopicWeight, exitParams.TopicWeight)
+
+	writeScoringConfig(t, path, `
+scorer_weights: {bad_responses: 1, gossip: 1}
+bad_responses_threshold: 3
+gossip_score_threshold: -20
+`)
+	require.NoError(t, s.reloadScoringConfig())
+	assert.DeepEqual(t, &scorers.Weights{BadResponses: 1, Gossip: 1}, s.peers.Scorers().Weights())
+	assert.Equal(t, 3, s.peers.Scorers().BadResponsesScorer().Params().Threshold)
+	assert.Equal(t, -20.0, s.peers.Scorers().GossipScorer().Params().Threshold)
+	blockParams, err = s.topicScoreParams(blockTopic)
+	require.NoError(t, err)
+	assert.Equal(t, defaultBlockTopicParams().TopicWeight, blockParams.TopicWeight)
+
+	// An invalid file keeps the config in use.
+	writeScoringConfig(t, path, "scorer_weights: {gossip: -1}")
+	require.ErrorContains(t, "invalid scorer weight", s.reloadScoringConfig())
+	assert.DeepEqual(t, &scorers.Weights{BadResponses: 1, Gossip: 1}, s.peers.Scorers().Weights())
+}
+
+func TestSetTopicScoreComponents(t *testing.T) {
+	p := defaultBlockTopicParams()
+	snap := &pubsub.TopicScoreSnapshot{
+		TimeInMesh:               10 * p.TimeInMeshQuantum,
+		FirstMessageDeliveries:   4,
+		MeshMessageDeliveries:    0,
+		InvalidMessageDeliveries: 2,
+	}
+	ts := &pbrpc.TopicScoreSnapshot{}
+	setTopicScoreComponents(ts, snap, p)
+	assert.Equal(t, float32(p.TopicWeight), ts.TopicWeight)
+	assert.Equal(t, float32(10*p.TimeInMeshWeight), ts.TimeInMeshScore)
+	assert.Equal(t, float32(4*p.FirstMessageDeliveriesWeight), ts.FirstMessageDeliveriesScore)
+	assert.Equal(t, float32(0), ts.MeshMessageDeliveriesScore, "Block topic does not score mesh deliveries")
+	assert.Equal(t, float32(4*p.InvalidMessageDeliveriesWeight), ts.InvalidMessageDeliveriesScore)
+	want := (10*p.TimeInMeshWeight + 4*p.FirstMessageDeliveriesWeight + 4*p.InvalidMessageDeliveriesWeight) * p.TopicWeight
+	assert.Equal(t, float32(want), ts.Score)
+
+	p = defaultAggregateTopicParams(10000)
+	snap = &pubsub.TopicScoreSnapshot{TimeInMesh: p.MeshMessageDeliveriesActivation + time.Second}
+	ts = &pbrpc.TopicScoreSnapshot{}
+	setTopicScoreComponents(ts, snap, p)
+	deficit := p.MeshMessageDeliveriesThreshold
+	assert.Equal(t, float32(deficit*deficit*p.MeshMessageDeliveriesWeight), ts.MeshMessageDeliveriesScore)
+}
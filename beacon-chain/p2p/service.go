@@ -83,6 +83,10 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	scoringConfig         *ScoringConfig
+	topicScoringParams    map[string]*pubsub.TopicScoreParams
+	scoringLock           sync.RWMutex
+	trustedPeers          *trustedPeers
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -100,6 +104,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+
+		topicScoringParams: make(map[string]*pubsub.TopicScoreParams),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -123,6 +129,18 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, 30*time.Second, true /* deleteEmptyBuckets */)
+	s.trustedPeers, err = parseTrustedPeers(s.cfg.TrustedPeers)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse trusted peers")
+		return nil, err
+	}
+	if s.cfg.ScoringConfigFile != "" {
+		s.scoringConfig, err = LoadScoringConfig(s.cfg.ScoringConfigFile)
+		if err != nil {
+			log.WithError(err).Error("Failed to load peer scoring config")
+			return nil, err
+		}
+	}
 
 	opts := s.buildOptions(ipAddr, s.privKey)
 	h, err := libp2p.New(opts...)
@@ -159,6 +177,14 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	if err := s.applyScorerOverrides(s.scoringConfig); err != nil {
+		log.WithError(err).Error("Failed to apply peer scoring config")
+		return nil, err
+	}
+	if trusted := s.trustedPeers.ids(); len(trusted) > 0 {
+		s.peers.SetTrustedPeers(trusted)
+		log.WithField("peers", trusted).Info("Configured trusted peers")
+	}
 
 	// Initialize Data maps.
 	types.InitializeDataMaps()
@@ -216,6 +242,7 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	s.maintainTrustedPeers()
 	// Initialize metadata according to the
 	// current epoch.
 	s.RefreshENR()
@@ -255,6 +282,9 @@ func (s *Service) Start() {
 		logExternalDNSAddr(s.host.ID(), p2pHostDNS, p2pTCPPort)
 	}
 	go s.forkWatcher()
+	if s.cfg.ScoringConfigFile != "" {
+		go s.watchScoringConfig()
+	}
 }
 
 // Stop the p2p service and terminate all peer connections.
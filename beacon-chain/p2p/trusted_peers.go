@@ -0,0 +1,188 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// trustedPeerDialInterval is how often we check that we are connected to the trusted peers,
+	// and the delay before the first redial of a trusted peer we could not connect to.
+	trustedPeerDialInterval = 5 * time.Second
+
+	// trustedPeerMaxBackoff is the maximum delay between two dials of a trusted peer.
+	trustedPeerMaxBackoff = 5 * time.Minute
+)
+
+// trustedPeers holds the peers we always stay connected to. They are never deemed bad nor pruned,
+// and have reserved connection slots outside of the peer limits.
+type trustedPeers struct {
+	infos   []peer.AddrInfo
+	lock    sync.Mutex
+	backoff map[peer.ID]*dialBackoff
+}
+
+// dialBackoff tracks the failed dials of a trusted peer.
+type dialBackoff struct {
+	failures int
+	next     time.Time
+	dialing  bool
+}
+
+// parseTrustedPeers parses the trusted peers, given by peer ID, ENR or multiaddr with a /p2p
+// component. The addresses of the entries of the same peer are merged.
+func parseTrustedPeers(entries []string) (*trustedPeers, error) {
+	t := &trustedPeers{
+		backoff: make(map[peer.ID]*dialBackoff),
+	}
+	index := make(map[peer.ID]int)
+	for _, entry := range entries {
+		if entry == "" {
+			continue
+		}
+		var infos []peer.AddrInfo
+		if pid, err := peer.Decode(entry); err == nil {
+			infos = []peer.AddrInfo{{ID: pid}}
+		} else {
+			addrs, err := PeersFromStringAddrs([]string{entry})
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse trusted peer %s", entry)
+			}
+			if len(addrs) == 0 {
+				return nil, errors.Errorf("could not parse trusted peer %s", entry)
+			}
+			infos, err = peer.AddrInfosFromP2pAddrs(addrs...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get the peer ID of trusted peer %s", entry)
+			}
+		}
+		for _, info := range infos {
+			i, ok := index[info.ID]
+			if !ok {
+				index[info.ID] = len(t.infos)
+				t.infos = append(t.infos, info)
+				continue
+			}
+			t.infos[i].Addrs = append(t.infos[i].Addrs, info.Addrs...)
+		}
+	}
+	return t, nil
+}
+
+// empty checks if there are no trusted peers.
+func (t *trustedPeers) empty() bool {
+	return t == nil || len(t.infos) == 0
+}
+
+// ids returns the IDs of the trusted peers.
+func (t *trustedPeers) ids() []peer.ID {
+	if t == nil {
+		return nil
+	}
+	ids := make([]peer.ID, 0, len(t.infos))
+	for _, info := range t.infos {
+		ids = append(ids, info.ID)
+	}
+	return ids
+}
+
+// toDial returns the trusted peers that are not connected and whose backoff has elapsed, and marks
+// them as being dialled. The peers given without an address are dialled on the addresses known
+// for them, if any. The backoff of the connected peers is reset.
+func (t *trustedPeers) toDial(now time.Time, connected func(peer.ID) bool, known func(peer.ID) []ma.Multiaddr) []peer.AddrInfo {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var infos []peer.AddrInfo
+	for _, info := range t.infos {
+		if connected(info.ID) {
+			delete(t.backoff, info.ID)
+			continue
+		}
+		addrs := info.Addrs
+		if len(addrs) == 0 {
+			addrs = known(info.ID)
+		}
+		if len(addrs) == 0 {
+			continue
+		}
+		b, ok := t.backoff[info.ID]
+		if !ok {
+			b = &dialBackoff{}
+			t.backoff[info.ID] = b
+		}
+		if b.dialing || now.Before(b.next) {
+			continue
+		}
+		b.dialing = true
+		infos = append(infos, peer.AddrInfo{ID: info.ID, Addrs: addrs})
+	}
+	return infos
+}
+
+// dialed records the outcome of a dial of a trusted peer, backing off exponentially on failures.
+func (t *trustedPeers) dialed(pid peer.ID, now time.Time, err error) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	b, ok := t.backoff[pid]
+	if !ok {
+		return 0
+	}
+	b.dialing = false
+	if err == nil {
+		delete(t.backoff, pid)
+		return 0
+	}
+	b.failures++
+	delay := backoffDelay(b.failures)
+	b.next = now.Add(delay)
+	return delay
+}
+
+// backoffDelay returns the delay before the next dial of a peer after the given number of
+// consecutive failed dials.
+func backoffDelay(failures int) time.Duration {
+	delay := trustedPeerDialInterval
+	for i := 1; i < failures && delay < trustedPeerMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > trustedPeerMaxBackoff {
+		return trustedPeerMaxBackoff
+	}
+	return delay
+}
+
+// maintainTrustedPeers dials the trusted peers we are not connected to, right away and then
+// periodically, backing off from the ones we fail to connect to.
+func (s *Service) maintainTrustedPeers() {
+	if s.trustedPeers.empty() {
+		return
+	}
+	s.dialTrustedPeers()
+	async.RunEvery(s.ctx, trustedPeerDialInterval, s.dialTrustedPeers)
+}
+
+func (s *Service) dialTrustedPeers() {
+	connected := func(pid peer.ID) bool {
+		return s.host.Network().Connectedness(pid) == network.Connected
+	}
+	for _, info := range s.trustedPeers.toDial(prysmTime.Now(), connected, s.host.Peerstore().Addrs) {
+		// Make each dial non-blocking. Failed dials of trusted peers are not counted as bad responses.
+		go func(info peer.AddrInfo) {
+			err := connectWithTimeout(s.ctx, s.host, &info)
+			if delay := s.trustedPeers.dialed(info.ID, prysmTime.Now(), err); err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"peer":  info.ID,
+					"retry": delay,
+				}).Debug("Could not connect to trusted peer")
+			}
+		}(info)
+	}
+}
@@ -0,0 +1,151 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	mockp2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func newTrustedPeerID(t *testing.T) peer.ID {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	return pid
+}
+
+func TestParseTrustedPeers(t *testing.T) {
+	pid1 := newTrustedPeerID(t)
+	pid2 := newTrustedPeerID(t)
+	trusted, err := parseTrustedPeers([]string{
+		pid1.String(),
+		"",
+		fmt.Sprintf("/ip4/212.67.10.122/tcp/13000/p2p/%s", pid2),
+		fmt.Sprintf("/ip4/212.67.10.123/tcp/13000/p2p/%s", pid2),
+		fmt.Sprintf("/ip4/212.67.10.124/tcp/13000/p2p/%s", pid1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trusted.infos))
+	assert.DeepEqual(t, []peer.ID{pid1, pid2}, trusted.ids())
+	assert.Equal(t, 1, len(trusted.infos[0].Addrs))
+	assert.Equal(t, 2, len(trusted.infos[1].Addrs), "Addresses of the same peer are not merged")
+
+	_, err = parseTrustedPeers([]string{"/ip4/212.67.10.122/tcp/13000"})
+	assert.ErrorContains(t, "could not get the peer ID of trusted peer", err)
+	_, err = parseTrustedPeers([]string{"not a peer"})
+	assert.ErrorContains(t, "could not parse trusted peer", err)
+
+	var noTrustedPeers *trustedPeers
+	assert.Equal(t, true, noTrustedPeers.empty())
+}
+
+func TestTrustedPeers_Backoff(t *testing.T) {
+	assert.Equal(t, trustedPeerDialInterval, backoffDelay(1))
+	assert.Equal(t, 2*trustedPeerDialInterval, backoffDelay(2))
+	assert.Equal(t, 8*trustedPeerDialInterval, backoffDelay(4))
+	assert.Equal(t, trustedPeerMaxBackoff, backoffDelay(100))
+
+	pid1 := newTrustedPeerID(t)
+	pid2 := newTrustedPeerID(t)
+	trusted, err := parseTrustedPeers([]string{
+		pid1.String(),
+		fmt.Sprintf("/ip4/212.67.10.122/tcp/13000/p2p/%s", pid2),
+	})
+	require.NoError(t, err)
+	connected := map[peer.ID]bool{}
+	isConnected := func(pid peer.ID) bool { return connected[pid] }
+	known := map[peer.ID][]ma.Multiaddr{}
+	knownAddrs := func(pid peer.ID) []ma.Multiaddr { return known[pid] }
+
+	// Only the peer with an address is dialled, and only once at a time.
+	now := time.Now()
+	infos := trusted.toDial(now, isConnected, knownAddrs)
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, pid2, infos[0].ID)
+	assert.Equal(t, 0, len(trusted.toDial(now, isConnected, knownAddrs)))
+
+	// The peer given without an address is dialled once its address is known.
+	addr, err := ma.NewMultiaddr("/ip4/212.67.10.123/tcp/13000")
+	require.NoError(t, err)
+	known[pid1] = []ma.Multiaddr{addr}
+	infos = trusted.toDial(now, isConnected, knownAddrs)
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, pid1, infos[0].ID)
+	assert.DeepEqual(t, []ma.Multiaddr{addr}, infos[0].Addrs)
+
+	// Failed dials back off exponentially.
+	assert.Equal(t, trustedPeerDialInterval, trusted.dialed(pid2, now, errors.New("failed")))
+	assert.Equal(t, 0, len(trusted.toDial(now.Add(trustedPeerDialInterval-time.Second), isConnected, knownAddrs)))
+	now = now.Add(trustedPeerDialInterval)
+	require.Equal(t, 1, len(trusted.toDial(now, isConnected, knownAddrs)))
+	assert.Equal(t, 2*trustedPeerDialInterval, trusted.dialed(pid2, now, errors.New("failed")))
+
+	// A connection resets the backoff.
+	connected[pid2] = true
+	assert.Equal(t, 0, len(trusted.toDial(now, isConnected, knownAddrs)))
+	connected[pid2] = false
+	assert.Equal(t, 1, len(trusted.toDial(now, isConnected, knownAddrs)))
+	assert.Equal(t, time.Duration(0), trusted.dialed(pid2, now, nil))
+	assert.Equal(t, 1, len(trusted.toDial(now, isConnected, knownAddrs)))
+}
+
+func TestService_TrustedPeersReservedSlots(t *testing.T) {
+	limit := 20
+	trustedPID := newTrustedPeerID(t)
+	trustedIP := "212.67.10.122"
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    limit,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: uint(limit)},
+	}
+	var err error
+	s.trustedPeers, err = parseTrustedPeers([]string{fmt.Sprintf("/ip4/%s/tcp/13000/p2p/%s", trustedIP, trustedPID)})
+	require.NoError(t, err)
+	s.peers.SetTrustedPeers(s.trustedPeers.ids())
+	s.addrFilter, err = configureFilter(&Config{DenyListCIDR: []string{"212.67.0.0/16"}})
+	require.NoError(t, err)
+	s.started = true
+
+	trustedAddr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", trustedIP, 3000))
+	require.NoError(t, err)
+	otherAddr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", "213.67.10.122", 3000))
+	require.NoError(t, err)
+	assert.Equal(t, true, s.InterceptAddrDial(trustedPID, trustedAddr), "Trusted peer is filtered")
+	// Inbound peers are filtered once they are known.
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: trustedAddr}))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, trustedPID, &maEndpoints{raddr: trustedAddr}), "Trusted peer is filtered")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, newTrustedPeerID(t), &maEndpoints{raddr: trustedAddr}))
+
+	inboundLimit := int(float64(limit)*peers.InboundRatio) + highWatermarkBuffer
+	for i := 0; i < inboundLimit; i++ {
+		addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	// The limit is checked once the peer is known.
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: otherAddr}))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, newTrustedPeerID(t), &maEndpoints{raddr: otherAddr}))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, trustedPID, &maEndpoints{raddr: otherAddr}), "Trusted peer has no reserved slot")
+
+	// Trusted peers do not take up slots.
+	s.peers.SetTrustedPeers(s.peers.All())
+	assert.Equal(t, false, s.isPeerAtLimit(true /* inbound */))
+}
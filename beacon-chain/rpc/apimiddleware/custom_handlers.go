@@ -385,6 +385,8 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &EventHeadJson{}
 			case events.BlockTopic:
 				data = &ReceivedBlockDataJson{}
+			case events.BlockGossipTopic:
+				data = &EventBlockGossipJson{}
 			case events.AttestationTopic:
 				data = &AttestationJson{}
 
@@ -416,6 +418,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				msg.Data = attData
 			case events.VoluntaryExitTopic:
 				data = &SignedVoluntaryExitJson{}
+			case events.ProposerSlashingTopic:
+				data = &ProposerSlashingJson{}
+			case events.AttesterSlashingTopic:
+				data = &AttesterSlashingJson{}
 			case events.FinalizedCheckpointTopic:
 				data = &EventFinalizedCheckpointJson{}
 			case events.ChainReorgTopic:
@@ -374,6 +374,38 @@ data: {"aggregation_bits":"0x666f6f","data":{"slot":"1","index":"1","beacon_bloc
 	assert.DeepEqual(t, expectedEvent, w.Body.String())
 }
 
+func TestReceiveEvents_BlockGossip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		data := &EventBlockGossipJson{
+			Slot:  "1",
+			Block: "Zm9v",
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.BlockGossipTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+	assert.Equal(t, `event: block_gossip
+data: {"slot":"1","block":"0x666f6f"}
+
+`, w.Body.String())
+}
+
 func TestReceiveEvents_EventNotSupported(t *testing.T) {
 	ch := make(chan *sse.Event)
 	w := httptest.NewRecorder()
@@ -35,6 +35,9 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v2/beacon/blocks/{block_id}",
 		"/eth/v1/beacon/blocks/{block_id}/root",
 		"/eth/v1/beacon/blocks/{block_id}/attestations",
+		"/eth/v1/beacon/rewards/blocks/{block_id}",
+		"/eth/v1/beacon/rewards/attestations/{epoch}",
+		"/eth/v1/beacon/rewards/sync_committee/{block_id}",
 		"/eth/v1/beacon/blinded_blocks/{block_id}",
 		"/eth/v1/beacon/pool/attestations",
 		"/eth/v1/beacon/pool/attester_slashings",
@@ -143,6 +146,21 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.GetResponse = &BlockRootResponseJson{}
 	case "/eth/v1/beacon/blocks/{block_id}/attestations":
 		endpoint.GetResponse = &BlockAttestationsResponseJson{}
+	case "/eth/v1/beacon/rewards/blocks/{block_id}":
+		endpoint.GetResponse = &BlockRewardsResponseJson{}
+	case "/eth/v1/beacon/rewards/attestations/{epoch}":
+		endpoint.PostRequest = &ValidatorIndicesJson{}
+		endpoint.PostResponse = &AttestationRewardsResponseJson{}
+		endpoint.RequestURLLiterals = []string{"epoch"}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
+	case "/eth/v1/beacon/rewards/sync_committee/{block_id}":
+		endpoint.PostRequest = &ValidatorIndicesJson{}
+		endpoint.PostResponse = &SyncCommitteeRewardsResponseJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
 	case "/eth/v1/beacon/blinded_blocks/{block_id}":
 		endpoint.GetResponse = &BlindedBlockResponseJson{}
 		endpoint.Hooks = apimiddleware.HookCollection{
@@ -222,6 +240,7 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 	case "/eth/v1/config/spec":
 		endpoint.GetResponse = &SpecResponseJson{}
 	case "/eth/v1/events":
+		// Only reached when the native Server-Sent Events handler of the events server is not registered.
 		endpoint.CustomHandlers = []apimiddleware.CustomHandler{handleEvents}
 	case "/eth/v1/validator/duties/attester/{epoch}":
 		endpoint.PostRequest = &ValidatorIndicesJson{}
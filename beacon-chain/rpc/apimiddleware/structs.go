@@ -151,6 +151,24 @@ type BlockAttestationsResponseJson struct {
 	Finalized           bool               `json:"finalized"`
 }
 
+type BlockRewardsResponseJson struct {
+	Data                *BlockRewardsJson `json:"data"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+}
+
+type AttestationRewardsResponseJson struct {
+	Data                *AttestationRewardsJson `json:"data"`
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+	Finalized           bool                    `json:"finalized"`
+}
+
+type SyncCommitteeRewardsResponseJson struct {
+	Data                []*SyncCommitteeRewardJson `json:"data"`
+	ExecutionOptimistic bool                       `json:"execution_optimistic"`
+	Finalized           bool                       `json:"finalized"`
+}
+
 type AttestationsPoolResponseJson struct {
 	Data []*AttestationJson `json:"data"`
 }
@@ -982,6 +1000,41 @@ type SyncCommitteeDuty struct {
 	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
 }
 
+type BlockRewardsJson struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+type AttestationRewardsJson struct {
+	IdealRewards []*IdealAttestationRewardJson `json:"ideal_rewards"`
+	TotalRewards []*TotalAttestationRewardJson `json:"total_rewards"`
+}
+
+type IdealAttestationRewardJson struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	Inactivity       string `json:"inactivity"`
+}
+
+type TotalAttestationRewardJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Inactivity     string `json:"inactivity"`
+}
+
+type SyncCommitteeRewardJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Reward         string `json:"reward"`
+}
+
 type SignedAggregateAttestationAndProofJson struct {
 	Message   *AggregateAttestationAndProofJson `json:"message"`
 	Signature string                            `json:"signature" hex:"true"`
@@ -1140,6 +1193,11 @@ type ReceivedBlockDataJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventBlockGossipJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block" hex:"true"`
+}
+
 type AggregatedAttReceivedDataJson struct {
 	Aggregate *AttestationJson `json:"aggregate"`
 }
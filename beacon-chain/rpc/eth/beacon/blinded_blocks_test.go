@@ -911,6 +911,7 @@ func TestServer_SubmitBlindedBlockSSZ_OK(t *testing.T) {
 			BlockBuilder:      &builderTest.MockBuilderService{},
 			BlockReceiver:     c,
 			BlockNotifier:     &mock.MockBlockNotifier{},
+			OperationNotifier: &mock.MockOperationNotifier{},
 		}
 		beaconChainServer := &Server{
 			BeaconDB:                beaconDB,
@@ -969,6 +970,7 @@ func TestServer_SubmitBlindedBlockSSZ_OK(t *testing.T) {
 			BlockBuilder:      &builderTest.MockBuilderService{},
 			BlockReceiver:     c,
 			BlockNotifier:     &mock.MockBlockNotifier{},
+			OperationNotifier: &mock.MockOperationNotifier{},
 		}
 		beaconChainServer := &Server{
 			BeaconDB:                beaconDB,
@@ -1097,6 +1099,7 @@ func TestSubmitBlindedBlock(t *testing.T) {
 			BlockBuilder:      &builderTest.MockBuilderService{},
 			BlockReceiver:     c,
 			BlockNotifier:     &mock.MockBlockNotifier{},
+			OperationNotifier: &mock.MockOperationNotifier{},
 		}
 		beaconChainServer := &Server{
 			BeaconDB:                beaconDB,
@@ -1168,6 +1171,7 @@ func TestSubmitBlindedBlock(t *testing.T) {
 			BlockBuilder:      &builderTest.MockBuilderService{},
 			BlockReceiver:     c,
 			BlockNotifier:     &mock.MockBlockNotifier{},
+			OperationNotifier: &mock.MockOperationNotifier{},
 		}
 		beaconChainServer := &Server{
 			BeaconDB:                beaconDB,
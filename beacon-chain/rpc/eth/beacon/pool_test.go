@@ -11,6 +11,8 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	grpcutil "github.com/prysmaticlabs/prysm/v4/api/grpc"
 	blockchainmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	prysmtime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
@@ -441,13 +443,24 @@ func TestSubmitAttesterSlashing_Ok(t *testing.T) {
 
 	broadcaster := &p2pMock.MockBroadcaster{}
 	s := &Server{
-		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
-		SlashingsPool:    &slashingsmock.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &blockchainmock.ChainService{State: bs},
+		SlashingsPool:     &slashingsmock.PoolMock{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		Broadcaster:       broadcaster,
 	}
 
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
 	_, err = s.SubmitAttesterSlashing(ctx, slashing)
 	require.NoError(t, err)
+	select {
+	case e := <-opChannel:
+		assert.Equal(t, feed.EventType(operation.AttesterSlashingReceived), e.Type)
+	default:
+		t.Error("No attester slashing received event sent")
+	}
 	pendingSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, bs, true)
 	require.Equal(t, 1, len(pendingSlashings))
 	assert.DeepEqual(t, migration.V1AttSlashingToV1Alpha1(slashing), pendingSlashings[0])
@@ -515,9 +528,10 @@ func TestSubmitAttesterSlashing_AcrossFork(t *testing.T) {
 
 	broadcaster := &p2pMock.MockBroadcaster{}
 	s := &Server{
-		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
-		SlashingsPool:    &slashingsmock.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &blockchainmock.ChainService{State: bs},
+		SlashingsPool:     &slashingsmock.PoolMock{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		Broadcaster:       broadcaster,
 	}
 
 	_, err = s.SubmitAttesterSlashing(ctx, slashing)
@@ -622,13 +636,24 @@ func TestSubmitProposerSlashing_Ok(t *testing.T) {
 
 	broadcaster := &p2pMock.MockBroadcaster{}
 	s := &Server{
-		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
-		SlashingsPool:    &slashingsmock.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &blockchainmock.ChainService{State: bs},
+		SlashingsPool:     &slashingsmock.PoolMock{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		Broadcaster:       broadcaster,
 	}
 
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
 	_, err = s.SubmitProposerSlashing(ctx, slashing)
 	require.NoError(t, err)
+	select {
+	case e := <-opChannel:
+		assert.Equal(t, feed.EventType(operation.ProposerSlashingReceived), e.Type)
+	default:
+		t.Error("No proposer slashing received event sent")
+	}
 	pendingSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, bs, true)
 	require.Equal(t, 1, len(pendingSlashings))
 	assert.DeepEqual(t, migration.V1ProposerSlashingToV1Alpha1(slashing), pendingSlashings[0])
@@ -688,9 +713,10 @@ func TestSubmitProposerSlashing_AcrossFork(t *testing.T) {
 
 	broadcaster := &p2pMock.MockBroadcaster{}
 	s := &Server{
-		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
-		SlashingsPool:    &slashingsmock.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &blockchainmock.ChainService{State: bs},
+		SlashingsPool:     &slashingsmock.PoolMock{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		Broadcaster:       broadcaster,
 	}
 
 	_, err = s.SubmitProposerSlashing(ctx, slashing)
@@ -0,0 +1,334 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	corehelpers "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockRewards retrieves the rewards the proposer of the requested block earned for including it. The rewards are
+// computed by replaying the block's operations on the state before it, and are only available from Altair.
+func (bs *Server) GetBlockRewards(ctx context.Context, req *ethpbv2.BlockRewardsRequest) (*ethpbv2.BlockRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetBlockRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	err = handleGetBlockError(blk, err)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block rewards are not supported for Phase 0 blocks")
+	}
+	st, err := bs.preBlockState(ctx, blk)
+	if err != nil {
+		return nil, err
+	}
+
+	body := blk.Block().Body()
+	proposerIndex := blk.Block().ProposerIndex()
+	initBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, body.ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process proposer slashings: %v", err)
+	}
+	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, body.AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process attester slashings: %v", err)
+	}
+	attesterSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process attestations: %v", err)
+	}
+	attestationsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+	syncAggregate, err := body.SyncAggregate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+	_, syncAggregateReward, err := altair.ProcessSyncAggregate(ctx, st, syncAggregate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process sync aggregate: %v", err)
+	}
+
+	rewards := &ethpbv2.BlockRewards{
+		ProposerIndex:     proposerIndex,
+		ProposerSlashings: balanceIncrease(initBalance, proposerSlashingsBalance),
+		AttesterSlashings: balanceIncrease(proposerSlashingsBalance, attesterSlashingsBalance),
+		Attestations:      balanceIncrease(attesterSlashingsBalance, attestationsBalance),
+		SyncAggregate:     syncAggregateReward,
+	}
+	rewards.Total = rewards.ProposerSlashings + rewards.AttesterSlashings + rewards.Attestations + rewards.SyncAggregate
+
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block root: %v", err)
+	}
+	isOptimistic, err := bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if block is optimistic: %v", err)
+	}
+	return &ethpbv2.BlockRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.IsFinalized(ctx, root),
+	}, nil
+}
+
+// GetAttestationRewards retrieves the rewards validators earned for their attestations in the requested epoch, along
+// with the rewards a validator of each effective balance would have earned for perfect attestations. The rewards are
+// computed as in the epoch transition processing them, from the state at the end of the following epoch, so they are
+// only available once that epoch is over.
+func (bs *Server) GetAttestationRewards(ctx context.Context, req *ethpbv2.AttestationRewardsRequest) (*ethpbv2.AttestationRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetAttestationRewards")
+	defer span.End()
+
+	if req.Epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation rewards are not supported before Altair")
+	}
+	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch+1 >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestation rewards of epoch %d are available once epoch %d is over",
+			req.Epoch,
+			req.Epoch+1,
+		)
+	}
+	endSlot, err := slots.EpochEnd(req.Epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get end slot of epoch %d: %v", req.Epoch+1, err)
+	}
+	st, err := bs.StateFetcher.StateBySlot(ctx, endSlot)
+	if err != nil {
+		return nil, helpers.PrepareStateFetchGRPCError(err)
+	}
+	for _, idx := range req.Index {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", idx)
+		}
+	}
+
+	// Epoch processing is replayed up to the rewards, so that they account for the finality and inactivity updates.
+	st = st.Copy()
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not initialize precompute validators: %v", err)
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process epoch participation: %v", err)
+	}
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process justification and finalization: %v", err)
+	}
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process inactivity scores: %v", err)
+	}
+
+	requested := vals
+	if len(req.Index) > 0 {
+		requested = make([]*precompute.Validator, len(req.Index))
+		for i, idx := range req.Index {
+			requested[i] = vals[idx]
+		}
+	}
+	deltas, err := altair.AttestationDeltas(st, bal, requested)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation rewards: %v", err)
+	}
+	totalRewards := make([]*ethpbv2.TotalAttestationReward, len(deltas))
+	for i, d := range deltas {
+		idx := primitives.ValidatorIndex(i)
+		if len(req.Index) > 0 {
+			idx = req.Index[i]
+		}
+		totalRewards[i] = &ethpbv2.TotalAttestationReward{
+			ValidatorIndex: idx,
+			Head:           int64(d.HeadReward),
+			Target:         int64(d.TargetReward) - int64(d.TargetPenalty),
+			Source:         int64(d.SourceReward) - int64(d.SourcePenalty),
+			Inactivity:     -int64(d.InactivityPenalty),
+		}
+	}
+
+	cfg := params.BeaconConfig()
+	idealVals := make([]*precompute.Validator, 0, cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement)
+	for balance := cfg.EffectiveBalanceIncrement; balance <= cfg.MaxEffectiveBalance; balance += cfg.EffectiveBalanceIncrement {
+		idealVals = append(idealVals, &precompute.Validator{
+			IsActivePrevEpoch:            true,
+			CurrentEpochEffectiveBalance: balance,
+			IsPrevEpochSourceAttester:    true,
+			IsPrevEpochTargetAttester:    true,
+			IsPrevEpochHeadAttester:      true,
+		})
+	}
+	idealDeltas, err := altair.AttestationDeltas(st, bal, idealVals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute ideal attestation rewards: %v", err)
+	}
+	idealRewards := make([]*ethpbv2.IdealAttestationReward, len(idealDeltas))
+	for i, d := range idealDeltas {
+		idealRewards[i] = &ethpbv2.IdealAttestationReward{
+			EffectiveBalance: idealVals[i].CurrentEpochEffectiveBalance,
+			Head:             int64(d.HeadReward),
+			Target:           int64(d.TargetReward),
+			Source:           int64(d.SourceReward),
+		}
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(
+		ctx,
+		[]byte(strconv.FormatUint(uint64(endSlot), 10)),
+		bs.OptimisticModeFetcher,
+		bs.StateFetcher,
+		bs.ChainInfoFetcher,
+		bs.BeaconDB,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	}
+	return &ethpbv2.AttestationRewardsResponse{
+		Data: &ethpbv2.AttestationRewards{
+			IdealRewards: idealRewards,
+			TotalRewards: totalRewards,
+		},
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.FinalizedCheckpt().Epoch > req.Epoch+1,
+	}, nil
+}
+
+// GetSyncCommitteeRewards retrieves the rewards sync committee members earned for their participation in the requested
+// block. Members which missed it have the penalty they incurred reported as a negative reward.
+func (bs *Server) GetSyncCommitteeRewards(ctx context.Context, req *ethpbv2.SyncCommitteeRewardsRequest) (*ethpbv2.SyncCommitteeRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetSyncCommitteeRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	err = handleGetBlockError(blk, err)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Sync committee rewards are not supported for Phase 0 blocks")
+	}
+	st, err := bs.preBlockState(ctx, blk)
+	if err != nil {
+		return nil, err
+	}
+	requested := make(map[primitives.ValidatorIndex]bool, len(req.Index))
+	for _, idx := range req.Index {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", idx)
+		}
+		requested[idx] = true
+	}
+
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee: %v", err)
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get total active balance: %v", err)
+	}
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee rewards: %v", err)
+	}
+
+	// Validators can sit more than once in the committee, in which case their rewards add up.
+	rewards := make([]*ethpbv2.SyncCommitteeReward, 0, len(committee.Pubkeys))
+	positions := make(map[primitives.ValidatorIndex]int, len(committee.Pubkeys))
+	for i, pubkey := range committee.Pubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "Could not find sync committee member %#x", pubkey)
+		}
+		if len(requested) > 0 && !requested[idx] {
+			continue
+		}
+		reward := int64(participantReward)
+		if !syncAggregate.SyncCommitteeBits.BitAt(uint64(i)) {
+			reward = -reward
+		}
+		if pos, ok := positions[idx]; ok {
+			rewards[pos].Reward += reward
+			continue
+		}
+		positions[idx] = len(rewards)
+		rewards = append(rewards, &ethpbv2.SyncCommitteeReward{ValidatorIndex: idx, Reward: reward})
+	}
+
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block root: %v", err)
+	}
+	isOptimistic, err := bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if block is optimistic: %v", err)
+	}
+	return &ethpbv2.SyncCommitteeRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.IsFinalized(ctx, root),
+	}, nil
+}
+
+// preBlockState replays the state of the block's parent up to the block's slot.
+func (bs *Server) preBlockState(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error) {
+	st, err := bs.StateGenService.StateByRoot(ctx, blk.Block().ParentRoot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state of parent block: %v", err)
+	}
+	st, err = transition.ProcessSlots(ctx, st, blk.Block().Slot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
+	}
+	return st, nil
+}
+
+// balanceIncrease returns how much a balance increased, or zero if it decreased.
+func balanceIncrease(before, after uint64) uint64 {
+	if after < before {
+		return 0
+	}
+	return after - before
+}
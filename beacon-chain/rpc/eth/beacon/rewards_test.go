@@ -0,0 +1,263 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	dbTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+func TestServer_GetBlockRewards(t *testing.T) {
+	ctx := context.Background()
+	st, keys := rewardsTestState(t)
+	preSt := st.Copy()
+	b, err := util.GenerateFullBlockAltair(preSt, keys, &util.BlockGenConfig{
+		NumProposerSlashings: 1,
+		NumAttesterSlashings: 1,
+		NumAttestations:      1,
+		FullSyncAggregate:    true,
+	}, 1)
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	stateGen := mockstategen.NewMockService()
+	stateGen.AddStateForRoot(st.Copy(), blk.Block().ParentRoot())
+	chain := &mock.ChainService{
+		Block:           blk,
+		OptimisticRoots: map[[32]byte]bool{root: true},
+		FinalizedRoots:  map[[32]byte]bool{},
+	}
+	bs := &Server{
+		ChainInfoFetcher:      chain,
+		OptimisticModeFetcher: chain,
+		FinalizationFetcher:   chain,
+		StateGenService:       stateGen,
+	}
+
+	resp, err := bs.GetBlockRewards(ctx, &ethpbv2.BlockRewardsRequest{BlockId: []byte("head")})
+	require.NoError(t, err)
+	rewards := resp.Data
+	assert.Equal(t, true, resp.ExecutionOptimistic)
+	assert.Equal(t, false, resp.Finalized)
+	assert.Equal(t, blk.Block().ProposerIndex(), rewards.ProposerIndex)
+	assert.Equal(t, rewards.ProposerSlashings+rewards.AttesterSlashings+rewards.Attestations+rewards.SyncAggregate, rewards.Total)
+	assert.NotEqual(t, uint64(0), rewards.ProposerSlashings)
+	assert.NotEqual(t, uint64(0), rewards.AttesterSlashings)
+	assert.NotEqual(t, uint64(0), rewards.Attestations)
+	assert.NotEqual(t, uint64(0), rewards.SyncAggregate)
+
+	// The stategen replay must not have altered the stored parent state.
+	stateGen.AddStateForRoot(st.Copy(), blk.Block().ParentRoot())
+	syncResp, err := bs.GetSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{BlockId: []byte("head")})
+	require.NoError(t, err)
+	syncRewards := make(map[primitives.ValidatorIndex]int64)
+	for _, r := range syncResp.Data {
+		syncRewards[r.ValidatorIndex] = r.Reward
+	}
+
+	// Golden vector: apply the block with the state transition and check every balance change is accounted for.
+	pre, err := transition.ProcessSlots(ctx, st.Copy(), blk.Block().Slot())
+	require.NoError(t, err)
+	post, err := transition.ExecuteStateTransition(ctx, st.Copy(), blk)
+	require.NoError(t, err)
+	for i := primitives.ValidatorIndex(0); uint64(i) < uint64(post.NumValidators()); i++ {
+		v, err := post.ValidatorAtIndexReadOnly(i)
+		require.NoError(t, err)
+		if v.Slashed() {
+			continue
+		}
+		before, err := pre.BalanceAtIndex(i)
+		require.NoError(t, err)
+		after, err := post.BalanceAtIndex(i)
+		require.NoError(t, err)
+		want := syncRewards[i]
+		if i == rewards.ProposerIndex {
+			want += int64(rewards.Total)
+		}
+		assert.Equal(t, want, int64(after)-int64(before), "Unexpected balance change of validator %d", i)
+	}
+}
+
+func TestServer_GetSyncCommitteeRewards(t *testing.T) {
+	ctx := context.Background()
+	st, keys := rewardsTestState(t)
+	// No sync committee member took part in the block, so that they are all penalized.
+	b, err := util.GenerateFullBlockAltair(st.Copy(), keys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	stateGen := mockstategen.NewMockService()
+	chain := &mock.ChainService{Block: blk}
+	bs := &Server{
+		ChainInfoFetcher:      chain,
+		OptimisticModeFetcher: chain,
+		FinalizationFetcher:   chain,
+		StateGenService:       stateGen,
+	}
+
+	t.Run("requested validators", func(t *testing.T) {
+		stateGen.AddStateForRoot(st.Copy(), blk.Block().ParentRoot())
+		all, err := bs.GetSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{BlockId: []byte("head")})
+		require.NoError(t, err)
+		require.NotEqual(t, 0, len(all.Data))
+		for _, r := range all.Data {
+			assert.Equal(t, true, r.Reward < 0)
+		}
+
+		stateGen.AddStateForRoot(st.Copy(), blk.Block().ParentRoot())
+		idx := all.Data[0].ValidatorIndex
+		resp, err := bs.GetSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{
+			BlockId: []byte("head"),
+			Index:   []primitives.ValidatorIndex{idx},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, all.Data[0], resp.Data[0])
+	})
+	t.Run("invalid validator", func(t *testing.T) {
+		stateGen.AddStateForRoot(st.Copy(), blk.Block().ParentRoot())
+		_, err := bs.GetSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{
+			BlockId: []byte("head"),
+			Index:   []primitives.ValidatorIndex{64},
+		})
+		assert.ErrorContains(t, "Invalid validator index 64", err)
+	})
+	t.Run("phase 0", func(t *testing.T) {
+		phase0Blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+		require.NoError(t, err)
+		phase0Chain := &mock.ChainService{Block: phase0Blk}
+		bs := &Server{ChainInfoFetcher: phase0Chain}
+		_, err = bs.GetSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{BlockId: []byte("head")})
+		assert.ErrorContains(t, "not supported for Phase 0 blocks", err)
+		_, err = bs.GetBlockRewards(ctx, &ethpbv2.BlockRewardsRequest{BlockId: []byte("head")})
+		assert.ErrorContains(t, "not supported for Phase 0 blocks", err)
+	})
+}
+
+func TestServer_GetAttestationRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	const epoch = primitives.Epoch(1)
+	endSlot, err := slots.EpochEnd(epoch + 1)
+	require.NoError(t, err)
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(endSlot))
+	// Validators have every combination of timely source, target and head participation flags for the epoch, and
+	// some of them an inactivity score.
+	participation := make([]byte, st.NumValidators())
+	scores := make([]uint64, st.NumValidators())
+	for i := range participation {
+		participation[i] = byte(i % 8)
+		scores[i] = uint64(i % 3)
+	}
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+	require.NoError(t, st.SetInactivityScores(scores))
+
+	currentSlot, err := slots.EpochStart(epoch + 2)
+	require.NoError(t, err)
+	db := dbTest.SetupDB(t)
+	chain := &mock.ChainService{
+		Slot:                &currentSlot,
+		FinalizedCheckPoint: &eth.Checkpoint{Epoch: epoch + 2},
+	}
+	bs := &Server{
+		BeaconDB:              db,
+		GenesisTimeFetcher:    chain,
+		ChainInfoFetcher:      chain,
+		OptimisticModeFetcher: chain,
+		FinalizationFetcher:   chain,
+		StateFetcher: &testutil.MockFetcher{
+			StatesBySlot: map[primitives.Slot]state.BeaconState{endSlot: st},
+		},
+	}
+
+	t.Run("epoch not over", func(t *testing.T) {
+		_, err := bs.GetAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{Epoch: epoch + 1})
+		assert.ErrorContains(t, "available once epoch 3 is over", err)
+	})
+
+	resp, err := bs.GetAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{Epoch: epoch})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Finalized)
+	assert.Equal(t, false, resp.ExecutionOptimistic)
+	require.Equal(t, 64, len(resp.Data.TotalRewards))
+	ideal := resp.Data.IdealRewards
+	require.Equal(t, int(params.BeaconConfig().MaxEffectiveBalance/params.BeaconConfig().EffectiveBalanceIncrement), len(ideal))
+	last := ideal[len(ideal)-1]
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, last.EffectiveBalance)
+	// Validators 7, 15, ... were timely on everything, like the ideal validator of their effective balance.
+	perfect := resp.Data.TotalRewards[7]
+	assert.Equal(t, primitives.ValidatorIndex(7), perfect.ValidatorIndex)
+	assert.Equal(t, last.Head, perfect.Head)
+	assert.Equal(t, last.Target, perfect.Target)
+	assert.Equal(t, last.Source, perfect.Source)
+	assert.Equal(t, int64(0), perfect.Inactivity)
+
+	// Golden vector: process the epoch with the state transition and check every balance change is accounted for.
+	post, err := transition.ProcessSlots(ctx, st.Copy(), endSlot+1)
+	require.NoError(t, err)
+	for _, r := range resp.Data.TotalRewards {
+		before, err := st.BalanceAtIndex(r.ValidatorIndex)
+		require.NoError(t, err)
+		after, err := post.BalanceAtIndex(r.ValidatorIndex)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			r.Head+r.Target+r.Source+r.Inactivity,
+			int64(after)-int64(before),
+			"Unexpected balance change of validator %d",
+			r.ValidatorIndex,
+		)
+	}
+	// Validator 0 missed everything.
+	missed := resp.Data.TotalRewards[0]
+	assert.Equal(t, true, missed.Source < 0 && missed.Target < 0 && missed.Head == 0)
+
+	resp, err = bs.GetAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{
+		Epoch: epoch,
+		Index: []primitives.ValidatorIndex{7, 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data.TotalRewards))
+	assert.DeepEqual(t, perfect, resp.Data.TotalRewards[0])
+	assert.Equal(t, primitives.ValidatorIndex(3), resp.Data.TotalRewards[1].ValidatorIndex)
+
+	_, err = bs.GetAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{
+		Epoch: epoch,
+		Index: []primitives.ValidatorIndex{64},
+	})
+	assert.ErrorContains(t, "Invalid validator index 64", err)
+}
+
+// rewardsTestState returns an Altair genesis state whose sync committee is made of its own validators.
+func rewardsTestState(t *testing.T) (state.BeaconState, []bls.SecretKey) {
+	st, keys := util.DeterministicGenesisStateAltair(t, 64)
+	sc, err := altair.NextSyncCommittee(context.Background(), st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(sc))
+	require.NoError(t, st.SetNextSyncCommittee(sc))
+	return st, keys
+}
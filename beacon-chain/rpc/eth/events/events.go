@@ -1,6 +1,7 @@
 package events
 
 import (
+	"fmt"
 	"strings"
 
 	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
@@ -30,10 +31,16 @@ const (
 	HeadTopic = "head"
 	// BlockTopic represents a new produced block event topic.
 	BlockTopic = "block"
+	// BlockGossipTopic represents a new block which passed gossip validation event topic.
+	BlockGossipTopic = "block_gossip"
 	// AttestationTopic represents a new submitted attestation event topic.
 	AttestationTopic = "attestation"
 	// VoluntaryExitTopic represents a new performed voluntary exit event topic.
 	VoluntaryExitTopic = "voluntary_exit"
+	// ProposerSlashingTopic represents a new received proposer slashing event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// AttesterSlashingTopic represents a new received attester slashing event topic.
+	AttesterSlashingTopic = "attester_slashing"
 	// FinalizedCheckpointTopic represents a new finalized checkpoint event topic.
 	FinalizedCheckpointTopic = "finalized_checkpoint"
 	// ChainReorgTopic represents a chain reorganization event topic.
@@ -49,8 +56,11 @@ const (
 var casesHandled = map[string]bool{
 	HeadTopic:                      true,
 	BlockTopic:                     true,
+	BlockGossipTopic:               true,
 	AttestationTopic:               true,
 	VoluntaryExitTopic:             true,
+	ProposerSlashingTopic:          true,
+	AttesterSlashingTopic:          true,
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
@@ -67,16 +77,9 @@ func (s *Server) StreamEvents(
 	if req == nil || len(req.Topics) == 0 {
 		return status.Error(codes.InvalidArgument, "No topics specified to subscribe to")
 	}
-	// Check if the topics in the request are valid.
-	requestedTopics := make(map[string]bool)
-	for _, rawTopic := range req.Topics {
-		splitTopic := strings.Split(rawTopic, ",")
-		for _, topic := range splitTopic {
-			if _, ok := casesHandled[topic]; !ok {
-				return status.Errorf(codes.InvalidArgument, "Topic %s not allowed for event subscriptions", topic)
-			}
-			requestedTopics[topic] = true
-		}
+	requestedTopics, err := parseTopics(req.Topics)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Subscribe to event feeds from information received in the beacon node runtime.
@@ -93,19 +96,23 @@ func (s *Server) StreamEvents(
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
 
+	send := func(name string, data proto.Message) error {
+		return streamData(stream, name, data)
+	}
+
 	// Handle each event received and context cancelation.
 	for {
 		select {
 		case event := <-blockChan:
-			if err := handleBlockEvents(stream, requestedTopics, event); err != nil {
+			if err := handleBlockEvents(send, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle block event: %v", err)
 			}
 		case event := <-opsChan:
-			if err := handleBlockOperationEvents(stream, requestedTopics, event); err != nil {
+			if err := handleBlockOperationEvents(send, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle block operations event: %v", err)
 			}
 		case event := <-stateChan:
-			if err := s.handleStateEvents(stream, requestedTopics, event); err != nil {
+			if err := s.handleStateEvents(send, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle state event: %v", err)
 			}
 		case <-s.Ctx.Done():
@@ -116,8 +123,23 @@ func (s *Server) StreamEvents(
 	}
 }
 
+// parseTopics checks the topics of a subscription request are valid, splitting comma separated topics.
+func parseTopics(rawTopics []string) (map[string]bool, error) {
+	requestedTopics := make(map[string]bool)
+	for _, rawTopic := range rawTopics {
+		splitTopic := strings.Split(rawTopic, ",")
+		for _, topic := range splitTopic {
+			if _, ok := casesHandled[topic]; !ok {
+				return nil, fmt.Errorf("Topic %s not allowed for event subscriptions", topic)
+			}
+			requestedTopics[topic] = true
+		}
+	}
+	return requestedTopics, nil
+}
+
 func handleBlockEvents(
-	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+	send eventSender, requestedTopics map[string]bool, event *feed.Event,
 ) error {
 	switch event.Type {
 	case blockfeed.ReceivedBlock:
@@ -141,14 +163,14 @@ func handleBlockEvents(
 			Block:               item[:],
 			ExecutionOptimistic: blkData.IsOptimistic,
 		}
-		return streamData(stream, BlockTopic, eventBlock)
+		return send(BlockTopic, eventBlock)
 	default:
 		return nil
 	}
 }
 
 func handleBlockOperationEvents(
-	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+	send eventSender, requestedTopics map[string]bool, event *feed.Event,
 ) error {
 	switch event.Type {
 	case operation.AggregatedAttReceived:
@@ -160,7 +182,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation)
-		return streamData(stream, AttestationTopic, v1Data)
+		return send(AttestationTopic, v1Data)
 	case operation.UnaggregatedAttReceived:
 		if _, ok := requestedTopics[AttestationTopic]; !ok {
 			return nil
@@ -170,7 +192,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1AttestationToV1(attData.Attestation)
-		return streamData(stream, AttestationTopic, v1Data)
+		return send(AttestationTopic, v1Data)
 	case operation.ExitReceived:
 		if _, ok := requestedTopics[VoluntaryExitTopic]; !ok {
 			return nil
@@ -180,7 +202,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
-		return streamData(stream, VoluntaryExitTopic, v1Data)
+		return send(VoluntaryExitTopic, v1Data)
 	case operation.SyncCommitteeContributionReceived:
 		if _, ok := requestedTopics[SyncCommitteeContributionTopic]; !ok {
 			return nil
@@ -190,7 +212,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
-		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+		return send(SyncCommitteeContributionTopic, v2Data)
 	case operation.BLSToExecutionChangeReceived:
 		if _, ok := requestedTopics[BLSToExecutionChangeTopic]; !ok {
 			return nil
@@ -200,7 +222,43 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v2Change := migration.V1Alpha1SignedBLSToExecChangeToV2(changeData.Change)
-		return streamData(stream, BLSToExecutionChangeTopic, v2Change)
+		return send(BLSToExecutionChangeTopic, v2Change)
+	case operation.ProposerSlashingReceived:
+		if _, ok := requestedTopics[ProposerSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return send(ProposerSlashingTopic, v1Data)
+	case operation.AttesterSlashingReceived:
+		if _, ok := requestedTopics[AttesterSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return send(AttesterSlashingTopic, v1Data)
+	case operation.BlockGossipReceived:
+		if _, ok := requestedTopics[BlockGossipTopic]; !ok {
+			return nil
+		}
+		blkData, ok := event.Data.(*operation.BlockGossipReceivedData)
+		if !ok {
+			return nil
+		}
+		root, err := blkData.SignedBlock.Block().HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash tree root block")
+		}
+		return send(BlockGossipTopic, &ethpb.EventBlockGossip{
+			Slot:  blkData.SignedBlock.Block().Slot(),
+			Block: root[:],
+		})
 
 	default:
 		return nil
@@ -208,7 +266,7 @@ func handleBlockOperationEvents(
 }
 
 func (s *Server) handleStateEvents(
-	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+	send eventSender, requestedTopics map[string]bool, event *feed.Event,
 ) error {
 	switch event.Type {
 	case statefeed.NewHead:
@@ -217,10 +275,10 @@ func (s *Server) handleStateEvents(
 			if !ok {
 				return nil
 			}
-			return streamData(stream, HeadTopic, head)
+			return send(HeadTopic, head)
 		}
 		if _, ok := requestedTopics[PayloadAttributesTopic]; ok {
-			if err := s.streamPayloadAttributes(stream); err != nil {
+			if err := s.streamPayloadAttributes(send); err != nil {
 				log.WithError(err).Error("Unable to obtain stream payload attributes")
 			}
 			return nil
@@ -228,7 +286,7 @@ func (s *Server) handleStateEvents(
 		return nil
 	case statefeed.MissedSlot:
 		if _, ok := requestedTopics[PayloadAttributesTopic]; ok {
-			if err := s.streamPayloadAttributes(stream); err != nil {
+			if err := s.streamPayloadAttributes(send); err != nil {
 				log.WithError(err).Error("Unable to obtain stream payload attributes")
 			}
 			return nil
@@ -242,7 +300,7 @@ func (s *Server) handleStateEvents(
 		if !ok {
 			return nil
 		}
-		return streamData(stream, FinalizedCheckpointTopic, finalizedCheckpoint)
+		return send(FinalizedCheckpointTopic, finalizedCheckpoint)
 	case statefeed.Reorg:
 		if _, ok := requestedTopics[ChainReorgTopic]; !ok {
 			return nil
@@ -251,7 +309,7 @@ func (s *Server) handleStateEvents(
 		if !ok {
 			return nil
 		}
-		return streamData(stream, ChainReorgTopic, reorg)
+		return send(ChainReorgTopic, reorg)
 	default:
 		return nil
 	}
@@ -260,7 +318,7 @@ func (s *Server) handleStateEvents(
 // streamPayloadAttributes on new head event.
 // This event stream is intended to be used by builders and relays.
 // parent_ fields are based on state at N_{current_slot}, while the rest of fields are based on state of N_{current_slot + 1}
-func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEventsServer) error {
+func (s *Server) streamPayloadAttributes(send eventSender) error {
 	st, err := s.HeadFetcher.HeadState(s.Ctx)
 	if err != nil {
 		return err
@@ -303,7 +361,7 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 
 	switch headState.Version() {
 	case version.Bellatrix:
-		return streamData(stream, PayloadAttributesTopic, &ethpb.EventPayloadAttributeV1{
+		return send(PayloadAttributesTopic, &ethpb.EventPayloadAttributeV1{
 			Version: version.String(headState.Version()),
 			Data: &ethpb.EventPayloadAttributeV1_BasePayloadAttribute{
 				ProposerIndex:     proposerIndex,
@@ -323,7 +381,7 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 		if err != nil {
 			return err
 		}
-		return streamData(stream, PayloadAttributesTopic, &ethpb.EventPayloadAttributeV2{
+		return send(PayloadAttributesTopic, &ethpb.EventPayloadAttributeV2{
 			Version: version.String(headState.Version()),
 			Data: &ethpb.EventPayloadAttributeV2_BasePayloadAttribute{
 				ProposerIndex:     proposerIndex,
@@ -344,6 +402,9 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 	}
 }
 
+// eventSender delivers an event of the named topic to a subscriber.
+type eventSender func(name string, data proto.Message) error
+
 func streamData(stream ethpbservice.Events_StreamEventsServer, name string, data proto.Message) error {
 	returnData, err := anypb.New(data)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	prysmtime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
@@ -283,6 +284,113 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(ProposerSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.ProposerSlashing{
+			Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{ProposerIndex: 1, Slot: 1},
+			}),
+			Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{ProposerIndex: 1, Slot: 1, BodyRoot: bytesutil.PadTo([]byte("body"), 32)},
+			}),
+		}
+		wantedSlashing := migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: ProposerSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.ProposerSlashingReceived,
+				Data: &operation.ProposerSlashingReceivedData{
+					ProposerSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(AttesterSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.AttesterSlashing{
+			Attestation_1: util.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+			Attestation_2: util.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{2, 3}}),
+		}
+		wantedSlashing := migration.V1Alpha1AttSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: AttesterSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{AttesterSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.AttesterSlashingReceived,
+				Data: &operation.AttesterSlashingReceivedData{
+					AttesterSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(BlockGossipTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlockV1alpha1 := util.NewBeaconBlockCapella()
+		wantedBlockV1alpha1.Block.Slot = 8
+		wantedBlock, err := blocks.NewSignedBeaconBlock(wantedBlockV1alpha1)
+		require.NoError(t, err)
+		wantedRoot, err := wantedBlockV1alpha1.Block.HashTreeRoot()
+		require.NoError(t, err)
+		genericResponse, err := anypb.New(&ethpb.EventBlockGossip{
+			Slot:  8,
+			Block: wantedRoot[:],
+		})
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: BlockGossipTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockGossipTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.BlockGossipReceived,
+				Data: &operation.BlockGossipReceivedData{
+					SignedBlock: wantedBlock,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
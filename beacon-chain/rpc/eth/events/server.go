@@ -5,6 +5,7 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
@@ -21,4 +22,8 @@ type Server struct {
 	OperationNotifier opfeed.Notifier
 	HeadFetcher       blockchain.HeadFetcher
 	ChainInfoFetcher  blockchain.ChainInfoFetcher
+	// EventBufferSize is the number of events queued for a Server-Sent Events client before it is disconnected.
+	EventBufferSize int
+	// KeepAliveInterval is the delay after which a comment is sent on an idle Server-Sent Events stream.
+	KeepAliveInterval time.Duration
 }
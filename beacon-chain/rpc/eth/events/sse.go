@@ -0,0 +1,262 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// defaultEventBufferSize is the number of events queued for a client before it is deemed too slow and disconnected.
+	defaultEventBufferSize = 1024
+	// defaultKeepAliveInterval is the delay after which a comment is sent on an idle event stream.
+	defaultKeepAliveInterval = 10 * time.Second
+)
+
+var errSlowConsumer = errors.New("event buffer is full")
+
+// StreamEventsHTTP serves the events API as Server-Sent Events, subscribing to the block, state and operation feeds
+// directly rather than going through the gRPC gateway. Events are queued for each client in a bounded buffer, and a
+// client falling so far behind that its buffer fills up is disconnected, so that it cannot hold back the feeds. Idle
+// streams receive a comment every keep-alive interval, so that proxies do not time them out.
+func (s *Server) StreamEventsHTTP(w http.ResponseWriter, r *http.Request) {
+	rawTopics := r.URL.Query()["topics"]
+	if len(rawTopics) == 0 {
+		writeHTTPError(w, http.StatusBadRequest, "No topics specified to subscribe to")
+		return
+	}
+	requestedTopics, err := parseTopics(rawTopics)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPError(w, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	// Subscribe to event feeds from information received in the beacon node runtime.
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.BlockNotifier.BlockFeed().Subscribe(blockChan)
+
+	opsChan := make(chan *feed.Event, 1)
+	opsSub := s.OperationNotifier.OperationFeed().Subscribe(opsChan)
+
+	stateChan := make(chan *feed.Event, 1)
+	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChan)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	queue := make(chan []byte, s.eventBufferSize())
+	done := make(chan struct{})
+	// Feed events are read and queued on their own routine, so that a client stuck on a write does not block the feeds.
+	go func() {
+		defer close(done)
+		defer blockSub.Unsubscribe()
+		defer opsSub.Unsubscribe()
+		defer stateSub.Unsubscribe()
+		s.queueEvents(ctx, requestedTopics, blockChan, opsChan, stateChan, queue)
+		cancel()
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(s.keepAliveInterval())
+	defer keepAlive.Stop()
+	for {
+		select {
+		case frame := <-queue:
+			if _, err := w.Write(frame); err != nil {
+				return
+			}
+			flusher.Flush()
+			keepAlive.Reset(s.keepAliveInterval())
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ":\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ctx.Done():
+			return
+		case <-s.Ctx.Done():
+			return
+		}
+	}
+}
+
+// queueEvents encodes the feed events of the requested topics into the client queue, until the context is done or
+// the queue is full.
+func (s *Server) queueEvents(
+	ctx context.Context,
+	requestedTopics map[string]bool,
+	blockChan, opsChan, stateChan <-chan *feed.Event,
+	queue chan<- []byte,
+) {
+	send := func(name string, data proto.Message) error {
+		frame, err := sseFrame(name, data)
+		if err != nil {
+			return err
+		}
+		select {
+		case queue <- frame:
+			return nil
+		default:
+			return errSlowConsumer
+		}
+	}
+	for {
+		var err error
+		select {
+		case event := <-blockChan:
+			err = handleBlockEvents(send, requestedTopics, event)
+		case event := <-opsChan:
+			err = handleBlockOperationEvents(send, requestedTopics, event)
+		case event := <-stateChan:
+			err = s.handleStateEvents(send, requestedTopics, event)
+		case <-ctx.Done():
+			return
+		}
+		if errors.Is(err, errSlowConsumer) {
+			log.Warn("Disconnecting events stream client which is not keeping up with events")
+			return
+		}
+		if err != nil {
+			log.WithError(err).Error("Could not handle event")
+			return
+		}
+	}
+}
+
+func (s *Server) eventBufferSize() int {
+	if s.EventBufferSize > 0 {
+		return s.EventBufferSize
+	}
+	return defaultEventBufferSize
+}
+
+func (s *Server) keepAliveInterval() time.Duration {
+	if s.KeepAliveInterval > 0 {
+		return s.KeepAliveInterval
+	}
+	return defaultKeepAliveInterval
+}
+
+// sseFrame encodes an event in the Server-Sent Events format, with its data as in the Beacon API.
+func sseFrame(name string, data proto.Message) ([]byte, error) {
+	// The gRPC stream carries aggregates whole, for the gateway to extract the attestation from.
+	if agg, ok := data.(*ethpb.AggregateAttestationAndProof); ok {
+		data = agg.Aggregate
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString("event: ")
+	buf.WriteString(name)
+	buf.WriteString("\ndata: ")
+	if err := writeMessageJSON(buf, data.ProtoReflect()); err != nil {
+		return nil, errors.Wrapf(err, "could not encode %s event", name)
+	}
+	buf.WriteString("\n\n")
+	return buf.Bytes(), nil
+}
+
+// writeMessageJSON encodes a message the way the Beacon API does: fields keep their proto names and declaration order,
+// integers are quoted decimal strings and bytes are 0x prefixed hex strings.
+func writeMessageJSON(buf *bytes.Buffer, m protoreflect.Message) error {
+	if !m.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	buf.WriteByte('{')
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeStringJSON(buf, string(fd.Name()))
+		buf.WriteByte(':')
+		v := m.Get(fd)
+		switch {
+		case fd.IsMap():
+			return errors.Errorf("unsupported map field %s", fd.Name())
+		case fd.IsList():
+			list := v.List()
+			buf.WriteByte('[')
+			for j := 0; j < list.Len(); j++ {
+				if j > 0 {
+					buf.WriteByte(',')
+				}
+				if err := writeValueJSON(buf, fd, list.Get(j)); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+		default:
+			if err := writeValueJSON(buf, fd, v); err != nil {
+				return err
+			}
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeValueJSON(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		buf.WriteString(strconv.FormatBool(v.Bool()))
+	case protoreflect.StringKind:
+		writeStringJSON(buf, v.String())
+	case protoreflect.BytesKind:
+		writeStringJSON(buf, hexutil.Encode(v.Bytes()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		writeStringJSON(buf, strconv.FormatInt(v.Int(), 10))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		writeStringJSON(buf, strconv.FormatUint(v.Uint(), 10))
+	case protoreflect.EnumKind:
+		enum := fd.Enum().Values().ByNumber(v.Enum())
+		if enum == nil {
+			return errors.Errorf("unknown value %d of enum field %s", v.Enum(), fd.Name())
+		}
+		writeStringJSON(buf, string(enum.Name()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return writeMessageJSON(buf, v.Message())
+	default:
+		return errors.Errorf("unsupported kind %s of field %s", fd.Kind(), fd.Name())
+	}
+	return nil
+}
+
+func writeStringJSON(buf *bytes.Buffer, s string) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		// Marshaling a string cannot fail.
+		panic(err)
+	}
+	buf.Write(b)
+}
+
+func writeHTTPError(w http.ResponseWriter, code int, msg string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{Message: msg, Code: code}, nil)
+}
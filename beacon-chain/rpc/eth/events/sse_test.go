@@ -0,0 +1,214 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStreamEventsHTTP_Preconditions(t *testing.T) {
+	srv := &Server{}
+	t.Run("no_topics_specified", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		srv.StreamEventsHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/events", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.StringContains(t, "No topics specified", rec.Body.String())
+	})
+	t.Run("topic_not_allowed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		srv.StreamEventsHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics=head,foobar", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.StringContains(t, "Topic foobar not allowed", rec.Body.String())
+	})
+}
+
+func TestStreamEventsHTTP_Events(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := setupHTTPServer(ctx)
+	httpSrv := httptest.NewServer(http.HandlerFunc(srv.StreamEventsHTTP))
+	defer httpSrv.Close()
+
+	resp, lines := getEvents(t, ctx, httpSrv.URL+"?topics="+VoluntaryExitTopic+","+AttestationTopic)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	opFeed := srv.OperationNotifier.OperationFeed()
+	// Events of topics which were not requested are not sent.
+	opFeed.Send(&feed.Event{
+		Type: operation.BLSToExecutionChangeReceived,
+		Data: &operation.BLSToExecutionChangeReceivedData{Change: &eth.SignedBLSToExecutionChange{}},
+	})
+	opFeed.Send(&feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{
+			Exit: &eth.SignedVoluntaryExit{
+				Exit:      &eth.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+				Signature: []byte{0xab, 0xcd},
+			},
+		},
+	})
+	assert.Equal(t, "event: "+VoluntaryExitTopic, readLine(t, lines))
+	assert.Equal(t, `data: {"message":{"epoch":"1","validator_index":"2"},"signature":"0xabcd"}`, readLine(t, lines))
+	assert.Equal(t, "", readLine(t, lines))
+
+	opFeed.Send(&feed.Event{
+		Type: operation.AggregatedAttReceived,
+		Data: &operation.AggregatedAttReceivedData{
+			Attestation: &eth.AggregateAttestationAndProof{
+				AggregatorIndex: 7,
+				Aggregate: &eth.Attestation{
+					AggregationBits: []byte{0x01},
+					Data: &eth.AttestationData{
+						Slot:   3,
+						Source: &eth.Checkpoint{},
+						Target: &eth.Checkpoint{},
+					},
+				},
+			},
+		},
+	})
+	assert.Equal(t, "event: "+AttestationTopic, readLine(t, lines))
+	assert.Equal(
+		t,
+		`data: {"aggregation_bits":"0x01","data":{"slot":"3","index":"0","beacon_block_root":"0x",`+
+			`"source":{"epoch":"0","root":"0x"},"target":{"epoch":"0","root":"0x"}},"signature":"0x"}`,
+		readLine(t, lines),
+	)
+}
+
+func TestStreamEventsHTTP_KeepAlive(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := setupHTTPServer(ctx)
+	srv.KeepAliveInterval = 10 * time.Millisecond
+	httpSrv := httptest.NewServer(http.HandlerFunc(srv.StreamEventsHTTP))
+	defer httpSrv.Close()
+
+	resp, lines := getEvents(t, ctx, httpSrv.URL+"?topics="+HeadTopic)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, ":", readLine(t, lines))
+	assert.Equal(t, "", readLine(t, lines))
+}
+
+func TestStreamEventsHTTP_SlowConsumer(t *testing.T) {
+	srv := setupHTTPServer(context.Background())
+	srv.EventBufferSize = 1
+	opFeed := srv.OperationNotifier.OperationFeed()
+	w := &blockingWriter{ResponseRecorder: httptest.NewRecorder(), unblock: make(chan struct{}), writing: make(chan struct{}, 8)}
+	returned := make(chan struct{})
+	go func() {
+		srv.StreamEventsHTTP(w, httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics="+VoluntaryExitTopic, nil))
+		close(returned)
+	}()
+
+	exit := &feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{Exit: &eth.SignedVoluntaryExit{Exit: &eth.VoluntaryExit{}}},
+	}
+	// Wait for the handler to subscribe and get stuck writing the first event.
+	require.NoError(t, waitFor(func() bool { return opFeed.Send(exit) > 0 }))
+	<-w.writing
+	// The second event fills the buffer, and the third one gets the client disconnected.
+	require.Equal(t, 1, opFeed.Send(exit))
+	require.Equal(t, 1, opFeed.Send(exit))
+	require.NoError(t, waitFor(func() bool { return opFeed.Send(exit) == 0 }))
+
+	close(w.unblock)
+	select {
+	case <-returned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Handler did not return after disconnecting the client")
+	}
+}
+
+func setupHTTPServer(ctx context.Context) *Server {
+	return &Server{
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
+		StateNotifier:     &mockChain.MockStateNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+		Ctx:               ctx,
+	}
+}
+
+func getEvents(t *testing.T, ctx context.Context, url string) (*http.Response, <-chan string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	lines := make(chan string, 16)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	return resp, lines
+}
+
+func readLine(t *testing.T, lines <-chan string) string {
+	select {
+	case line, ok := <-lines:
+		require.Equal(t, true, ok, "Event stream closed")
+		return line
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event stream")
+		return ""
+	}
+}
+
+func waitFor(cond func() bool) error {
+	for i := 0; i < 500; i++ {
+		if cond() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("condition not met")
+}
+
+// blockingWriter is a response writer which does not complete writes of events until unblocked,
+// as with a client which stopped reading its events.
+type blockingWriter struct {
+	*httptest.ResponseRecorder
+	unblock chan struct{}
+	writing chan struct{}
+}
+
+func (w *blockingWriter) Write(b []byte) (int, error) {
+	if strings.HasPrefix(string(b), "event:") {
+		w.writing <- struct{}{}
+		<-w.unblock
+	}
+	return w.ResponseRecorder.Write(b)
+}
+
+func TestSSEFrame(t *testing.T) {
+	frame, err := sseFrame(HeadTopic, &ethpb.EventHead{Slot: 5, EpochTransition: true})
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		"event: head\ndata: {\"slot\":\"5\",\"block\":\"0x\",\"state\":\"0x\",\"epoch_transition\":true,"+
+			"\"previous_duty_dependent_root\":\"0x\",\"current_duty_dependent_root\":\"0x\",\"execution_optimistic\":false}\n\n",
+		string(frame),
+	)
+}
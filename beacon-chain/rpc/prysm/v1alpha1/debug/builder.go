@@ -0,0 +1,34 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	pbrpc "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBuilderBids returns the builder bid audit records of the node's own proposals within a slot range. The range
+// cannot be wider than the retention period of the records.
+func (ds *Server) ListBuilderBids(ctx context.Context, req *pbrpc.BuilderBidsRequest) (*pbrpc.BuilderBidsResponse, error) {
+	endSlot := req.EndSlot
+	if endSlot == 0 {
+		endSlot = req.StartSlot
+	}
+	if endSlot < req.StartSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "End slot %d is before start slot %d", endSlot, req.StartSlot)
+	}
+	retention := primitives.Slot(kv.BuilderBidRecordsRetention) * params.BeaconConfig().SlotsPerEpoch
+	if endSlot-req.StartSlot >= retention {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot range %d-%d is wider than the %d slots builder bid records are kept for",
+			req.StartSlot, endSlot, retention)
+	}
+	records, err := ds.BeaconDB.BuilderBidRecords(ctx, req.StartSlot, endSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve builder bid records: %v", err)
+	}
+	return &pbrpc.BuilderBidsResponse{Records: records}, nil
+}
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestServer_ListBuilderBids(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	for _, slot := range []uint64{1, 5, 9} {
+		require.NoError(t, db.SaveBuilderBidRecord(ctx, &ethpb.BuilderBidRecord{
+			Slot: primitives.Slot(slot),
+			Bids: []*ethpb.RelayBidRecord{{Relay: "relay.example", Value: "1", Chosen: true}},
+		}))
+	}
+	bs := &Server{BeaconDB: db}
+
+	res, err := bs.ListBuilderBids(ctx, &ethpb.BuilderBidsRequest{StartSlot: 2, EndSlot: 9})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Records))
+	assert.Equal(t, primitives.Slot(5), res.Records[0].Slot)
+	assert.Equal(t, primitives.Slot(9), res.Records[1].Slot)
+
+	// The end slot defaults to the start slot.
+	res, err = bs.ListBuilderBids(ctx, &ethpb.BuilderBidsRequest{StartSlot: 5})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Records))
+	assert.Equal(t, "relay.example", res.Records[0].Bids[0].Relay)
+
+	_, err = bs.ListBuilderBids(ctx, &ethpb.BuilderBidsRequest{StartSlot: 5, EndSlot: 4})
+	require.ErrorContains(t, "End slot 4 is before start slot 5", err)
+
+	// The range cannot be wider than the retention period of the records.
+	retention := primitives.Slot(kv.BuilderBidRecordsRetention) * params.BeaconConfig().SlotsPerEpoch
+	res, err = bs.ListBuilderBids(ctx, &ethpb.BuilderBidsRequest{StartSlot: 5, EndSlot: 5 + retention - 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Records))
+	_, err = bs.ListBuilderBids(ctx, &ethpb.BuilderBidsRequest{StartSlot: 5, EndSlot: 5 + retention})
+	require.ErrorContains(t, "is wider than", err)
+}
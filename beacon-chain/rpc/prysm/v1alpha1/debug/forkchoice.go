@@ -0,0 +1,25 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetForkChoiceGraph returns the fork choice tree with the weight and votes of each node, the path
+// followed to select the head and the late block reorg decisions for the next slot.
+func (ds *Server) GetForkChoiceGraph(ctx context.Context, req *pbrpc.ForkChoiceGraphRequest) (*pbrpc.ForkChoiceGraph, error) {
+	graph, err := ds.ForkchoiceFetcher.ForkChoiceGraph(ctx, req.IncludeVotes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get fork choice graph: %v", err)
+	}
+	return graph, nil
+}
+
+// ListReorgDecisions returns the late block reorg policy and its most recent evaluations.
+func (ds *Server) ListReorgDecisions(_ context.Context, _ *empty.Empty) (*pbrpc.ReorgDecisions, error) {
+	return ds.ForkchoiceFetcher.ReorgDecisions(), nil
+}
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestServer_GetForkChoiceGraph(t *testing.T) {
+	ctx := context.Background()
+	fc := doublylinkedtree.New()
+	st, _ := util.DeterministicGenesisState(t, 8)
+	root := params.BeaconConfig().ZeroHash
+	require.NoError(t, fc.InsertNode(ctx, st, root))
+	bs := &Server{ForkchoiceFetcher: &mock.ChainService{ForkChoiceStore: fc}}
+
+	graph, err := bs.GetForkChoiceGraph(ctx, &ethpb.ForkChoiceGraphRequest{IncludeVotes: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(graph.Nodes))
+	assert.DeepEqual(t, root[:], graph.Nodes[0].Root)
+	assert.DeepEqual(t, root[:], graph.HeadRoot)
+	assert.Equal(t, 0, len(graph.Votes))
+
+	bs = &Server{ForkchoiceFetcher: &mock.ChainService{ForkChoiceStore: doublylinkedtree.New()}}
+	_, err = bs.GetForkChoiceGraph(ctx, &ethpb.ForkChoiceGraphRequest{})
+	require.ErrorContains(t, "Could not get fork choice graph", err)
+}
+
+func TestServer_ListReorgDecisions(t *testing.T) {
+	fc := doublylinkedtree.New()
+	require.Equal(t, false, fc.ShouldOverrideFCU())
+	bs := &Server{ForkchoiceFetcher: &mock.ChainService{ForkChoiceStore: fc}}
+
+	res, err := bs.ListReorgDecisions(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().ReorgWeightThreshold, res.Policy.HeadWeightThreshold)
+	require.Equal(t, 1, len(res.Decisions))
+	assert.Equal(t, ethpb.ReorgDecision_OVERRIDE_FCU, res.Decisions[0].Evaluation)
+	assert.Equal(t, false, res.Decisions[0].Reorg)
+	assert.Equal(t, "there is no head", res.Decisions[0].Reason)
+}
@@ -36,6 +36,60 @@ func (ds *Server) ListPeers(_ context.Context, _ *empty.Empty) (*ethpb.DebugPeer
 	return &ethpb.DebugPeerResponses{Responses: responses}, nil
 }
 
+// ListPeerScores returns the score of every known peer, broken down into the gossipsub score
+// components of each topic and the contributions of the scorers.
+func (ds *Server) ListPeerScores(_ context.Context, _ *empty.Empty) (*ethpb.PeerScores, error) {
+	peers := ds.PeersFetcher.Peers()
+	scorers := peers.Scorers()
+	weights := scorers.Weights()
+	resp := &ethpb.PeerScores{
+		Weights: &ethpb.ScorerWeights{
+			BadResponses:  float32(weights.BadResponses),
+			BlockProvider: float32(weights.BlockProvider),
+			PeerStatus:    float32(weights.PeerStatus),
+			Gossip:        float32(weights.Gossip),
+		},
+		BadResponsesThreshold: uint64(scorers.BadResponsesScorer().Threshold()),
+		GossipScoreThreshold:  float32(scorers.GossipScorer().Threshold()),
+	}
+	for _, pid := range peers.All() {
+		connState, err := peers.ConnectionState(pid)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+		}
+		gScore, bPenalty, topicScores, err := scorers.GossipScorer().GossipData(pid)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+		}
+		appSpecificScore, ipColocationFactor, err := scorers.GossipScorer().GossipPenalties(pid)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+		}
+		peerScore := &ethpb.PeerScore{
+			PeerId:             pid.String(),
+			ConnectionState:    ethpb.ConnectionState(connState),
+			OverallScore:       float32(scorers.Score(pid)),
+			GossipScore:        float32(gScore),
+			TopicScores:        topicScores,
+			BehaviourPenalty:   float32(bPenalty),
+			AppSpecificScore:   float32(appSpecificScore),
+			IpColocationFactor: float32(ipColocationFactor),
+		}
+		for _, c := range scorers.Contributions(pid) {
+			peerScore.IsBad = peerScore.IsBad || c.IsBad
+			peerScore.Scorers = append(peerScore.Scorers, &ethpb.ScorerContribution{
+				Scorer:       c.Scorer,
+				Score:        float32(c.Score),
+				Weight:       float32(c.Weight),
+				Contribution: float32(c.Score * c.Weight),
+				IsBad:        c.IsBad,
+			})
+		}
+		resp.Peers = append(resp.Peers, peerScore)
+	}
+	return resp, nil
+}
+
 func (ds *Server) getPeer(pid peer.ID) (*ethpb.DebugPeerResponse, error) {
 	peers := ds.PeersFetcher.Peers()
 	peerStore := ds.PeerManager.Host().Peerstore()
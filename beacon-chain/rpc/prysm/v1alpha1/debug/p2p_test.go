@@ -57,3 +57,48 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+func TestDebugServer_ListPeerScores(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher: peersProvider,
+	}
+	scorers := peersProvider.Peers().Scorers()
+	firstPeer := peersProvider.Peers().All()[0]
+	scorers.BadResponsesScorer().Increment(firstPeer)
+	scorers.GossipScorer().SetGossipData(firstPeer, -10, 2, map[string]*ethpb.TopicScoreSnapshot{
+		"/eth2/00000000/beacon_block/ssz_snappy": {TimeInMesh: 1000, TopicWeight: 0.8, Score: 1.5},
+	})
+	scorers.GossipScorer().SetGossipPenalties(firstPeer, 0, 3)
+
+	res, err := ds.ListPeerScores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.4), res.Weights.Gossip)
+	assert.Equal(t, uint64(5), res.BadResponsesThreshold)
+	assert.Equal(t, float32(-100), res.GossipScoreThreshold)
+	require.Equal(t, 2, len(res.Peers))
+
+	var peerScore *ethpb.PeerScore
+	for _, p := range res.Peers {
+		if p.PeerId == firstPeer.String() {
+			peerScore = p
+		}
+	}
+	require.NotNil(t, peerScore)
+	assert.Equal(t, ethpb.ConnectionState_CONNECTED, peerScore.ConnectionState)
+	assert.Equal(t, float32(scorers.Score(firstPeer)), peerScore.OverallScore)
+	assert.Equal(t, false, peerScore.IsBad)
+	assert.Equal(t, float32(-10), peerScore.GossipScore)
+	assert.Equal(t, float32(2), peerScore.BehaviourPenalty)
+	assert.Equal(t, float32(3), peerScore.IpColocationFactor)
+	assert.Equal(t, float32(1.5), peerScore.TopicScores["/eth2/00000000/beacon_block/ssz_snappy"].Score)
+	require.Equal(t, 4, len(peerScore.Scorers))
+	badResponses := peerScore.Scorers[0]
+	assert.Equal(t, "bad_responses", badResponses.Scorer)
+	assert.Equal(t, float32(-2), badResponses.Score)
+	assert.Equal(t, float32(0.3), badResponses.Weight)
+	assert.Equal(t, float32(-2*0.3), badResponses.Contribution)
+	gossip := peerScore.Scorers[3]
+	assert.Equal(t, "gossip", gossip.Scorer)
+	assert.Equal(t, float32(-10*0.4), gossip.Contribution)
+}
@@ -31,6 +31,7 @@ type Server struct {
 	PeerManager        p2p.PeerManager
 	PeersFetcher       p2p.PeersProvider
 	ReplayerBuilder    stategen.ReplayerBuilder
+	ForkchoiceFetcher  blockchain.ForkchoiceFetcher
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
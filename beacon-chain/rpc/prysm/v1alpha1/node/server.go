@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
@@ -41,6 +42,7 @@ type Server struct {
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
 	POWChainInfoFetcher  execution.ChainInfoFetcher
+	RelayStatusFetcher   builder.RelayStatusFetcher
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -235,6 +237,16 @@ func (ns *Server) GetETH1ConnectionStatus(_ context.Context, _ *empty.Empty) (*e
 	}, nil
 }
 
+// ListBuilderRelays lists the builder relays of the node along with the faults recorded against them.
+func (ns *Server) ListBuilderRelays(_ context.Context, _ *empty.Empty) (*ethpb.BuilderRelays, error) {
+	if ns.RelayStatusFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Builder service is not available")
+	}
+	return &ethpb.BuilderRelays{
+		Relays: ns.RelayStatusFetcher.RelayStatuses(ns.GenesisTimeFetcher.CurrentSlot()),
+	}, nil
+}
+
 // StreamBeaconLogs from the beacon node via a gRPC server-side stream.
 // DEPRECATED: This endpoint doesn't appear to be used and have been marked for deprecation.
 func (ns *Server) StreamBeaconLogs(_ *empty.Empty, stream ethpb.Health_StreamBeaconLogsServer) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	mockBuilder "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder/testing"
 	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
@@ -170,3 +171,18 @@ func TestNodeServer_GetETH1ConnectionStatus(t *testing.T) {
 	assert.Equal(t, ep, res.CurrentAddress)
 	assert.Equal(t, errStr, res.CurrentConnectionError)
 }
+
+func TestNodeServer_ListBuilderRelays(t *testing.T) {
+	ns := &Server{GenesisTimeFetcher: &mock.ChainService{}}
+	_, err := ns.ListBuilderRelays(context.Background(), &emptypb.Empty{})
+	require.ErrorContains(t, "not available", err)
+
+	relays := []*ethpb.BuilderRelay{
+		{Relay: "good.relay:443"},
+		{Relay: "bad.relay:443", Quarantined: true, ReleaseEpoch: 3, Quarantines: 1, FailedUnblinds: 1},
+	}
+	ns.RelayStatusFetcher = &mockBuilder.MockBuilderService{RelayStatusList: relays}
+	res, err := ns.ListBuilderRelays(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, relays, res.Relays)
+}
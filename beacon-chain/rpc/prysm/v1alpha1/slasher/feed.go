@@ -0,0 +1,102 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Number of items queued for each stream. Items received while the queue of a stream is full are
+// dropped, so that a slow slasher cannot hold up the sends to the feeds.
+const feedStreamBufferSize = 1024
+
+var droppedFeedItems = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "slasher_feed_dropped_items_total",
+	Help: "The number of items dropped because a slasher feed stream was not keeping up",
+}, []string{"feed"})
+
+// FeedServer defines a server implementation of the gRPC slasher feed service,
+// which streams the attestations and block headers received by the beacon node
+// to standalone slashers.
+type FeedServer struct {
+	Ctx                     context.Context
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+}
+
+// StreamSlasherAttestations streams the indexed attestations the beacon node feeds to slasher.
+func (s *FeedServer) StreamSlasherAttestations(
+	_ *emptypb.Empty, stream ethpb.SlasherFeed_StreamSlasherAttestationsServer,
+) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	attsChannel := make(chan *ethpb.IndexedAttestation, 1)
+	attsSub := s.IndexedAttestationsFeed.Subscribe(attsChannel)
+	defer attsSub.Unsubscribe()
+	queue := make(chan *ethpb.IndexedAttestation, feedStreamBufferSize)
+	go relayFeed(ctx, attsChannel, queue, droppedFeedItems.WithLabelValues("attestations"))
+	for {
+		select {
+		case att := <-queue:
+			if err := stream.Send(att); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-attsSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// StreamSlasherBlockHeaders streams the signed block headers the beacon node feeds to slasher.
+func (s *FeedServer) StreamSlasherBlockHeaders(
+	_ *emptypb.Empty, stream ethpb.SlasherFeed_StreamSlasherBlockHeadersServer,
+) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	headersChannel := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	headersSub := s.BeaconBlockHeadersFeed.Subscribe(headersChannel)
+	defer headersSub.Unsubscribe()
+	queue := make(chan *ethpb.SignedBeaconBlockHeader, feedStreamBufferSize)
+	go relayFeed(ctx, headersChannel, queue, droppedFeedItems.WithLabelValues("block_headers"))
+	for {
+		select {
+		case header := <-queue:
+			if err := stream.Send(header); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-headersSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// relayFeed moves the items received from a feed subscription to the queue of a stream, until the context
+// is done. The queue is never waited on, so that a stream which is stuck on a send does not block the feed.
+func relayFeed[T any](ctx context.Context, items <-chan T, queue chan<- T, dropped prometheus.Counter) {
+	for {
+		select {
+		case item := <-items:
+			select {
+			case queue <- item:
+			default:
+				dropped.Inc()
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
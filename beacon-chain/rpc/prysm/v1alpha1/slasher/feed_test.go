@@ -0,0 +1,131 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type attestationsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.IndexedAttestation
+}
+
+func (s *attestationsStream) Context() context.Context { return s.ctx }
+
+func (s *attestationsStream) Send(att *ethpb.IndexedAttestation) error {
+	s.sent <- att
+	return nil
+}
+
+type blockHeadersStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.SignedBeaconBlockHeader
+}
+
+func (s *blockHeadersStream) Context() context.Context { return s.ctx }
+
+func (s *blockHeadersStream) Send(header *ethpb.SignedBeaconBlockHeader) error {
+	s.sent <- header
+	return nil
+}
+
+func TestFeedServer_StreamSlasherAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attsFeed := new(event.Feed)
+	s := &FeedServer{Ctx: context.Background(), IndexedAttestationsFeed: attsFeed}
+	stream := &attestationsStream{ctx: ctx, sent: make(chan *ethpb.IndexedAttestation, 1)}
+	exited := make(chan error)
+	go func() {
+		exited <- s.StreamSlasherAttestations(&emptypb.Empty{}, stream)
+	}()
+
+	want := &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}}
+	for attsFeed.Send(want) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.DeepEqual(t, want, <-stream.sent)
+	cancel()
+	require.ErrorContains(t, "Context canceled", <-exited)
+}
+
+func TestFeedServer_StreamSlasherBlockHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	headersFeed := new(event.Feed)
+	s := &FeedServer{Ctx: context.Background(), BeaconBlockHeadersFeed: headersFeed}
+	stream := &blockHeadersStream{ctx: ctx, sent: make(chan *ethpb.SignedBeaconBlockHeader, 1)}
+	exited := make(chan error)
+	go func() {
+		exited <- s.StreamSlasherBlockHeaders(&emptypb.Empty{}, stream)
+	}()
+
+	want := &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 3, ProposerIndex: 4}}
+	for headersFeed.Send(want) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.DeepEqual(t, want, <-stream.sent)
+	cancel()
+	require.ErrorContains(t, "Context canceled", <-exited)
+}
+
+// stalledAttestationsStream is a stream whose sends never complete, like a slasher which stopped reading.
+type stalledAttestationsStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stalledAttestationsStream) Context() context.Context { return s.ctx }
+
+func (s *stalledAttestationsStream) Send(_ *ethpb.IndexedAttestation) error {
+	<-s.ctx.Done()
+	return s.ctx.Err()
+}
+
+func TestFeedServer_StreamSlasherAttestations_StalledStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attsFeed := new(event.Feed)
+	s := &FeedServer{Ctx: context.Background(), IndexedAttestationsFeed: attsFeed}
+	exited := make(chan error)
+	go func() {
+		exited <- s.StreamSlasherAttestations(&emptypb.Empty{}, &stalledAttestationsStream{ctx: ctx})
+	}()
+
+	dropped := droppedFeedItems.WithLabelValues("attestations")
+	droppedBefore := counterValue(t, dropped)
+	att := &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}
+	for attsFeed.Send(att) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// The feed keeps accepting items once the queue of the stream is full.
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*feedStreamBufferSize; i++ {
+			attsFeed.Send(att)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(10 * time.Second):
+		t.Fatal("feed sends blocked by a stalled stream")
+	}
+	require.Equal(t, true, counterValue(t, dropped) > droppedBefore)
+	cancel()
+	require.NotNil(t, <-exited)
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	require.NoError(t, c.Write(m))
+	return m.GetCounter().GetValue()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/mock"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestServer_IsSlashableAttestation_SlashingFound(t *testing.T) {
@@ -52,3 +53,18 @@ func TestServer_IsSlashableBlock_SlashingNotFound(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, true, len(slashing.ProposerSlashings) == 0)
 }
+
+func TestServer_SlasherStatus(t *testing.T) {
+	want := &ethpb.SlasherStatusResponse{
+		Buckets: []*ethpb.SlasherBucketStats{
+			{Name: "slasher-chunks", NumKeys: 2, Size: 4096},
+		},
+		DatabaseSize:         8192,
+		LastProcessedEpoch:   10,
+		AttestationQueueSize: 3,
+	}
+	s := Server{SlashingChecker: &mock.MockSlashingChecker{Status: want}}
+	resp, err := s.SlasherStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, want, resp)
+}
@@ -0,0 +1,20 @@
+package slasher
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SlasherStatus returns the size of the slasher database per bucket, the last epoch
+// processed by slasher, the depth of its queues and its detection latency.
+func (s *Server) SlasherStatus(ctx context.Context, _ *emptypb.Empty) (*ethpb.SlasherStatusResponse, error) {
+	resp, err := s.SlashingChecker.SlasherStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get slasher status: %v", err)
+	}
+	return resp, nil
+}
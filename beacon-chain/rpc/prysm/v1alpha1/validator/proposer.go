@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
@@ -176,6 +177,19 @@ func (vs *Server) PrepareBeaconProposer(
 	var feeRecipients []common.Address
 	var validatorIndices []primitives.ValidatorIndex
 
+	// Builder preferences are refreshed on every request, as the validator client resends them each epoch.
+	if vs.BlockBuilder != nil && vs.BlockBuilder.Configured() {
+		idxs := make([]primitives.ValidatorIndex, len(request.Recipients))
+		prefs := make([]*builder.ValidatorPreferences, len(request.Recipients))
+		for i, r := range request.Recipients {
+			idxs[i] = r.ValidatorIndex
+			prefs[i] = &builder.ValidatorPreferences{Relays: r.Relays, MinBid: r.MinBid}
+		}
+		if err := vs.BlockBuilder.SetPreferences(ctx, idxs, prefs); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save builder preferences: %v", err)
+		}
+	}
+
 	newRecipients := make([]*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer, 0, len(request.Recipients))
 	for _, r := range request.Recipients {
 		f, err := vs.BeaconDB.FeeRecipientByValidatorID(ctx, r.ValidatorIndex)
@@ -285,6 +299,10 @@ func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.
 	if err := vs.P2P.Broadcast(ctx, blkPb); err != nil {
 		return nil, fmt.Errorf("could not broadcast block: %v", err)
 	}
+	vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.BlockGossipReceived,
+		Data: &opfeed.BlockGossipReceivedData{SignedBlock: blk},
+	})
 	log.WithFields(logrus.Fields{
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
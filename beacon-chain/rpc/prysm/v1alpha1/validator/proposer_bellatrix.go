@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -32,6 +32,21 @@ var builderGetPayloadMissCount = promauto.NewCounter(prometheus.CounterOpts{
 	Help: "The number of get payload misses for validator requests to builder",
 })
 
+// builderPayloadDecisionCount tracks the payload source chosen for a proposal when the builder is used, by reason.
+var builderPayloadDecisionCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "builder_payload_decision_total",
+	Help: "The number of proposals choosing between a builder and local payload, by reason of the decision",
+}, []string{"reason"})
+
+const (
+	decisionBuilder             = "builder"
+	decisionLocalHigherValue    = "local_higher_value"
+	decisionWithdrawalsMismatch = "withdrawals_mismatch"
+	decisionBelowMinBid         = "below_min_bid"
+)
+
+var errBelowMinBid = errors.New("builder bid is below the minimum bid")
+
 // blockBuilderTimeout is the maximum amount of time allowed for a block builder to respond to a
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
 const blockBuilderTimeout = 1 * time.Second
@@ -49,7 +64,10 @@ func (vs *Server) setExecutionData(ctx context.Context, blk interfaces.SignedBea
 		log.WithError(err).Warn("Proposer: failed to check if builder can be used")
 	} else if canUseBuilder {
 		builderPayload, err := vs.getPayloadHeaderFromBuilder(ctx, slot, idx)
-		if err != nil {
+		if errors.Is(err, errBelowMinBid) {
+			builderPayloadDecisionCount.WithLabelValues(decisionBelowMinBid).Inc()
+			log.WithError(err).Info("Proposer: using local execution payload")
+		} else if err != nil {
 			builderGetPayloadMissCount.Inc()
 			log.WithError(err).Warn("Proposer: failed to get payload header from builder")
 		} else {
@@ -67,25 +85,38 @@ func (vs *Server) setExecutionData(ctx context.Context, blk interfaces.SignedBea
 				builderValue, err := builderPayload.Value()
 				if err != nil {
 					log.WithError(err).Warn("Proposer: failed to get builder payload value") // Default to local if can't get builder value.
+					builderValue = big.NewInt(0)
 				}
 
 				withdrawalsMatched, err := matchingWithdrawalsRoot(localPayload, builderPayload)
 				if err != nil {
 					return errors.Wrap(err, "failed to match withdrawals root")
 				}
-				// If we can't get the builder value, just use local block.
-				if builderValue.Cmp(localValue) > 0 && withdrawalsMatched { // Builder value is higher and withdrawals match.
+				boostedValue := boostedBuilderValue(builderValue)
+				fields := logrus.Fields{
+					"localValue":         localValue,
+					"builderValue":       builderValue,
+					"builderBoostFactor": params.BeaconConfig().BuilderBoostFactor,
+				}
+				switch {
+				case !withdrawalsMatched:
+					builderPayloadDecisionCount.WithLabelValues(decisionWithdrawalsMismatch).Inc()
+					vs.BlockBuilder.ReportFault(slot, bytesutil.ToBytes32(builderPayload.BlockHash()), builder.FaultWithdrawalsMismatch)
+					log.WithFields(fields).Warn("Proposer: using local execution payload because builder withdrawals do not match")
+				case boostedValue.Cmp(localValue) <= 0:
+					builderPayloadDecisionCount.WithLabelValues(decisionLocalHigherValue).Inc()
+					log.WithFields(fields).Warn("Proposer: using local execution payload because higher value")
+				default: // Boosted builder value is higher and withdrawals match.
 					blk.SetBlinded(true)
 					if err := blk.SetExecution(builderPayload); err != nil {
 						log.WithError(err).Warn("Proposer: failed to set builder payload")
 					} else {
+						builderPayloadDecisionCount.WithLabelValues(decisionBuilder).Inc()
+						log.WithFields(fields).Info("Proposer: using builder payload")
 						return nil
 					}
 				}
-				log.WithFields(logrus.Fields{
-					"localValue":   localValue,
-					"builderValue": builderValue,
-				}).Warn("Proposer: using local execution payload because higher value")
+				blk.SetBlinded(false)
 				return blk.SetExecution(localPayload)
 			default: // Bellatrix case.
 				blk.SetBlinded(true)
@@ -127,9 +158,16 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
+	// With bid timing, the builder service polls the relays until its own cutoff, and gives up by its deadline.
+	var cancel context.CancelFunc
+	if deadline, ok := vs.BlockBuilder.HeaderDeadline(slot); ok {
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, blockBuilderTimeout)
+	}
 	defer cancel()
 
+	// The builder service only returns bids carrying a valid builder signature.
 	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
 	if err != nil {
 		return nil, err
@@ -149,6 +187,9 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 	if v.String() == "0" {
 		return nil, errors.New("builder returned header with 0 bid amount")
 	}
+	if minBid := vs.minBuilderBid(idx); v.Cmp(minBid) < 0 {
+		return nil, errors.Wrapf(errBelowMinBid, "bid value %s wei, minimum %s wei", v, minBid)
+	}
 
 	emptyRoot, err := ssz.TransactionsRoot([][]byte{})
 	if err != nil {
@@ -178,10 +219,6 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 		return nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
 	}
 
-	if err := validateBuilderSignature(signedBid); err != nil {
-		return nil, errors.Wrap(err, "could not validate builder signature")
-	}
-
 	log.WithFields(logrus.Fields{
 		"value":         v.String(),
 		"builderPubKey": fmt.Sprintf("%#x", bid.Pubkey()),
@@ -190,6 +227,23 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 	return header, nil
 }
 
+// minBuilderBid returns the minimum builder bid value in wei accepted for the proposer. A minimum set by the
+// validator through prepare beacon proposer takes precedence over the beacon node minimum.
+func (vs *Server) minBuilderBid(idx primitives.ValidatorIndex) *big.Int {
+	gwei := params.BeaconConfig().MinBuilderBid
+	if prefs := vs.BlockBuilder.Preferences(idx); prefs != nil && prefs.MinBid != 0 {
+		gwei = prefs.MinBid
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(1e9))
+}
+
+// boostedBuilderValue scales the builder value by the builder boost factor percentage, so it can be compared
+// against the local payload value.
+func boostedBuilderValue(v *big.Int) *big.Int {
+	boosted := new(big.Int).Mul(v, new(big.Int).SetUint64(params.BeaconConfig().BuilderBoostFactor))
+	return boosted.Div(boosted, big.NewInt(100))
+}
+
 // This function retrieves the full payload block using the input blind block. This input must be versioned as
 // bellatrix blind block. The output block will contain the full payload. The original header block
 // will be returned the block builder is not configured.
@@ -313,27 +367,6 @@ func (vs *Server) unblindBuilderBlock(ctx context.Context, b interfaces.ReadOnly
 	return wb, nil
 }
 
-// Validates builder signature and returns an error if the signature is invalid.
-func validateBuilderSignature(signedBid builder.SignedBid) error {
-	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
-		nil, /* fork version */
-		nil /* genesis val root */)
-	if err != nil {
-		return err
-	}
-	if signedBid.IsNil() {
-		return errors.New("nil builder bid")
-	}
-	bid, err := signedBid.Message()
-	if err != nil {
-		return errors.Wrap(err, "could not get bid")
-	}
-	if bid.IsNil() {
-		return errors.New("builder returned nil bid")
-	}
-	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
-}
-
 func matchingWithdrawalsRoot(local, builder interfaces.ExecutionData) (bool, error) {
 	wds, err := local.Withdrawals()
 	if err != nil {
@@ -8,8 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	blockchainTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	builderTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
@@ -179,6 +179,53 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
 	})
+	t.Run("Builder configured. Builder bid below minimum bid. Use local block", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		cfg := params.BeaconConfig().Copy()
+		cfg.MinBuilderBid = 1 // 1 gwei, the builder bids 1 wei.
+		params.OverrideBeaconConfig(cfg)
+		require.NoError(t, vs.setExecutionData(context.Background(), blk, capellaTransitionState))
+		cfg.MinBuilderBid = 0
+		params.OverrideBeaconConfig(cfg)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), e.BlockNumber()) // Local block
+	})
+	t.Run("Builder configured. Builder bid below validator minimum bid. Use local block", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		bb, ok := vs.BlockBuilder.(*builderTest.MockBuilderService)
+		require.Equal(t, true, ok)
+		idxs := []primitives.ValidatorIndex{blk.Block().ProposerIndex()}
+		require.NoError(t, bb.SetPreferences(ctx, idxs, []*builder.ValidatorPreferences{{MinBid: 1}}))
+		require.NoError(t, vs.setExecutionData(context.Background(), blk, capellaTransitionState))
+		require.NoError(t, bb.SetPreferences(ctx, idxs, []*builder.ValidatorPreferences{nil}))
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), e.BlockNumber()) // Local block
+	})
+	t.Run("Builder configured. Builder boost factor favors builder block", func(t *testing.T) {
+		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: withdrawals}, BlockValue: big.NewInt(1)}
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		require.NoError(t, vs.setExecutionData(context.Background(), blk, capellaTransitionState))
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), e.BlockNumber()) // Local block because values are equal
+
+		cfg := params.BeaconConfig().Copy()
+		cfg.BuilderBoostFactor = 200
+		params.OverrideBeaconConfig(cfg)
+		blk, err = blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		require.NoError(t, vs.setExecutionData(context.Background(), blk, capellaTransitionState))
+		cfg.BuilderBoostFactor = 100
+		params.OverrideBeaconConfig(cfg)
+		e, err = blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
+	})
 	t.Run("Builder configured. Local block has higher value", func(t *testing.T) {
 		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
 		require.NoError(t, err)
@@ -500,44 +547,6 @@ func TestServer_getBuilderBlock(t *testing.T) {
 	}
 }
 
-func TestServer_validateBuilderSignature(t *testing.T) {
-	sk, err := bls.RandKey()
-	require.NoError(t, err)
-	bid := &ethpb.BuilderBid{
-		Header: &v1.ExecutionPayloadHeader{
-			ParentHash:       make([]byte, fieldparams.RootLength),
-			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-			StateRoot:        make([]byte, fieldparams.RootLength),
-			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-			PrevRandao:       make([]byte, fieldparams.RootLength),
-			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-			BlockHash:        make([]byte, fieldparams.RootLength),
-			TransactionsRoot: make([]byte, fieldparams.RootLength),
-			BlockNumber:      1,
-		},
-		Pubkey: sk.PublicKey().Marshal(),
-		Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
-	}
-	d := params.BeaconConfig().DomainApplicationBuilder
-	domain, err := signing.ComputeDomain(d, nil, nil)
-	require.NoError(t, err)
-	sr, err := signing.ComputeSigningRoot(bid, domain)
-	require.NoError(t, err)
-	pbBid := &ethpb.SignedBuilderBid{
-		Message:   bid,
-		Signature: sk.Sign(sr[:]).Marshal(),
-	}
-	sBid, err := builder.WrappedSignedBuilderBid(pbBid)
-	require.NoError(t, err)
-	require.NoError(t, validateBuilderSignature(sBid))
-
-	pbBid.Message.Value = make([]byte, 32)
-	sBid, err = builder.WrappedSignedBuilderBid(pbBid)
-	require.NoError(t, err)
-	require.ErrorIs(t, validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
-}
-
 func Test_matchingWithdrawalsRoot(t *testing.T) {
 	t.Run("could not get local withdrawals", func(t *testing.T) {
 		local := &v1.ExecutionPayload{}
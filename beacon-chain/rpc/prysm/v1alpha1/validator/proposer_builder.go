@@ -45,6 +45,12 @@ func (vs *Server) circuitBreakBuilder(s primitives.Slot) (bool, error) {
 		return true, errors.New("no fork choicer configured")
 	}
 
+	// Circuit breaker is active if every relay is quarantined for faults.
+	if vs.BlockBuilder != nil && vs.BlockBuilder.AllRelaysQuarantined(s) {
+		log.WithField("currentSlot", s).Warn("Circuit breaker activated due to every relay being quarantined for faults")
+		return true, nil
+	}
+
 	// Circuit breaker is active if the missing consecutive slots greater than `MaxBuilderConsecutiveMissedSlots`.
 	highestReceivedSlot := vs.ForkchoiceFetcher.HighestReceivedBlockSlot()
 	maxConsecutiveSkipSlotsAllowed := params.BeaconConfig().MaxBuilderConsecutiveMissedSlots
@@ -575,6 +575,7 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				BlockReceiver:     c,
 				HeadFetcher:       c,
 				BlockNotifier:     c.BlockNotifier(),
+				OperationNotifier: c.OperationNotifier(),
 				P2P:               mockp2p.NewTestP2P(t),
 				BlockBuilder:      &builderTest.MockBuilderService{HasConfigured: true, PayloadCapella: emptyPayloadCapella()},
 			}
@@ -2478,6 +2479,28 @@ func TestProposer_PrepareBeaconProposer(t *testing.T) {
 	}
 }
 
+func TestProposer_PrepareBeaconProposer_BuilderPreferences(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	bb := &builderTest.MockBuilderService{HasConfigured: true}
+	proposerServer := &Server{BeaconDB: db, BlockBuilder: bb}
+	req := &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: make([]byte, fieldparams.FeeRecipientLength), ValidatorIndex: 1, Relays: []string{"https://relay.example"}, MinBid: 10},
+		},
+	}
+	_, err := proposerServer.PrepareBeaconProposer(ctx, req)
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{"https://relay.example"}, bb.ValidatorPreferences[1].Relays)
+	require.Equal(t, uint64(10), bb.ValidatorPreferences[1].MinBid)
+
+	// Preferences are updated even when the fee recipient is unchanged.
+	req.Recipients[0].Relays = []string{"https://other.example"}
+	_, err = proposerServer.PrepareBeaconProposer(ctx, req)
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{"https://other.example"}, bb.ValidatorPreferences[1].Relays)
+}
+
 func TestProposer_PrepareBeaconProposerOverlapping(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := dbutil.SetupDB(t)
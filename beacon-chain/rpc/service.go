@@ -6,13 +6,16 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
@@ -36,6 +39,7 @@ import (
 	beaconv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/beacon"
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/node"
+	slasherv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/statefetcher"
 	slasherservice "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
@@ -68,6 +72,7 @@ type Service struct {
 	credentialError      error
 	connectedRPCClients  map[net.Addr]bool
 	clientConnectionLock sync.Mutex
+	eventsServer         *events.Server
 }
 
 // Config options for the beacon node RPC server.
@@ -99,6 +104,8 @@ type Config struct {
 	ExitPool                      voluntaryexits.PoolManager
 	SlashingsPool                 slashings.PoolManager
 	SlashingChecker               slasherservice.SlashingChecker
+	SlasherAttestationsFeed       *event.Feed
+	SlasherBlockHeadersFeed       *event.Feed
 	SyncCommitteeObjectPool       synccommittee.Pool
 	BLSChangesPool                blstoexec.PoolManager
 	SyncService                   chainSync.Checker
@@ -117,6 +124,7 @@ type Config struct {
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
+	Router                        *mux.Router
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -172,6 +180,20 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
+	s.eventsServer = &events.Server{
+		Ctx:               s.ctx,
+		StateNotifier:     s.cfg.StateNotifier,
+		BlockNotifier:     s.cfg.BlockNotifier,
+		OperationNotifier: s.cfg.OperationNotifier,
+		HeadFetcher:       s.cfg.HeadFetcher,
+		ChainInfoFetcher:  s.cfg.ChainInfoFetcher,
+	}
+	if s.cfg.Router != nil {
+		// Registered when the service is created, before any service is started, so that events are served
+		// natively rather than by the routes the gateway adds to the same router when it starts.
+		s.cfg.Router.HandleFunc("/eth/v1/events", s.eventsServer.StreamEventsHTTP).Methods(http.MethodGet)
+	}
+
 	return s
 }
 
@@ -258,6 +280,7 @@ func (s *Service) Start() {
 		PeerManager:          s.cfg.PeerManager,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		POWChainInfoFetcher:  s.cfg.ExecutionChainInfoFetcher,
+		RelayStatusFetcher:   s.cfg.BlockBuilder,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
 	}
@@ -330,14 +353,7 @@ func (s *Service) Start() {
 	ethpbv1alpha1.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpbv1alpha1.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbservice.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
-	ethpbservice.RegisterEventsServer(s.grpcServer, &events.Server{
-		Ctx:               s.ctx,
-		StateNotifier:     s.cfg.StateNotifier,
-		BlockNotifier:     s.cfg.BlockNotifier,
-		OperationNotifier: s.cfg.OperationNotifier,
-		HeadFetcher:       s.cfg.HeadFetcher,
-		ChainInfoFetcher:  s.cfg.ChainInfoFetcher,
-	})
+	ethpbservice.RegisterEventsServer(s.grpcServer, s.eventsServer)
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
@@ -348,6 +364,7 @@ func (s *Service) Start() {
 			PeerManager:        s.cfg.PeerManager,
 			PeersFetcher:       s.cfg.PeersFetcher,
 			ReplayerBuilder:    ch,
+			ForkchoiceFetcher:  s.cfg.ForkchoiceFetcher,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -370,6 +387,18 @@ func (s *Service) Start() {
 	}
 	ethpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 	ethpbservice.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
+	if features.Get().EnableSlasher {
+		ethpbv1alpha1.RegisterSlasherServer(s.grpcServer, &slasherv1alpha1.Server{
+			SlashingChecker: s.cfg.SlashingChecker,
+		})
+	}
+	if features.Get().EnableSlasherFeed {
+		ethpbv1alpha1.RegisterSlasherFeedServer(s.grpcServer, &slasherv1alpha1.FeedServer{
+			Ctx:                     s.ctx,
+			IndexedAttestationsFeed: s.cfg.SlasherAttestationsFeed,
+			BeaconBlockHeadersFeed:  s.cfg.SlasherBlockHeadersFeed,
+		})
+	}
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gorilla/mux"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
 	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
@@ -83,3 +86,26 @@ func TestRPC_InsecureEndpoint(t *testing.T) {
 	require.LogsContain(t, hook, "You are using an insecure gRPC server")
 	assert.NoError(t, rpcService.Stop())
 }
+
+func TestNewService_RegistersEventsRoute(t *testing.T) {
+	chainService := &mock.ChainService{Genesis: time.Now()}
+	router := mux.NewRouter()
+	rpcService := NewService(context.Background(), &Config{
+		Port:               "7778",
+		GenesisTimeFetcher: chainService,
+		HeadFetcher:        chainService,
+		StateNotifier:      chainService.StateNotifier(),
+		Router:             router,
+	})
+	defer func() {
+		assert.NoError(t, rpcService.listener.Close())
+	}()
+
+	// The route is registered before the service, or the gateway sharing the router, is started.
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics=head", nil)
+	var match mux.RouteMatch
+	require.Equal(t, true, router.Match(req, &match))
+	tpl, err := match.Route.GetPathTemplate()
+	require.NoError(t, err)
+	require.Equal(t, "/eth/v1/events", tpl)
+}
@@ -18,6 +18,9 @@ import (
 func (s *Service) checkSlashableAttestations(
 	ctx context.Context, currentEpoch primitives.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
 ) ([]*ethpb.AttesterSlashing, error) {
+	// Stale chunks must not be pruned while they are being updated.
+	s.chunksLock.Lock()
+	defer s.chunksLock.Unlock()
 	slashings := make([]*ethpb.AttesterSlashing, 0)
 
 	log.Debug("Checking for double votes")
@@ -44,9 +47,11 @@ func (s *Service) checkSlashableAttestations(
 		}
 		slashings = append(slashings, attSlashings...)
 		indices := s.params.validatorIndicesInChunk(validatorChunkIdx)
+		s.latestEpochWrittenLock.Lock()
 		for _, idx := range indices {
 			s.latestEpochWrittenForValidator[idx] = currentEpoch
 		}
+		s.latestEpochWrittenLock.Unlock()
 		batchTimes = append(batchTimes, time.Since(innerStart))
 	}
 	var avgProcessingTimePerBatch time.Duration
@@ -211,7 +216,9 @@ func (s *Service) epochUpdateForValidator(
 	updatedChunks map[uint64]Chunker,
 	validatorIndex primitives.ValidatorIndex,
 ) error {
+	s.latestEpochWrittenLock.RLock()
 	epoch := s.latestEpochWrittenForValidator[validatorIndex]
+	s.latestEpochWrittenLock.RUnlock()
 	if epoch == 0 {
 		return nil
 	}
@@ -33,6 +33,10 @@ var (
 		Name: "slasher_blocks_received_total",
 		Help: "Total number of blocks received by slasher",
 	})
+	replayedEpochsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_replayed_epochs_total",
+		Help: "Total number of epochs of history replayed by slasher from the beacon database",
+	})
 	processedBlocksTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "slasher_blocks_processed_total",
 		Help: "Total number of blocks successfully processed by slasher",
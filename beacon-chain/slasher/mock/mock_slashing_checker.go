@@ -12,6 +12,7 @@ type MockSlashingChecker struct {
 	AttesterSlashingFound bool
 	ProposerSlashingFound bool
 	HighestAtts           map[primitives.ValidatorIndex]*ethpb.HighestAttestation
+	Status                *ethpb.SlasherStatusResponse
 }
 
 func (s *MockSlashingChecker) HighestAttestations(
@@ -71,3 +72,7 @@ func (s *MockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb
 	}
 	return nil, nil
 }
+
+func (s *MockSlashingChecker) SlasherStatus(_ context.Context) (*ethpb.SlasherStatusResponse, error) {
+	return s.Status, nil
+}
@@ -12,6 +12,10 @@ import (
 // Verifies attester slashings, logs them, and submits them to the slashing operations pool
 // in the beacon node if they pass validation.
 func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		s.submitAttesterSlashings(ctx, slashings)
+		return nil
+	}
 	var beaconState state.BeaconState
 	var err error
 	if len(slashings) > 0 {
@@ -48,6 +52,10 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 // Verifies proposer slashings, logs them, and submits them to the slashing operations pool
 // in the beacon node if they pass validation.
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		s.submitProposerSlashings(ctx, slashings)
+		return nil
+	}
 	var beaconState state.BeaconState
 	var err error
 	if len(slashings) > 0 {
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 )
 
 // Struct for handling a thread-safe list of indexed attestation wrappers.
@@ -56,6 +57,21 @@ func (q *attestationsQueue) extend(atts []*slashertypes.IndexedAttestationWrappe
 	q.items = append(q.items, atts...)
 }
 
+// Drops the attestations with a target epoch before the given epoch, and returns how many were dropped.
+func (q *attestationsQueue) dropBefore(epoch primitives.Epoch) int {
+	q.Lock()
+	defer q.Unlock()
+	kept := make([]*slashertypes.IndexedAttestationWrapper, 0, len(q.items))
+	for _, att := range q.items {
+		if att.IndexedAttestation.Data.Target.Epoch >= epoch {
+			kept = append(kept, att)
+		}
+	}
+	numDropped := len(q.items) - len(kept)
+	q.items = kept
+	return numDropped
+}
+
 func (q *blocksQueue) push(blk *slashertypes.SignedBlockHeaderWrapper) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
@@ -81,3 +97,18 @@ func (q *blocksQueue) extend(blks []*slashertypes.SignedBlockHeaderWrapper) {
 	defer q.lock.Unlock()
 	q.items = append(q.items, blks...)
 }
+
+// Drops the blocks with a slot before the given slot, and returns how many were dropped.
+func (q *blocksQueue) dropBefore(slot primitives.Slot) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	kept := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(q.items))
+	for _, blk := range q.items {
+		if blk.SignedBeaconBlockHeader.Header.Slot >= slot {
+			kept = append(kept, blk)
+		}
+	}
+	numDropped := len(q.items) - len(kept)
+	q.items = kept
+	return numDropped
+}
@@ -37,6 +37,19 @@ func Test_attestationsQueue(t *testing.T) {
 		require.DeepEqual(t, 0, attQueue.size())
 		require.DeepEqual(t, wantedAtts, received)
 	})
+
+	t.Run("drop_before", func(tt *testing.T) {
+		attQueue := newAttestationsQueue()
+		wantedAtts := []*slashertypes.IndexedAttestationWrapper{
+			createAttestationWrapper(t, 0, 1, []uint64{1}, make([]byte, 32)),
+			createAttestationWrapper(t, 1, 2, []uint64{1}, make([]byte, 32)),
+		}
+		attQueue.extend(wantedAtts)
+		require.Equal(t, 1, attQueue.dropBefore(2))
+
+		received := attQueue.dequeue()
+		require.DeepEqual(t, wantedAtts[1:], received)
+	})
 }
 
 func Test_blocksQueue(t *testing.T) {
@@ -68,4 +81,17 @@ func Test_blocksQueue(t *testing.T) {
 		require.DeepEqual(t, 0, blkQueue.size())
 		require.DeepEqual(t, wantedBlks, received)
 	})
+
+	t.Run("drop_before", func(tt *testing.T) {
+		blkQueue := newBlocksQueue()
+		wantedBlks := []*slashertypes.SignedBlockHeaderWrapper{
+			createProposalWrapper(t, 0, primitives.ValidatorIndex(1), make([]byte, 32)),
+			createProposalWrapper(t, 1, primitives.ValidatorIndex(1), make([]byte, 32)),
+		}
+		blkQueue.extend(wantedBlks)
+		require.Equal(t, 1, blkQueue.dropBefore(1))
+
+		received := blkQueue.dequeue()
+		require.DeepEqual(t, wantedBlks[1:], received)
+	})
 }
@@ -100,6 +100,7 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 				"numDroppedAtts":  numDropped,
 			}).Info("Processing queued attestations for slashing detection")
 
+			start := time.Now()
 			// Save the attestation records to our database.
 			if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(
 				ctx, validAtts,
@@ -122,6 +123,11 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 				continue
 			}
 
+			s.statusLock.Lock()
+			s.lastProcessedEpoch = currentEpoch
+			s.attsDetectionLatency = time.Since(start)
+			s.statusLock.Unlock()
+
 			processedAttestationsTotal.Add(float64(len(validAtts)))
 		case <-ctx.Done():
 			return
@@ -161,7 +167,12 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan pri
 				continue
 			}
 
-			log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable blocks")
+			elapsed := time.Since(start)
+			s.statusLock.Lock()
+			s.blocksDetectionLatency = elapsed
+			s.statusLock.Unlock()
+
+			log.WithField("elapsed", elapsed).Debug("Done checking slashable blocks")
 
 			processedBlocksTotal.Add(float64(len(blocks)))
 		case <-ctx.Done():
@@ -175,7 +186,12 @@ func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan primit
 	for {
 		select {
 		case <-slotTicker:
-			headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
+			headSlot, err := s.headSlot(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not get head slot")
+				continue
+			}
+			headEpoch := slots.ToEpoch(headSlot)
 			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, headEpoch); err != nil {
 				log.WithError(err).Error("Could not prune slasher data")
 				continue
@@ -203,7 +219,7 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	log.WithFields(logrus.Fields{
 		"currentEpoch":          currentEpoch,
 		"pruningAllBeforeEpoch": maxPruningEpoch,
-	}).Info("Pruning old attestations and proposals for slasher")
+	}).Info("Pruning old attestations, proposals and chunks for slasher")
 	numPrunedAtts, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(
 		ctx, maxPruningEpoch,
 	)
@@ -216,6 +232,10 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if err != nil {
 		return errors.Wrap(err, "Could not prune proposals")
 	}
+	numPrunedChunks, err := s.pruneStaleChunks(ctx, maxPruningEpoch)
+	if err != nil {
+		return errors.Wrap(err, "Could not prune chunks")
+	}
 	fields := logrus.Fields{}
 	if numPrunedAtts > 0 {
 		fields["numPrunedAtts"] = numPrunedAtts
@@ -223,7 +243,74 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if numPrunedProposals > 0 {
 		fields["numPrunedProposals"] = numPrunedProposals
 	}
+	if numPrunedChunks > 0 {
+		fields["numPrunedChunks"] = numPrunedChunks
+	}
 	fields["elapsed"] = time.Since(start)
-	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
+	log.WithFields(fields).Info("Done pruning old attestations, proposals and chunks for slasher")
 	return nil
 }
+
+// Prunes the min and max span chunks of the validator chunk indices whose validators have not
+// attested after the max pruning epoch. The distances stored in these chunks are only for epochs
+// outside of the sliding window, which would be reset to their neutral element the next time the
+// validators attest anyway, so the chunks can be deleted and their validators considered as having
+// never attested. As validators are tracked by epoch, this is done at most once per epoch.
+func (s *Service) pruneStaleChunks(ctx context.Context, maxPruningEpoch primitives.Epoch) (uint, error) {
+	if maxPruningEpoch < s.nextChunksPruningEpoch {
+		return 0, nil
+	}
+	// Attestations are not processed while pruning, so that the chunks of a validator attesting
+	// after its chunks were deemed stale are not deleted.
+	s.chunksLock.Lock()
+	defer s.chunksLock.Unlock()
+
+	s.latestEpochWrittenLock.RLock()
+	latestEpochByValidatorChunk := make(map[uint64]primitives.Epoch)
+	for validatorIdx, epoch := range s.latestEpochWrittenForValidator {
+		validatorChunkIdx := s.params.validatorChunkIndex(validatorIdx)
+		if latest, ok := latestEpochByValidatorChunk[validatorChunkIdx]; !ok || epoch > latest {
+			latestEpochByValidatorChunk[validatorChunkIdx] = epoch
+		}
+	}
+	s.latestEpochWrittenLock.RUnlock()
+
+	staleValidatorChunks := make([]uint64, 0)
+	for validatorChunkIdx, epoch := range latestEpochByValidatorChunk {
+		if epoch <= maxPruningEpoch {
+			staleValidatorChunks = append(staleValidatorChunks, validatorChunkIdx)
+		}
+	}
+	numChunks := uint64(s.params.historyLength.Div(s.params.chunkSize))
+	chunkKeys := make([][]byte, 0, uint64(len(staleValidatorChunks))*numChunks)
+	staleValidators := make([]primitives.ValidatorIndex, 0)
+	for _, validatorChunkIdx := range staleValidatorChunks {
+		for chunkIdx := uint64(0); chunkIdx < numChunks; chunkIdx++ {
+			chunkKeys = append(chunkKeys, s.params.flatSliceID(validatorChunkIdx, chunkIdx))
+		}
+		staleValidators = append(staleValidators, s.params.validatorIndicesInChunk(validatorChunkIdx)...)
+	}
+	var numPruned uint
+	if len(chunkKeys) > 0 {
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			n, err := s.serviceCfg.Database.PruneSlasherChunks(ctx, kind, chunkKeys)
+			if err != nil {
+				return numPruned, err
+			}
+			numPruned += n
+		}
+		if err := s.serviceCfg.Database.PruneLastEpochsWrittenForValidators(
+			ctx, maxPruningEpoch, staleValidators,
+		); err != nil {
+			return numPruned, err
+		}
+	}
+
+	s.latestEpochWrittenLock.Lock()
+	for _, validatorIdx := range staleValidators {
+		delete(s.latestEpochWrittenForValidator, validatorIdx)
+	}
+	s.latestEpochWrittenLock.Unlock()
+	s.nextChunksPruningEpoch = maxPruningEpoch + 1
+	return numPruned, nil
+}
@@ -201,6 +201,70 @@ func TestService_pruneSlasherDataWithinSlidingWindow_ProposalsPruned(t *testing.
 	}
 }
 
+func TestService_pruneSlasherDataWithinSlidingWindow_ChunksPruned(t *testing.T) {
+	ctx := context.Background()
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		params: params,
+		latestEpochWrittenForValidator: map[primitives.ValidatorIndex]primitives.Epoch{
+			0: 1,
+			1: 1,
+			2: 6,
+			3: 6,
+		},
+	}
+
+	// Store the chunks of validator chunk indices 0 and 1.
+	chunkKeys := make([][][]byte, 2)
+	for validatorChunkIdx := uint64(0); validatorChunkIdx < 2; validatorChunkIdx++ {
+		for chunkIdx := uint64(0); chunkIdx < 2; chunkIdx++ {
+			chunkKeys[validatorChunkIdx] = append(chunkKeys[validatorChunkIdx], params.flatSliceID(validatorChunkIdx, chunkIdx))
+		}
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunks := [][]uint16{{1, 2, 3, 4}, {1, 2, 3, 4}}
+			require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, chunkKeys[validatorChunkIdx], chunks))
+		}
+	}
+
+	require.NoError(t, slasherDB.SaveLastEpochsWrittenForValidators(ctx, s.latestEpochWrittenForValidator))
+
+	// Validators 0 and 1 last attested at epoch 1, which is within the sliding window of epoch 4.
+	require.NoError(t, s.pruneSlasherDataWithinSlidingWindow(ctx, 4))
+	_, exists, err := slasherDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, chunkKeys[0])
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, true}, exists)
+
+	// At epoch 5, the chunks of validators 0 and 1 only hold data from outside of the sliding window.
+	require.NoError(t, s.pruneSlasherDataWithinSlidingWindow(ctx, 5))
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		_, exists, err = slasherDB.LoadSlasherChunks(ctx, kind, chunkKeys[0])
+		require.NoError(t, err)
+		require.DeepEqual(t, []bool{false, false}, exists)
+		_, exists, err = slasherDB.LoadSlasherChunks(ctx, kind, chunkKeys[1])
+		require.NoError(t, err)
+		require.DeepEqual(t, []bool{true, true}, exists)
+	}
+	require.DeepEqual(t, map[primitives.ValidatorIndex]primitives.Epoch{2: 6, 3: 6}, s.latestEpochWrittenForValidator)
+	epochs, err := slasherDB.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{0, 1, 2, 3})
+	require.NoError(t, err)
+	for i, want := range []primitives.Epoch{0, 0, 6, 6} {
+		require.Equal(t, want, epochs[i].Epoch, "Unexpected last epoch written for validator %d", i)
+	}
+
+	// Chunks are pruned at most once per epoch.
+	numPruned, err := s.pruneStaleChunks(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), numPruned)
+}
+
 func TestSlasher_receiveAttestations_OnlyValidAttestations(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	s := &Service{
@@ -0,0 +1,59 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// RemoteBeaconNode is the beacon node a standalone slasher, running in its own process,
+// follows the chain of. The beacon node verifies the slashings submitted to it before
+// inserting them into its slashing operations pool, so slasher does not need states
+// to verify them itself.
+type RemoteBeaconNode interface {
+	HeadSlot(ctx context.Context) (primitives.Slot, error)
+	NumValidators(ctx context.Context) (uint64, error)
+	SubmitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error
+	SubmitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
+}
+
+// Returns the slot of the head of the chain.
+func (s *Service) headSlot(ctx context.Context) (primitives.Slot, error) {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		return s.serviceCfg.RemoteBeaconNode.HeadSlot(ctx)
+	}
+	return s.serviceCfg.HeadStateFetcher.HeadSlot(), nil
+}
+
+// Returns the number of validators in the head state of the chain.
+func (s *Service) numValidators(ctx context.Context) (uint64, error) {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		return s.serviceCfg.RemoteBeaconNode.NumValidators(ctx)
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(headState.NumValidators()), nil
+}
+
+// Logs attester slashings and submits them to the remote beacon node.
+func (s *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	for _, sl := range slashings {
+		logAttesterSlashing(sl)
+		if err := s.serviceCfg.RemoteBeaconNode.SubmitAttesterSlashing(ctx, sl); err != nil {
+			log.WithError(err).Error("Could not submit attester slashing to beacon node")
+		}
+	}
+}
+
+// Logs proposer slashings and submits them to the remote beacon node.
+func (s *Service) submitProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) {
+	for _, sl := range slashings {
+		logProposerSlashing(sl)
+		if err := s.serviceCfg.RemoteBeaconNode.SubmitProposerSlashing(ctx, sl); err != nil {
+			log.WithError(err).Error("Could not submit proposer slashing to beacon node")
+		}
+	}
+}
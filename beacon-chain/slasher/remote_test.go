@@ -0,0 +1,65 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type mockRemoteBeaconNode struct {
+	headSlot          primitives.Slot
+	numValidators     uint64
+	attesterSlashings []*ethpb.AttesterSlashing
+	proposerSlashings []*ethpb.ProposerSlashing
+}
+
+func (m *mockRemoteBeaconNode) HeadSlot(_ context.Context) (primitives.Slot, error) {
+	return m.headSlot, nil
+}
+
+func (m *mockRemoteBeaconNode) NumValidators(_ context.Context) (uint64, error) {
+	return m.numValidators, nil
+}
+
+func (m *mockRemoteBeaconNode) SubmitAttesterSlashing(_ context.Context, slashing *ethpb.AttesterSlashing) error {
+	m.attesterSlashings = append(m.attesterSlashings, slashing)
+	return nil
+}
+
+func (m *mockRemoteBeaconNode) SubmitProposerSlashing(_ context.Context, slashing *ethpb.ProposerSlashing) error {
+	m.proposerSlashings = append(m.proposerSlashings, slashing)
+	return nil
+}
+
+func TestService_RemoteBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	remote := &mockRemoteBeaconNode{headSlot: 65, numValidators: 8}
+	// No chain services are configured, which would make the service panic if it used them.
+	s := &Service{
+		serviceCfg: &ServiceConfig{RemoteBeaconNode: remote},
+	}
+
+	headSlot, err := s.headSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(65), headSlot)
+	numVals, err := s.numValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), numVals)
+
+	attSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(t, 0, 1, []uint64{1}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(t, 0, 1, []uint64{1}, []byte{2}).IndexedAttestation,
+	}
+	require.NoError(t, s.processAttesterSlashings(ctx, []*ethpb.AttesterSlashing{attSlashing}))
+	require.DeepEqual(t, []*ethpb.AttesterSlashing{attSlashing}, remote.attesterSlashings)
+
+	propSlashing := &ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 1, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 1, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	require.NoError(t, s.processProposerSlashings(ctx, []*ethpb.ProposerSlashing{propSlashing}))
+	require.DeepEqual(t, []*ethpb.ProposerSlashing{propSlashing}, remote.proposerSlashings)
+}
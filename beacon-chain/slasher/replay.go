@@ -0,0 +1,297 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// replayQueueWindow is the number of epochs before the current epoch for which the attestations
+// and blocks received while history is replayed are kept queued. Older ones are dropped, so that
+// the queues stay bounded however long the replay takes.
+const replayQueueWindow = primitives.Epoch(2)
+
+// ReplayConfig defines a range of finalized epochs of the history stored in the beacon
+// database, which slasher replays through slashing detection before it starts detecting
+// slashings live.
+type ReplayConfig struct {
+	StartEpoch primitives.Epoch
+	// EndEpoch is the last epoch replayed. It is lowered to the last finalized epoch.
+	EndEpoch primitives.Epoch
+	// ReportPath is the file the slashings found are written to. If empty, they are
+	// submitted to the slashing operations pool of the beacon node instead.
+	ReportPath string
+}
+
+// ReplayReport lists the slashings found when replaying a range of epochs.
+type ReplayReport struct {
+	StartEpoch        primitives.Epoch          `json:"start_epoch"`
+	EndEpoch          primitives.Epoch          `json:"end_epoch"`
+	AttesterSlashings []*ethpb.AttesterSlashing `json:"attester_slashings"`
+	ProposerSlashings []*ethpb.ProposerSlashing `json:"proposer_slashings"`
+}
+
+// Replays the blocks stored in the beacon database over the configured range of finalized
+// epochs, epoch by epoch, as if slasher had received them and the attestations they include
+// at the time. Every stored block is replayed, including the blocks of forks which were not
+// finalized, as they can hold offenses as well. Replaying history older than what slasher
+// already processed would corrupt its min and max spans, so it is refused.
+func (s *Service) replay(ctx context.Context, cfg *ReplayConfig) (*ReplayReport, error) {
+	finalized, err := s.serviceCfg.BeaconDatabase.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	// The blocks of the finalized checkpoint epoch after its first slot are not finalized.
+	if finalized.Epoch == 0 {
+		return nil, errors.New("no finalized epoch to replay")
+	}
+	endEpoch := cfg.EndEpoch
+	if endEpoch >= finalized.Epoch {
+		endEpoch = finalized.Epoch - 1
+	}
+	if cfg.StartEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is after the last finalized epoch to replay %d", cfg.StartEpoch, endEpoch)
+	}
+	s.latestEpochWrittenLock.RLock()
+	for _, epoch := range s.latestEpochWrittenForValidator {
+		if epoch > cfg.StartEpoch {
+			s.latestEpochWrittenLock.RUnlock()
+			return nil, errors.Errorf(
+				"slasher already processed epoch %d, which is after the start epoch %d, replay requires a new slasher database",
+				epoch, cfg.StartEpoch,
+			)
+		}
+	}
+	s.latestEpochWrittenLock.RUnlock()
+
+	log.WithFields(logrus.Fields{
+		"startEpoch": cfg.StartEpoch,
+		"endEpoch":   endEpoch,
+	}).Info("Replaying history from the beacon database for slashing detection")
+	start := time.Now()
+	report := &ReplayReport{
+		StartEpoch:        cfg.StartEpoch,
+		EndEpoch:          endEpoch,
+		AttesterSlashings: make([]*ethpb.AttesterSlashing, 0),
+		ProposerSlashings: make([]*ethpb.ProposerSlashing, 0),
+	}
+	committees := newReplayCommittees(s.serviceCfg.StateGen)
+	for epoch := cfg.StartEpoch; epoch <= endEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		attSlashings, propSlashings, err := s.replayEpoch(ctx, epoch, committees)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay epoch %d", epoch)
+		}
+		report.AttesterSlashings = append(report.AttesterSlashings, attSlashings...)
+		report.ProposerSlashings = append(report.ProposerSlashings, propSlashings...)
+		if cfg.ReportPath == "" {
+			if err := s.processAttesterSlashings(ctx, attSlashings); err != nil {
+				return nil, err
+			}
+			if err := s.processProposerSlashings(ctx, propSlashings); err != nil {
+				return nil, err
+			}
+		}
+		replayedEpochsTotal.Inc()
+		// Blocks include attestations targeting their epoch or the previous one.
+		if epoch > 0 {
+			committees.prune(epoch - 1)
+		}
+		s.trimQueues()
+	}
+	if cfg.ReportPath != "" {
+		enc, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not encode replay report")
+		}
+		if err := file.WriteFile(cfg.ReportPath, enc); err != nil {
+			return nil, errors.Wrap(err, "could not write replay report")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"numAttesterSlashings": len(report.AttesterSlashings),
+		"numProposerSlashings": len(report.ProposerSlashings),
+		"elapsed":              time.Since(start),
+	}).Info("Done replaying history for slashing detection")
+	return report, nil
+}
+
+// Replays the blocks of an epoch stored in the beacon database, and the attestations they include,
+// through the same detection as the blocks and attestations received from the beacon node.
+func (s *Service) replayEpoch(
+	ctx context.Context, epoch primitives.Epoch, committees *replayCommittees,
+) ([]*ethpb.AttesterSlashing, []*ethpb.ProposerSlashing, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	blks, _, err := s.serviceCfg.BeaconDatabase.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get blocks")
+	}
+	proposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	atts := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	for _, blk := range blks {
+		header, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get block header")
+		}
+		headerRoot, err := header.Header.HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		proposals = append(proposals, &slashertypes.SignedBlockHeaderWrapper{
+			SignedBeaconBlockHeader: header,
+			SigningRoot:             headerRoot,
+		})
+		for _, att := range blk.Block().Body().Attestations() {
+			committee, err := committees.committee(ctx, att)
+			if err != nil {
+				return nil, nil, err
+			}
+			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+			if err != nil {
+				return nil, nil, err
+			}
+			dataRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return nil, nil, err
+			}
+			atts = append(atts, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				SigningRoot:        dataRoot,
+			})
+		}
+	}
+
+	propSlashings, err := s.detectProposerSlashings(ctx, proposals)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not detect proposer slashings")
+	}
+	validAtts, _, _ := s.filterAttestations(atts, epoch)
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, validAtts); err != nil {
+		return nil, nil, errors.Wrap(err, "could not save attestation records")
+	}
+	attSlashings, err := s.checkSlashableAttestations(ctx, epoch, validAtts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not check slashable attestations")
+	}
+	return attSlashings, propSlashings, nil
+}
+
+// replayTarget holds what the committees of an attestation target checkpoint are computed from.
+type replayTarget struct {
+	seed          [32]byte
+	activeIndices []primitives.ValidatorIndex
+}
+
+// replayCommittees computes the committees of the replayed attestations from the states of their target checkpoints.
+// Replayed checkpoints are long finalized, so their states are regenerated and kept here rather than in the caches of
+// the blockchain service, which they would only evict the live checkpoints from.
+type replayCommittees struct {
+	stateGen stategen.StateManager
+	targets  map[primitives.Epoch]map[[32]byte]*replayTarget
+}
+
+func newReplayCommittees(stateGen stategen.StateManager) *replayCommittees {
+	return &replayCommittees{
+		stateGen: stateGen,
+		targets:  make(map[primitives.Epoch]map[[32]byte]*replayTarget),
+	}
+}
+
+// Returns the committee of an attestation included in a block, using the state at the start of its target epoch.
+func (c *replayCommittees) committee(ctx context.Context, att *ethpb.Attestation) ([]primitives.ValidatorIndex, error) {
+	target, err := c.target(ctx, att.Data.Target)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := helpers.BeaconCommittee(ctx, target.activeIndices, target.seed, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation committee")
+	}
+	return committee, nil
+}
+
+func (c *replayCommittees) target(ctx context.Context, cp *ethpb.Checkpoint) (*replayTarget, error) {
+	root := bytesutil.ToBytes32(cp.Root)
+	if t, ok := c.targets[cp.Epoch][root]; ok {
+		return t, nil
+	}
+	st, err := c.stateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get attestation target state for epoch %d", cp.Epoch)
+	}
+	epochStart, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err = transition.ProcessSlotsIfPossible(ctx, st, epochStart)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to epoch %d", cp.Epoch)
+	}
+	seed, err := helpers.Seed(st, cp.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get seed")
+	}
+	// The active indices are read from the state directly, as helpers.ActiveValidatorIndices fills the committee cache.
+	activeIndices := make([]primitives.ValidatorIndex, 0, st.NumValidators())
+	if err := st.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+		if helpers.IsActiveValidatorUsingTrie(val, cp.Epoch) {
+			activeIndices = append(activeIndices, primitives.ValidatorIndex(idx))
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not get active indices")
+	}
+	t := &replayTarget{seed: seed, activeIndices: activeIndices}
+	if c.targets[cp.Epoch] == nil {
+		c.targets[cp.Epoch] = make(map[[32]byte]*replayTarget)
+	}
+	c.targets[cp.Epoch][root] = t
+	return t, nil
+}
+
+// Drops the targets of the epochs before the given epoch.
+func (c *replayCommittees) prune(epoch primitives.Epoch) {
+	for e := range c.targets {
+		if e < epoch {
+			delete(c.targets, e)
+		}
+	}
+}
+
+// Drops the queued attestations and blocks which are older than the replay queue window.
+func (s *Service) trimQueues() {
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	if currentEpoch <= replayQueueWindow {
+		return
+	}
+	windowStart := currentEpoch - replayQueueWindow
+	droppedAttestationsTotal.Add(float64(s.attsQueue.dropBefore(windowStart)))
+	startSlot, err := slots.EpochStart(windowStart)
+	if err != nil {
+		return
+	}
+	s.blksQueue.dropBefore(startSlot)
+}
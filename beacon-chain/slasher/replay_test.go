@@ -0,0 +1,147 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+func TestService_replay(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconState, _ := util.DeterministicGenesisState(t, 64)
+
+	genesis := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	stateGen := stategen.New(beaconDB, doublylinkedtree.New())
+	require.NoError(t, stateGen.SaveState(ctx, genesisRoot, beaconState))
+
+	// Two blocks proposed at the same slot of epoch 1 by the same proposer, each including an
+	// attestation of the same committee for a different head.
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, slot, 0)
+	require.NoError(t, err)
+	attestation := func(head byte) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		bits.SetBitAt(0, true)
+		return util.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: bytesutil.PadTo([]byte{head}, 32),
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]},
+			},
+		})
+	}
+	roots := make([][32]byte, 2)
+	for i := range roots {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = genesisRoot[:]
+		blk.Block.Body.Graffiti = bytesutil.PadTo([]byte{byte(i)}, 32)
+		blk.Block.Body.Attestations = []*ethpb.Attestation{attestation(byte(i))}
+		util.SaveBlock(t, ctx, beaconDB, blk)
+		roots[i], err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	finalized := util.NewBeaconBlock()
+	finalized.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	finalized.Block.ParentRoot = roots[0][:]
+	wsb, err := blocks.NewSignedBeaconBlock(finalized)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	finalizedRoot, err := finalized.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: finalized.Block.Slot, Root: finalizedRoot[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}))
+
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:       slasherDB,
+			BeaconDatabase: beaconDB,
+			// Committees are computed from states regenerated for the replay, not from the caches of the blockchain service.
+			StateGen: stateGen,
+		},
+		latestEpochWrittenForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+		attsQueue:                      newAttestationsQueue(),
+		blksQueue:                      newBlocksQueue(),
+		genesisTime:                    time.Now(),
+	}
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	report, err := s.replay(ctx, &ReplayConfig{
+		StartEpoch: 0,
+		EndEpoch:   params.BeaconConfig().FarFutureEpoch,
+		ReportPath: reportPath,
+	})
+	require.NoError(t, err)
+	// The epoch of the finalized checkpoint is not replayed.
+	require.Equal(t, primitives.Epoch(1), report.EndEpoch)
+	require.Equal(t, 1, len(report.ProposerSlashings))
+	require.NotEqual(t, 0, len(report.AttesterSlashings))
+	for _, sl := range report.AttesterSlashings {
+		require.DeepEqual(t, []uint64{uint64(committee[0])}, sl.Attestation_1.AttestingIndices)
+	}
+
+	enc, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	written := &ReplayReport{}
+	require.NoError(t, json.Unmarshal(enc, written))
+	require.Equal(t, 1, len(written.ProposerSlashings))
+	require.Equal(t, len(report.AttesterSlashings), len(written.AttesterSlashings))
+
+	// History before what slasher already processed cannot be replayed.
+	_, err = s.replay(ctx, &ReplayConfig{StartEpoch: 0, EndEpoch: 1, ReportPath: reportPath})
+	require.ErrorContains(t, "requires a new slasher database", err)
+}
+
+func TestService_trimQueues(t *testing.T) {
+	s := &Service{
+		attsQueue: newAttestationsQueue(),
+		blksQueue: newBlocksQueue(),
+	}
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	s.genesisTime = time.Now().Add(-time.Duration(10*secondsPerEpoch) * time.Second)
+	s.attsQueue.extend([]*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(t, 6, 7, []uint64{1}, make([]byte, 32)),
+		createAttestationWrapper(t, 7, 8, []uint64{1}, make([]byte, 32)),
+		createAttestationWrapper(t, 9, 10, []uint64{1}, make([]byte, 32)),
+	})
+	startSlot, err := slots.EpochStart(8)
+	require.NoError(t, err)
+	s.blksQueue.extend([]*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(t, startSlot-1, primitives.ValidatorIndex(1), make([]byte, 32)),
+		createProposalWrapper(t, startSlot, primitives.ValidatorIndex(1), make([]byte, 32)),
+	})
+
+	// Only the attestations and blocks of the last epochs are kept.
+	s.trimQueues()
+	atts := s.attsQueue.dequeue()
+	require.Equal(t, 2, len(atts))
+	require.Equal(t, primitives.Epoch(8), atts[0].IndexedAttestation.Data.Target.Epoch)
+	blks := s.blksQueue.dequeue()
+	require.Equal(t, 1, len(blks))
+	require.Equal(t, startSlot, blks[0].SignedBeaconBlockHeader.Header.Slot)
+}
@@ -23,6 +23,31 @@ func (s *Service) HighestAttestations(
 	return atts, nil
 }
 
+// SlasherStatus reports the size of the slasher database, the last epoch for which queued
+// attestations were processed, how many attestations and blocks are waiting to be processed,
+// and how long detection took for the last batches.
+func (s *Service) SlasherStatus(ctx context.Context) (*ethpb.SlasherStatusResponse, error) {
+	buckets, err := s.serviceCfg.Database.BucketStats(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bucket stats from database")
+	}
+	size, err := s.serviceCfg.Database.DatabaseSize()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get database size")
+	}
+	s.statusLock.RLock()
+	defer s.statusLock.RUnlock()
+	return &ethpb.SlasherStatusResponse{
+		Buckets:                       buckets,
+		DatabaseSize:                  size,
+		LastProcessedEpoch:            s.lastProcessedEpoch,
+		AttestationQueueSize:          uint64(s.attsQueue.size()),
+		BlockQueueSize:                uint64(s.blksQueue.size()),
+		AttestationDetectionLatencyMs: uint64(s.attsDetectionLatency.Milliseconds()),
+		BlockDetectionLatencyMs:       uint64(s.blocksDetectionLatency.Milliseconds()),
+	}, nil
+}
+
 // IsSlashableBlock checks if an input block header is slashable
 // with respect to historical block proposal data.
 func (s *Service) IsSlashableBlock(
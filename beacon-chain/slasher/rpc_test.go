@@ -198,3 +198,43 @@ func TestService_HighestAttestations(t *testing.T) {
 		require.DeepEqual(t, &ethpb.HighestAttestation{ValidatorIndex: 1, HighestSourceEpoch: 0, HighestTargetEpoch: 1}, atts[0])
 	})
 }
+
+func TestService_SlasherStatus(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		params:                 DefaultParams(),
+		attsQueue:              newAttestationsQueue(),
+		blksQueue:              newBlocksQueue(),
+		lastProcessedEpoch:     3,
+		attsDetectionLatency:   2 * time.Second,
+		blocksDetectionLatency: 5 * time.Millisecond,
+	}
+	s.attsQueue.extend([]*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(t, 2, 3, []uint64{0}, []byte{1}),
+		createAttestationWrapper(t, 2, 3, []uint64{1}, []byte{1}),
+	})
+	s.blksQueue.push(createProposalWrapper(t, 2, 3, []byte{1}))
+	require.NoError(t, slasherDB.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(t, 2, 3, []byte{1}),
+	}))
+
+	resp, err := s.SlasherStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(3), resp.LastProcessedEpoch)
+	assert.Equal(t, uint64(2), resp.AttestationQueueSize)
+	assert.Equal(t, uint64(1), resp.BlockQueueSize)
+	assert.Equal(t, uint64(2000), resp.AttestationDetectionLatencyMs)
+	assert.Equal(t, uint64(5), resp.BlockDetectionLatencyMs)
+	assert.Equal(t, true, resp.DatabaseSize > 0)
+	var numProposals uint64
+	for _, b := range resp.Buckets {
+		if b.Name == "proposal-records" {
+			numProposals = b.NumKeys
+		}
+	}
+	assert.Equal(t, uint64(1), numProposals)
+}
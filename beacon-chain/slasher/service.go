@@ -6,6 +6,7 @@ package slasher
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/async/event"
@@ -15,7 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	beaconsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -38,7 +39,13 @@ type ServiceConfig struct {
 	StateGen                stategen.StateManager
 	SlashingPoolInserter    slashings.PoolInserter
 	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             sync.Checker
+	SyncChecker             beaconsync.Checker
+	BeaconDatabase          db.ReadOnlyDatabase
+	// Replay, if set, is the range of history replayed before detecting slashings live.
+	Replay *ReplayConfig
+	// RemoteBeaconNode, if set, is the beacon node a standalone slasher follows. It is used
+	// instead of HeadStateFetcher, StateGen, AttestationStateFetcher and SlashingPoolInserter.
+	RemoteBeaconNode RemoteBeaconNode
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -48,6 +55,7 @@ type SlashingChecker interface {
 	HighestAttestations(
 		ctx context.Context, indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SlasherStatus(ctx context.Context) (*ethpb.SlasherStatusResponse, error)
 }
 
 // Service defining a slasher implementation as part of
@@ -66,6 +74,13 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	latestEpochWrittenLock         sync.RWMutex
+	chunksLock                     sync.Mutex
+	nextChunksPruningEpoch         primitives.Epoch
+	statusLock                     sync.RWMutex
+	lastProcessedEpoch             primitives.Epoch
+	attsDetectionLatency           time.Duration
+	blocksDetectionLatency         time.Duration
 }
 
 // New instantiates a new slasher from configuration values.
@@ -97,14 +112,13 @@ func (s *Service) run() {
 	log.Info("Completed chain sync, starting slashing detection")
 
 	// Get the latest epoch written for each validator from disk on startup.
-	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(s.ctx)
+	numVals, err := s.numValidators(s.ctx)
 	if err != nil {
-		log.WithError(err).Error("Failed to fetch head state")
+		log.WithError(err).Error("Failed to fetch number of validators")
 		return
 	}
-	numVals := headState.NumValidators()
 	validatorIndices := make([]primitives.ValidatorIndex, numVals)
-	for i := 0; i < numVals; i++ {
+	for i := uint64(0); i < numVals; i++ {
 		validatorIndices[i] = primitives.ValidatorIndex(i)
 	}
 	start := time.Now()
@@ -116,9 +130,11 @@ func (s *Service) run() {
 		log.Error(err)
 		return
 	}
+	s.latestEpochWrittenLock.Lock()
 	for _, item := range epochsByValidator {
 		s.latestEpochWrittenForValidator[item.ValidatorIndex] = item.Epoch
 	}
+	s.latestEpochWrittenLock.Unlock()
 	log.WithField("elapsed", time.Since(start)).Info(
 		"Finished retrieving last epoch written per validator",
 	)
@@ -128,6 +144,15 @@ func (s *Service) run() {
 	go s.receiveAttestations(s.ctx, indexedAttsChan)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
 
+	// Attestations and blocks received while history is replayed are queued, and processed once
+	// it is done, so that the epochs processed by slasher keep increasing. Only the recent ones
+	// are kept queued, see replayQueueWindow.
+	if s.serviceCfg.Replay != nil {
+		if _, err := s.replay(s.ctx, s.serviceCfg.Replay); err != nil {
+			log.WithError(err).Error("Could not replay history for slashing detection")
+		}
+	}
+
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
@@ -155,9 +180,10 @@ func (s *Service) Stop() error {
 	ctx, innerCancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer innerCancel()
 	log.Info("Flushing last epoch written for each validator to disk, please wait")
-	if err := s.serviceCfg.Database.SaveLastEpochsWrittenForValidators(
-		ctx, s.latestEpochWrittenForValidator,
-	); err != nil {
+	s.latestEpochWrittenLock.RLock()
+	err := s.serviceCfg.Database.SaveLastEpochsWrittenForValidators(ctx, s.latestEpochWrittenForValidator)
+	s.latestEpochWrittenLock.RUnlock()
+	if err != nil {
 		log.Error(err)
 	}
 	log.WithField("elapsed", time.Since(start)).Debug(
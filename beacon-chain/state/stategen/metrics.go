@@ -25,4 +25,10 @@ var (
 			Help: "Time it took to replay to slot",
 		},
 	)
+	reconstructedStateSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "reconstructed_state_slot",
+			Help: "The slot of the latest historical state saved by state reconstruction",
+		},
+	)
 )
@@ -0,0 +1,261 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// reconstructionBatchSize is the number of slots whose blocks are loaded from the db at once during reconstruction.
+const reconstructionBatchSize = primitives.Slot(256)
+
+var (
+	errReconstructionGap      = errors.New("blocks below the origin block are missing, backfill may not be complete")
+	errGenesisStateMismatch   = errors.New("genesis state does not match the state root of the genesis block")
+	errReconstructionMismatch = errors.New("reconstructed state does not match the state root of its block")
+)
+
+// ReconstructionDB describes the set of DB methods needed to reconstruct historical states.
+type ReconstructionDB interface {
+	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+	Blocks(ctx context.Context, f *filters.QueryFilter) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte, error)
+	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
+	GenesisState(ctx context.Context) (state.BeaconState, error)
+	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
+	StateReconstructionRoot(ctx context.Context) ([32]byte, error)
+	SaveStateReconstructionRoot(ctx context.Context, blockRoot [32]byte) error
+}
+
+// ReconstructorOption is a functional option for controlling the initialization of a *Reconstructor value.
+type ReconstructorOption func(*Reconstructor)
+
+// WithGenesisState replays from the given genesis state, instead of the one saved in the db.
+func WithGenesisState(st state.BeaconState) ReconstructorOption {
+	return func(r *Reconstructor) {
+		r.genesis = st
+	}
+}
+
+// WithSlotsPerArchivedPoint sets the interval at which reconstructed states are saved.
+func WithSlotsPerArchivedPoint(sl primitives.Slot) ReconstructorOption {
+	return func(r *Reconstructor) {
+		r.slotsPerArchivedPoint = sl
+	}
+}
+
+// Reconstructor regenerates the cold states missing below the origin block of a node initialized via checkpoint
+// sync, so that historical states can be replayed from them. It replays the blocks from genesis to the origin block,
+// saving the state at each archived point the way MigrateToCold does: the post-state of the highest block below the
+// archived point, keyed by the root of that block.
+type Reconstructor struct {
+	db                    ReconstructionDB
+	slotsPerArchivedPoint primitives.Slot
+	genesis               state.BeaconState
+}
+
+// NewReconstructor returns a Reconstructor saving states into the given db.
+func NewReconstructor(beaconDB ReconstructionDB, opts ...ReconstructorOption) *Reconstructor {
+	r := &Reconstructor{
+		db:                    beaconDB,
+		slotsPerArchivedPoint: params.BeaconConfig().SlotsPerArchivedPoint,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Reconstruct replays the blocks between genesis and the origin block, saving the state at each archived point.
+// All the blocks below the origin block must be in the db, which is the case once backfill is complete. The root of
+// the latest saved state is persisted along with it, so that reconstruction resumes from there when interrupted.
+func (r *Reconstructor) Reconstruct(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.Reconstruct")
+	defer span.End()
+
+	if r.slotsPerArchivedPoint == 0 {
+		return errors.New("slots per archived point must be greater than 0")
+	}
+	originRoot, err := r.db.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		log.Debug("Node was not initialized via checkpoint sync, no historical states to reconstruct")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	origin, err := r.block(ctx, originRoot)
+	if err != nil {
+		return err
+	}
+	originSlot := origin.Block().Slot()
+
+	st, tip, tipRoot, err := r.resume(ctx)
+	if err != nil {
+		return err
+	}
+	if tipRoot == originRoot {
+		log.Debug("Historical states are already reconstructed")
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"slot":       st.Slot(),
+		"originSlot": originSlot,
+	}).Info("Reconstructing historical states below the origin block")
+
+	for start := st.Slot() + 1; start <= originSlot; start += reconstructionBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := start + reconstructionBatchSize - 1
+		if end > originSlot {
+			end = originSlot
+		}
+		blks, roots, err := r.db.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return errors.Wrapf(err, "could not get blocks between slots %d and %d", start, end)
+		}
+		if len(blks) != len(roots) {
+			return errors.New("length of blocks and roots don't match")
+		}
+		order := make([]int, len(blks))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return blks[order[i]].Block().Slot() < blks[order[j]].Block().Slot()
+		})
+		for _, i := range order {
+			blk := blks[i]
+			// Blocks of other forks, which were never finalized, are not part of the history.
+			if blk.Block().ParentRoot() != tipRoot {
+				continue
+			}
+			if err := r.archive(ctx, st, tip, tipRoot, blk.Block().Slot()); err != nil {
+				return err
+			}
+			if roots[i] == originRoot {
+				if err := r.db.SaveStateReconstructionRoot(ctx, originRoot); err != nil {
+					return errors.Wrap(err, "could not save state reconstruction root")
+				}
+				log.Info("Historical states reconstructed")
+				return nil
+			}
+			st, err = executeStateTransitionStateGen(ctx, st, blk)
+			if err != nil {
+				return errors.Wrapf(err, "could not replay block at slot %d", blk.Block().Slot())
+			}
+			tip, tipRoot = blk, roots[i]
+		}
+	}
+	return errors.Wrapf(errReconstructionGap, "no block descending from block %#x at slot %d", tipRoot, tip.Block().Slot())
+}
+
+// resume returns the state reconstruction starts from, along with its block: either the latest reconstructed state,
+// or the genesis state.
+func (r *Reconstructor) resume(ctx context.Context) (state.BeaconState, interfaces.ReadOnlySignedBeaconBlock, [32]byte, error) {
+	root, err := r.db.StateReconstructionRoot(ctx)
+	switch {
+	case err == nil:
+		blk, err := r.block(ctx, root)
+		if err != nil {
+			return nil, nil, [32]byte{}, err
+		}
+		st, err := r.db.State(ctx, root)
+		if err != nil {
+			return nil, nil, [32]byte{}, errors.Wrapf(err, "could not get reconstructed state with root %#x", root)
+		}
+		if st == nil || st.IsNil() {
+			return nil, nil, [32]byte{}, errors.Wrapf(errUnknownState, "reconstructed state with root %#x", root)
+		}
+		return st, blk, root, nil
+	case !errors.Is(err, db.ErrNotFoundStateReconstructionRoot):
+		return nil, nil, [32]byte{}, errors.Wrap(err, "could not get state reconstruction root")
+	}
+
+	root, err = r.db.GenesisBlockRoot(ctx)
+	if err != nil {
+		return nil, nil, [32]byte{}, errors.Wrap(err, "could not get genesis block root")
+	}
+	blk, err := r.block(ctx, root)
+	if err != nil {
+		return nil, nil, [32]byte{}, err
+	}
+	st := r.genesis
+	if st == nil || st.IsNil() {
+		st, err = r.db.GenesisState(ctx)
+		if err != nil {
+			return nil, nil, [32]byte{}, errors.Wrap(err, "could not get genesis state")
+		}
+		if st == nil || st.IsNil() {
+			return nil, nil, [32]byte{}, errors.Wrap(errUnknownState, "genesis state")
+		}
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, nil, [32]byte{}, errors.Wrap(err, "could not compute genesis state root")
+	}
+	if stateRoot != blk.Block().StateRoot() {
+		return nil, nil, [32]byte{}, errors.Wrapf(errGenesisStateMismatch, "state root %#x, block state root %#x", stateRoot, blk.Block().StateRoot())
+	}
+	return st.Copy(), blk, root, nil
+}
+
+// archive saves the post-state of the tip block when the next block is past an archived point, and records the
+// progress of reconstruction.
+func (r *Reconstructor) archive(
+	ctx context.Context,
+	st state.BeaconState,
+	tip interfaces.ReadOnlySignedBeaconBlock,
+	tipRoot [32]byte,
+	next primitives.Slot,
+) error {
+	archivedPoint := (st.Slot()/r.slotsPerArchivedPoint + 1) * r.slotsPerArchivedPoint
+	if next < archivedPoint {
+		return nil
+	}
+	if !r.db.HasState(ctx, tipRoot) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute state root at slot %d", st.Slot())
+		}
+		if stateRoot != tip.Block().StateRoot() {
+			return errors.Wrapf(errReconstructionMismatch, "slot %d, state root %#x, block state root %#x", st.Slot(), stateRoot, tip.Block().StateRoot())
+		}
+		if err := r.db.SaveState(ctx, st, tipRoot); err != nil {
+			return errors.Wrapf(err, "could not save reconstructed state at slot %d", st.Slot())
+		}
+		log.WithFields(logrus.Fields{
+			"slot":          st.Slot(),
+			"archivedPoint": archivedPoint,
+		}).Info("Saved reconstructed state")
+	}
+	if err := r.db.SaveStateReconstructionRoot(ctx, tipRoot); err != nil {
+		return errors.Wrap(err, "could not save state reconstruction root")
+	}
+	reconstructedStateSlot.Set(float64(st.Slot()))
+	return nil
+}
+
+func (r *Reconstructor) block(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	blk, err := r.db.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block with root %#x", root)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Wrap(errUnknownBlock, fmt.Sprintf("root %#x", root))
+	}
+	return blk, nil
+}
@@ -0,0 +1,167 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// setupCheckpointSyncedDB returns a db holding the genesis state and the blocks at the given slots, the last of which
+// is the origin block with its state, as a node initialized via checkpoint sync holds once backfill is complete.
+// The blocks at the missing slots are not saved. The post-states of the blocks are returned by block root.
+func setupCheckpointSyncedDB(t *testing.T, blockSlots []primitives.Slot, missing ...primitives.Slot) (*kv.Store, [][32]byte, map[[32]byte]state.BeaconState) {
+	ctx := context.Background()
+	beaconDB, ok := testDB.SetupDB(t).(*kv.Store)
+	require.Equal(t, true, ok)
+	st, keys := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, st))
+	st = st.Copy()
+
+	roots := make([][32]byte, 0, len(blockSlots))
+	states := make(map[[32]byte]state.BeaconState)
+	for _, slot := range blockSlots {
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+		states[root] = st.Copy()
+		if !isMissing(slot, missing) {
+			require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+		}
+	}
+	originRoot := roots[len(roots)-1]
+	require.NoError(t, beaconDB.SaveState(ctx, st, originRoot))
+	require.NoError(t, beaconDB.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	return beaconDB, roots, states
+}
+
+func isMissing(slot primitives.Slot, missing []primitives.Slot) bool {
+	for _, m := range missing {
+		if m == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// failingSaveDB fails to save states once a number of states were saved.
+type failingSaveDB struct {
+	*kv.Store
+	saves int
+}
+
+func (d *failingSaveDB) SaveState(ctx context.Context, st state.ReadOnlyBeaconState, blockRoot [32]byte) error {
+	if d.saves == 0 {
+		return errors.New("disk full")
+	}
+	d.saves--
+	return d.Store.SaveState(ctx, st, blockRoot)
+}
+
+func TestReconstructor_Reconstruct(t *testing.T) {
+	ctx := context.Background()
+	blockSlots := []primitives.Slot{1, 2, 4, 5, 6, 9, 10, 11, 15, 16, 17}
+	beaconDB, roots, states := setupCheckpointSyncedDB(t, blockSlots)
+
+	// With an archived point every 4 slots, the post-states of the highest blocks below slots 4, 8, 12 and 16 are
+	// saved, which are the blocks at slots 2, 6, 11 and 15.
+	archived := map[primitives.Slot]bool{2: true, 6: true, 11: true, 15: true}
+	r := NewReconstructor(beaconDB, WithSlotsPerArchivedPoint(4))
+	require.NoError(t, r.Reconstruct(ctx))
+	for i, root := range roots[:len(roots)-1] {
+		assert.Equal(t, archived[blockSlots[i]], beaconDB.HasState(ctx, root), "Unexpected state at slot %d", blockSlots[i])
+		if !archived[blockSlots[i]] {
+			continue
+		}
+		st, err := beaconDB.State(ctx, root)
+		require.NoError(t, err)
+		want, err := states[root].HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	progress, err := beaconDB.StateReconstructionRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[len(roots)-1], progress)
+
+	// Old states can be replayed from the reconstructed ones.
+	ch := NewCanonicalHistory(beaconDB, &mockCanonicalChecker{is: true}, &mockCurrentSlotter{Slot: 32})
+	st, err := ch.ReplayerForSlot(10).ReplayBlocks(ctx)
+	require.NoError(t, err)
+	want, err := states[roots[6]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Reconstruction is not done twice.
+	require.NoError(t, NewReconstructor(&failingSaveDB{Store: beaconDB}, WithSlotsPerArchivedPoint(4)).Reconstruct(ctx))
+}
+
+func TestReconstructor_Reconstruct_Resume(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, roots, _ := setupCheckpointSyncedDB(t, []primitives.Slot{1, 2, 4, 5, 6, 9, 10, 11, 15, 16, 17})
+
+	// The node stops after saving the states of slots 2 and 6.
+	failing := &failingSaveDB{Store: beaconDB, saves: 2}
+	require.ErrorContains(t, "disk full", NewReconstructor(failing, WithSlotsPerArchivedPoint(4)).Reconstruct(ctx))
+	progress, err := beaconDB.StateReconstructionRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[4], progress)
+
+	// Reconstruction resumes from the state of slot 6, without replaying from genesis.
+	require.NoError(t, beaconDB.DeleteState(ctx, roots[1]))
+	require.NoError(t, NewReconstructor(beaconDB, WithSlotsPerArchivedPoint(4)).Reconstruct(ctx))
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[1]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[7]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[8]))
+	progress, err = beaconDB.StateReconstructionRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[len(roots)-1], progress)
+}
+
+func TestReconstructor_Reconstruct_MissingBlocks(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, roots, _ := setupCheckpointSyncedDB(t, []primitives.Slot{1, 2, 4, 5, 6, 9, 10, 11, 15, 16, 17}, 9)
+
+	err := NewReconstructor(beaconDB, WithSlotsPerArchivedPoint(4)).Reconstruct(ctx)
+	require.ErrorIs(t, err, errReconstructionGap)
+	// Only the state below slot 4 could be saved, the block at slot 6 has no child.
+	progress, err := beaconDB.StateReconstructionRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], progress)
+}
+
+func TestReconstructor_Reconstruct_GenesisState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _, _ := setupCheckpointSyncedDB(t, []primitives.Slot{1, 2, 3})
+
+	other, _ := util.DeterministicGenesisState(t, 64)
+	err := NewReconstructor(beaconDB, WithGenesisState(other)).Reconstruct(ctx)
+	require.ErrorIs(t, err, errGenesisStateMismatch)
+
+	genesis, err := beaconDB.GenesisState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, NewReconstructor(beaconDB, WithGenesisState(genesis)).Reconstruct(ctx))
+}
+
+func TestReconstructor_Reconstruct_NotCheckpointSynced(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	require.NoError(t, NewReconstructor(beaconDB).Reconstruct(context.Background()))
+}
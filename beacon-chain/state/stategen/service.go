@@ -11,7 +11,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
@@ -46,7 +45,7 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
-	backfillStatus          *backfill.Status
+	backfillStatus          BackfillStatus
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
 }
@@ -73,7 +72,14 @@ type finalizedInfo struct {
 // StateGenOption is a functional option for controlling the initialization of a *State value
 type StateGenOption func(*State)
 
-func WithBackfillStatus(bfs *backfill.Status) StateGenOption {
+// BackfillStatus tells whether the blocks of a slot are in the database, which is not the case of the slots
+// yet to be backfilled when the node was initialized via checkpoint sync.
+type BackfillStatus interface {
+	SlotCovered(sl primitives.Slot) bool
+}
+
+// WithBackfillStatus sets the backfill status used to know which slots states can be generated for.
+func WithBackfillStatus(bfs BackfillStatus) StateGenOption {
 	return func(sg *State) {
 		sg.backfillStatus = bfs
 	}
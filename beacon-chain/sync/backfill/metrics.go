@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_slot",
+		Help: "Slot of the lowest block backfilled so far.",
+	})
+	backfillBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "Number of blocks saved by backfill.",
+	})
+	backfillBatchFailuresCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batch_failures_total",
+		Help: "Number of backfill batches which could not be fetched or verified from any peer.",
+	})
+)
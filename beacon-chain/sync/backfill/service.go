@@ -0,0 +1,342 @@
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	prysmsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+const (
+	// DefaultBatchSize is the default number of slots requested from a peer at once.
+	DefaultBatchSize = 64
+	// DefaultBlocksPerSecond is the default number of blocks backfill requests per second at most.
+	DefaultBlocksPerSecond = 64
+	// rateLimiterKey is the key of the rate limiter bucket which all backfill requests go through.
+	rateLimiterKey = "backfill"
+	// retryDelay is the time to wait before retrying a batch no peer could provide.
+	retryDelay = 5 * time.Second
+)
+
+var errNoPeersAvailable = errors.New("no peers available to backfill from")
+
+// Database describes the set of DB methods that the backfill Service needs to function.
+type Database interface {
+	BackfillDB
+	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	SaveBackfillFinalizedBlockRoots(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// StateReconstructor regenerates the historical states below the origin block, once the blocks are backfilled.
+type StateReconstructor interface {
+	Reconstruct(ctx context.Context) error
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P                p2p.P2P
+	DB                 Database
+	Chain              blockchain.ForkFetcher
+	InitialSync        prysmsync.Checker
+	Status             *Status
+	BatchSize          uint64
+	BlocksPerSecond    uint64
+	StateReconstructor StateReconstructor
+}
+
+// Service downloads the blocks missing between genesis and the origin block of a node initialized via checkpoint
+// sync. It walks the chain backwards from the origin block, requesting batches of blocks from peers, checking that
+// they are linked to the lowest block backfilled so far and that their proposer signatures are valid, before saving
+// them and advancing the backfill Status. Progress is persisted with each batch, so backfill resumes where it left off
+// after a restart. Backfill only starts once initial sync is done, and is rate limited so that it does not take the
+// bandwidth of peers away from syncing the head of the chain. Once all blocks are available, the Service can regenerate
+// the historical states below the origin block with a StateReconstructor.
+type Service struct {
+	cfg           *Config
+	ctx           context.Context
+	cancel        context.CancelFunc
+	rateLimiter   *leakybucket.Collector
+	requestBlocks func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error)
+	verifier      *verifier
+	genesisRoot   [32]byte
+	originEpoch   primitives.Epoch
+	// lowParent is the parent root of the lowest block backfilled so far.
+	lowParent [32]byte
+	// searchEnd is the slot below which the next batch is requested. It is lower than the slot of the lowest block
+	// backfilled so far when the slots in between were empty.
+	searchEnd primitives.Slot
+	err       error
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.BatchSize > params.BeaconNetworkConfig().MaxRequestBlocks {
+		cfg.BatchSize = params.BeaconNetworkConfig().MaxRequestBlocks
+	}
+	if cfg.BlocksPerSecond == 0 {
+		cfg.BlocksPerSecond = DefaultBlocksPerSecond
+	}
+	capacity := cfg.BlocksPerSecond
+	if capacity < cfg.BatchSize {
+		capacity = cfg.BatchSize
+	}
+	s := &Service{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		rateLimiter: leakybucket.NewCollector(float64(cfg.BlocksPerSecond), int64(capacity), time.Second, false /* deleteEmptyBuckets */),
+	}
+	s.requestBlocks = func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		return prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.cfg.Chain, s.cfg.P2P, pid, req, nil)
+	}
+	return s
+}
+
+// Start backfills the blocks missing since genesis, if any, then reconstructs the historical states if configured to.
+func (s *Service) Start() {
+	if s.cfg.Status.Complete() {
+		log.Debug("No blocks to backfill")
+	} else if !s.backfill() {
+		return
+	}
+	if s.cfg.StateReconstructor == nil {
+		return
+	}
+	if err := s.cfg.StateReconstructor.Reconstruct(s.ctx); err != nil {
+		if s.ctx.Err() != nil {
+			return
+		}
+		s.err = err
+		log.WithError(err).Error("Could not reconstruct historical states")
+	}
+}
+
+// backfill downloads blocks until the backfill Status is complete. It returns false if backfill was interrupted.
+func (s *Service) backfill() bool {
+	if err := s.waitForInitialSync(); err != nil {
+		return false
+	}
+	if err := s.initialize(); err != nil {
+		s.err = err
+		log.WithError(err).Error("Could not initialize backfill")
+		return false
+	}
+	log.WithField("slot", s.cfg.Status.EndGap()).Info("Backfilling blocks missing since genesis")
+	for !s.cfg.Status.Complete() {
+		if err := s.backfillBatch(); err != nil {
+			if s.ctx.Err() != nil {
+				return false
+			}
+			backfillBatchFailuresCount.Inc()
+			log.WithError(err).Debug("Could not backfill batch, retrying")
+			select {
+			case <-s.ctx.Done():
+				return false
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+	log.Info("Backfill complete, all blocks since genesis are available")
+	return true
+}
+
+// Stop backfill.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.rateLimiter.Free()
+	return nil
+}
+
+// Status of backfill.
+func (s *Service) Status() error {
+	return s.err
+}
+
+func (s *Service) waitForInitialSync() error {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for !s.cfg.InitialSync.Synced() {
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// initialize loads the lowest backfilled block, and the origin state whose validator set is used to verify
+// proposer signatures.
+func (s *Service) initialize() error {
+	ctx := s.ctx
+	var err error
+	s.genesisRoot, err = s.cfg.DB.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	lowRoot, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	low, err := s.cfg.DB.Block(ctx, lowRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get backfill block with root %#x", lowRoot)
+	}
+	if low == nil || low.IsNil() {
+		return errors.Errorf("backfill block with root %#x not found", lowRoot)
+	}
+	s.lowParent = low.Block().ParentRoot()
+	s.searchEnd = low.Block().Slot()
+	backfillSlot.Set(float64(s.searchEnd))
+
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	originState, err := s.cfg.DB.State(ctx, originRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get origin state with root %#x", originRoot)
+	}
+	if originState == nil || originState.IsNil() {
+		return errors.Errorf("origin state with root %#x not found", originRoot)
+	}
+	s.originEpoch = slots.ToEpoch(originState.Slot())
+	s.verifier = newVerifier(originState)
+	return nil
+}
+
+// backfillBatch requests the batch of blocks below the lowest backfilled block from peers, until one of them
+// provides valid blocks, and saves them.
+func (s *Service) backfillBatch() error {
+	ctx := s.ctx
+	if s.lowParent == s.genesisRoot {
+		return s.cfg.Status.Advance(ctx, s.cfg.Status.StartGap(), s.genesisRoot)
+	}
+	start := s.cfg.Status.StartGap() + 1
+	if s.searchEnd > start+primitives.Slot(s.cfg.BatchSize) {
+		start = s.searchEnd - primitives.Slot(s.cfg.BatchSize)
+	}
+	if start >= s.searchEnd {
+		// The parent of the lowest block was not found in the empty slots above genesis, the peers which
+		// reported them empty lied. Search again from the lowest block.
+		s.searchEnd = s.cfg.Status.EndGap()
+		return errors.Wrapf(errNotLinked, "parent %#x of the lowest block not found", s.lowParent)
+	}
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(s.searchEnd - start),
+		Step:      1,
+	}
+	if err := s.waitForBandwidth(req.Count); err != nil {
+		return err
+	}
+
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, s.originEpoch)
+	if len(pids) == 0 {
+		return errNoPeersAvailable
+	}
+	rand.NewGenerator().Shuffle(len(pids), func(i, j int) {
+		pids[i], pids[j] = pids[j], pids[i]
+	})
+	responded, invalid := false, false
+	for _, pid := range pids {
+		blks, err := s.requestBlocks(ctx, pid, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.WithError(err).WithField("peer", pid).Debug("Could not request blocks by range")
+			continue
+		}
+		if len(blks) == 0 {
+			responded = true
+			continue
+		}
+		if err := s.verify(blks); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Peer returned invalid blocks")
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			invalid = true
+			continue
+		}
+		return s.save(blks)
+	}
+	if invalid && s.searchEnd < s.cfg.Status.EndGap() {
+		// The blocks may not be linked because the peers which reported the slots above as empty lied.
+		s.searchEnd = s.cfg.Status.EndGap()
+	}
+	if !responded {
+		return errors.Wrapf(errNoPeersAvailable, "no peer returned blocks for slots [%d, %d)", start, s.searchEnd)
+	}
+	// Peers have no blocks to offer in the range, search below it.
+	s.searchEnd = start
+	return nil
+}
+
+func (s *Service) verify(blks []interfaces.ReadOnlySignedBeaconBlock) error {
+	if err := verifyLinked(blks, s.lowParent); err != nil {
+		return err
+	}
+	return s.verifier.verify(blks)
+}
+
+// save saves the blocks, which have been verified, and makes the lowest of them the new backfill position.
+func (s *Service) save(blks []interfaces.ReadOnlySignedBeaconBlock) error {
+	ctx := s.ctx
+	if err := s.cfg.DB.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.cfg.DB.SaveBackfillFinalizedBlockRoots(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not index backfilled blocks as finalized")
+	}
+	low := blks[0].Block()
+	lowRoot, err := low.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.Status.Advance(ctx, low.Slot(), lowRoot); err != nil {
+		return errors.Wrap(err, "could not advance backfill status")
+	}
+	s.lowParent = low.ParentRoot()
+	s.searchEnd = low.Slot()
+	backfillSlot.Set(float64(low.Slot()))
+	backfillBlocksCount.Add(float64(len(blks)))
+	log.WithFields(logrus.Fields{
+		"slot":   low.Slot(),
+		"blocks": len(blks),
+	}).Debug("Backfilled batch of blocks")
+	return nil
+}
+
+// waitForBandwidth waits until the rate limiter allows requesting the given number of blocks.
+func (s *Service) waitForBandwidth(count uint64) error {
+	for s.rateLimiter.Remaining(rateLimiterKey) < int64(count) {
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-time.After(s.rateLimiter.TillEmpty(rateLimiterKey)):
+		}
+	}
+	s.rateLimiter.Add(rateLimiterKey, int64(count))
+	return nil
+}
@@ -0,0 +1,271 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	coreblocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// testChain is a chain of signed blocks, with skipped slots, saved in a database as checkpoint sync would, with the
+// last block as the origin block.
+type testChain struct {
+	db          *kv.Store
+	genesisRoot [32]byte
+	blocks      []interfaces.ReadOnlySignedBeaconBlock
+	raw         []*ethpb.SignedBeaconBlock
+}
+
+func setupTestChain(t *testing.T) *testChain {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(t, 64)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis, err := blocks.NewSignedBeaconBlock(coreblocks.NewGenesisBlock(stateRoot[:]))
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var raw []*ethpb.SignedBeaconBlock
+	for _, slot := range []primitives.Slot{1, 2, 4, 5, 6, 9, 10, 11, 15, 16, 17} {
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		blks = append(blks, blk)
+		raw = append(raw, b)
+	}
+	require.Equal(t, genesisRoot, blks[0].Block().ParentRoot())
+
+	db, ok := dbtest.SetupDB(t).(*kv.Store)
+	require.Equal(t, true, ok)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	origin := blks[len(blks)-1]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, origin))
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: slots.ToEpoch(origin.Block().Slot()), Root: originRoot[:]}))
+	return &testChain{db: db, genesisRoot: genesisRoot, blocks: blks, raw: raw}
+}
+
+// tampered returns a copy of the block at the given index, modified by the given function.
+func (c *testChain) tampered(t *testing.T, i int, modify func(b *ethpb.SignedBeaconBlock)) interfaces.ReadOnlySignedBeaconBlock {
+	b := ethpb.CopySignedBeaconBlock(c.raw[i])
+	modify(b)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+// serve returns the blocks of the chain in the requested range, as an honest peer would.
+func (c *testChain) serve(req *ethpb.BeaconBlocksByRangeRequest) []interfaces.ReadOnlySignedBeaconBlock {
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for _, b := range c.blocks {
+		if b.Block().Slot() >= req.StartSlot && b.Block().Slot() < req.StartSlot.Add(req.Count) {
+			blks = append(blks, b)
+		}
+	}
+	return blks
+}
+
+func addPeer(t *testing.T, p *p2ptest.TestP2P, pid peer.ID) {
+	p.Peers().Add(new(enr.Record), pid, nil, network.DirOutbound)
+	p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	p.Peers().SetChainState(pid, &ethpb.Status{FinalizedEpoch: 10, HeadSlot: 1000})
+}
+
+func setupService(t *testing.T, c *testChain, p *p2ptest.TestP2P) *Service {
+	status := NewStatus(c.db)
+	require.NoError(t, status.Reload(context.Background()))
+	return NewService(context.Background(), &Config{
+		P2P:             p,
+		DB:              c.db,
+		InitialSync:     &mockSync.Sync{IsSynced: true},
+		Status:          status,
+		BatchSize:       4,
+		BlocksPerSecond: 1000,
+	})
+}
+
+type mockReconstructor struct {
+	calls int
+	err   error
+}
+
+func (r *mockReconstructor) Reconstruct(_ context.Context) error {
+	r.calls++
+	return r.err
+}
+
+func TestService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	c := setupTestChain(t)
+	p := p2ptest.NewTestP2P(t)
+	const honest, liar = peer.ID("honest"), peer.ID("liar")
+	addPeer(t, p, honest)
+	addPeer(t, p, liar)
+	s := setupService(t, c, p)
+	assert.Equal(t, c.blocks[len(c.blocks)-1].Block().Slot(), s.cfg.Status.EndGap())
+
+	s.requestBlocks = func(_ context.Context, pid peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		blks := c.serve(req)
+		if pid == liar && len(blks) > 0 {
+			// The lowest block was signed by someone else than its proposer.
+			i := 0
+			for c.blocks[i] != blks[0] {
+				i++
+			}
+			b := c.tampered(t, i, func(b *ethpb.SignedBeaconBlock) {
+				b.Signature = c.raw[i+1].Signature
+			})
+			return append([]interfaces.ReadOnlySignedBeaconBlock{b}, blks[1:]...), nil
+		}
+		return blks, nil
+	}
+	reconstructor := &mockReconstructor{}
+	s.cfg.StateReconstructor = reconstructor
+	s.Start()
+	require.NoError(t, s.Status())
+
+	assert.Equal(t, true, s.cfg.Status.Complete())
+	assert.Equal(t, 1, reconstructor.calls)
+	for _, b := range c.blocks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, c.db.HasBlock(ctx, root), "Block at slot %d was not backfilled", b.Block().Slot())
+		assert.Equal(t, true, c.db.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", b.Block().Slot())
+	}
+	assert.NotEqual(t, float64(0), p.Peers().Scorers().BadResponsesScorer().Score(liar))
+	assert.Equal(t, float64(0), p.Peers().Scorers().BadResponsesScorer().Score(honest))
+
+	// Backfill is complete after a restart.
+	status := NewStatus(c.db)
+	require.NoError(t, status.Reload(ctx))
+	assert.Equal(t, true, status.Complete())
+}
+
+func TestService_Backfill_Resume(t *testing.T) {
+	c := setupTestChain(t)
+	p := p2ptest.NewTestP2P(t)
+	addPeer(t, p, "peer")
+	s := setupService(t, c, p)
+	s.requestBlocks = func(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		return c.serve(req), nil
+	}
+	require.NoError(t, s.initialize())
+	// Slots [13, 17) hold blocks 15 and 16.
+	require.NoError(t, s.backfillBatch())
+	assert.Equal(t, primitives.Slot(15), s.cfg.Status.EndGap())
+	// Slots [11, 15) hold block 11.
+	require.NoError(t, s.backfillBatch())
+	assert.Equal(t, primitives.Slot(11), s.cfg.Status.EndGap())
+	assert.Equal(t, false, s.cfg.Status.SlotCovered(10))
+
+	// The backfill position survives a restart.
+	s = setupService(t, c, p)
+	assert.Equal(t, primitives.Slot(11), s.cfg.Status.EndGap())
+	s.requestBlocks = func(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		assert.Equal(t, true, req.StartSlot.Add(req.Count) <= 11, "Slot range [%d, %d) was already backfilled", req.StartSlot, req.StartSlot.Add(req.Count))
+		return c.serve(req), nil
+	}
+	s.Start()
+	assert.Equal(t, true, s.cfg.Status.Complete())
+	assert.Equal(t, true, s.cfg.Status.SlotCovered(10))
+}
+
+func TestService_ReconstructStates(t *testing.T) {
+	c := setupTestChain(t)
+	p := p2ptest.NewTestP2P(t)
+	s := setupService(t, c, p)
+	reconstructor := &mockReconstructor{}
+	s.cfg.StateReconstructor = reconstructor
+	// States are not reconstructed while blocks are missing.
+	s.cancel()
+	s.Start()
+	assert.Equal(t, 0, reconstructor.calls)
+
+	// Nothing is backfilled when the blocks are already available.
+	require.NoError(t, c.db.SaveBlocks(context.Background(), c.blocks))
+	require.NoError(t, s.cfg.Status.Advance(context.Background(), 0, c.genesisRoot))
+	require.Equal(t, true, s.cfg.Status.Complete())
+	s = setupService(t, c, p)
+	reconstructor = &mockReconstructor{err: errors.New("state root mismatch")}
+	s.cfg.StateReconstructor = reconstructor
+	s.Start()
+	assert.Equal(t, 1, reconstructor.calls)
+	require.ErrorContains(t, "state root mismatch", s.Status())
+}
+
+func TestService_Backfill_NoValidBatch(t *testing.T) {
+	c := setupTestChain(t)
+	p := p2ptest.NewTestP2P(t)
+	addPeer(t, p, "peer")
+	s := setupService(t, c, p)
+	s.requestBlocks = func(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		return nil, errors.New("stream reset")
+	}
+	require.NoError(t, s.initialize())
+	require.ErrorIs(t, s.backfillBatch(), errNoPeersAvailable)
+	assert.Equal(t, c.blocks[len(c.blocks)-1].Block().Slot(), s.cfg.Status.EndGap())
+
+	// Blocks which are not linked to the lowest backfilled block are rejected.
+	s.requestBlocks = func(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		return c.blocks[:2], nil
+	}
+	require.ErrorIs(t, s.backfillBatch(), errNoPeersAvailable)
+	assert.Equal(t, c.blocks[len(c.blocks)-1].Block().Slot(), s.cfg.Status.EndGap())
+	assert.NotEqual(t, float64(0), p.Peers().Scorers().BadResponsesScorer().Score("peer"))
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	c := setupTestChain(t)
+	origin := c.blocks[len(c.blocks)-1]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err := c.db.State(ctx, originRoot)
+	require.NoError(t, err)
+	v := newVerifier(st)
+	blks := c.blocks[:len(c.blocks)-1]
+
+	require.NoError(t, verifyLinked(blks, origin.Block().ParentRoot()))
+	require.NoError(t, v.verify(blks))
+
+	require.ErrorIs(t, verifyLinked(blks[1:], c.blocks[0].Block().ParentRoot()), errNotLinked)
+	require.ErrorIs(t, verifyLinked(blks[:len(blks)-1], origin.Block().ParentRoot()), errNotLinked)
+
+	b := c.tampered(t, 1, func(b *ethpb.SignedBeaconBlock) {
+		b.Signature = c.raw[0].Signature
+	})
+	require.ErrorIs(t, v.verify([]interfaces.ReadOnlySignedBeaconBlock{blks[0], b}), errInvalidSignature)
+
+	b = c.tampered(t, 1, func(b *ethpb.SignedBeaconBlock) {
+		b.Block.ProposerIndex = primitives.ValidatorIndex(len(v.keys))
+	})
+	require.ErrorIs(t, v.verify([]interfaces.ReadOnlySignedBeaconBlock{b}), errUnknownProposer)
+}
@@ -2,6 +2,7 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
@@ -19,25 +20,34 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. Backfill fills the gap backwards, walking the chain from the origin block
+// towards genesis. Status provides the means to update the value keeping track of the lowest block backfilled so far,
+// which is the upper end of the missing block range, via the Advance() method, to check whether a Slot is missing
+// from the database via the SlotCovered() method, and to see the current StartGap() and EndGap(). When the node prunes
+// its finalized history, Status also keeps track of the slot below which blocks were deleted, via the Prune() method.
 type Status struct {
+	lock        sync.RWMutex
 	start       primitives.Slot
 	end         primitives.Slot
+	earliest    primitives.Slot
 	store       BackfillDB
 	genesisSync bool
 }
 
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
-// If the slot is between StartGap() and EndGap(), the result is false.
+// If the slot is between StartGap() and EndGap(), or was pruned, the result is false.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.start < sl && sl < s.earliest {
+		return false
+	}
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,29 +55,87 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// EndGap returns the slot at the end of the range that needs to be backfilled, which is the slot of the lowest
+// block backfilled so far.
 func (s *Status) EndGap() primitives.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.end
 }
 
+// Complete is true if there is nothing left to backfill, either because the node was synced from genesis, or because
+// backfill reached genesis or the slot below which history is pruned.
+func (s *Status) Complete() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.genesisSync || s.end <= s.start || s.end <= s.earliest
+}
+
+// EarliestAvailableSlot returns the slot of the lowest block the node can serve, apart from the genesis block: the
+// lowest block backfilled so far while backfill is not complete, otherwise the slot below which history was pruned.
+func (s *Status) EarliestAvailableSlot() primitives.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.genesisSync && s.end > s.start && s.end > s.earliest {
+		return s.end
+	}
+	return s.earliest
+}
+
+// Prune records that the blocks below the given slot are deleted from the database. The given slot and root are those
+// of the lowest block kept. Backfill does not download the pruned blocks again: when the lowest block backfilled so
+// far is below the given slot, the backfill position moves up to the given block.
+func (s *Status) Prune(ctx context.Context, earliest primitives.Slot, root [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if earliest <= s.earliest {
+		return nil
+	}
+	if !s.genesisSync && s.end < earliest {
+		if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+			return err
+		}
+		s.end = earliest
+	}
+	if err := s.store.SaveEarliestAvailableSlot(ctx, earliest); err != nil {
+		return err
+	}
+	s.earliest = earliest
+	return nil
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
-// It updates the backfill block root entry in the database,
+// Advance advances the backfill position down to the given slot & root, which are those of the newly backfilled
+// lowest block. It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
 func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, backfill slot=%d", upTo, s.end)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	s.end = upTo
+	return nil
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	earliest, err := s.store.EarliestAvailableSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get earliest available slot")
+	}
+	s.earliest = earliest
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,15 +152,22 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
 		}
 		return err
 	}
+	genesisBlock, err := s.store.Block(ctx, genesisRoot)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving block for genesis root=%#x", genesisRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(genesisBlock); err != nil {
+		return err
+	}
+	s.start = genesisBlock.Block().Slot()
 
 	bfRoot, err := s.store.BackfillBlockRoot(ctx)
 	if err != nil {
@@ -101,6 +176,13 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	// Databases initialized before backfill walked the chain backwards saved the genesis root as the backfill root,
+	// which now means that backfill is complete. Backfill starts from the origin block for those, unless the origin
+	// block is linked to its parent.
+	if bfRoot == genesisRoot && bfRoot != cpRoot && !s.store.HasBlock(ctx, cpBlock.Block().ParentRoot()) {
+		s.end = cpBlock.Block().Slot()
+		return s.store.SaveBackfillBlockRoot(ctx, cpRoot)
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,7 +190,7 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.end = bfBlock.Block().Slot()
 	return nil
 }
 
@@ -119,4 +201,7 @@ type BackfillDB interface {
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+	HasBlock(ctx context.Context, blockRoot [32]byte) bool
+	EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error)
+	SaveEarliestAvailableSlot(ctx context.Context, slot primitives.Slot) error
 }
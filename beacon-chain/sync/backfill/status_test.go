@@ -9,7 +9,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
@@ -23,6 +22,9 @@ type mockBackfillDB struct {
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+	hasBlock                  func(ctx context.Context, blockRoot [32]byte) bool
+	earliestAvailableSlot     func(ctx context.Context) (primitives.Slot, error)
+	saveEarliestAvailableSlot func(ctx context.Context, slot primitives.Slot) error
 }
 
 var _ BackfillDB = &mockBackfillDB{}
@@ -62,6 +64,27 @@ func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interf
 	return nil, errEmptyMockDBMethod
 }
 
+func (db *mockBackfillDB) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
+	if db.hasBlock != nil {
+		return db.hasBlock(ctx, blockRoot)
+	}
+	return false
+}
+
+func (db *mockBackfillDB) EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error) {
+	if db.earliestAvailableSlot != nil {
+		return db.earliestAvailableSlot(ctx)
+	}
+	return 0, nil
+}
+
+func (db *mockBackfillDB) SaveEarliestAvailableSlot(ctx context.Context, slot primitives.Slot) error {
+	if db.saveEarliestAvailableSlot != nil {
+		return db.saveEarliestAvailableSlot(ctx, slot)
+	}
+	return errEmptyMockDBMethod
+}
+
 func TestSlotCovered(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -105,6 +128,24 @@ func TestSlotCovered(t *testing.T) {
 			slot:   100,
 			result: true,
 		},
+		{
+			name:   "pruned false",
+			status: &Status{genesisSync: true, earliest: 50},
+			slot:   49,
+			result: false,
+		},
+		{
+			name:   "genesis not pruned",
+			status: &Status{genesisSync: true, earliest: 50},
+			slot:   0,
+			result: true,
+		},
+		{
+			name:   "earliest available true",
+			status: &Status{genesisSync: true, earliest: 50},
+			slot:   50,
+			result: true,
+		},
 	}
 	for _, c := range cases {
 		result := c.status.SlotCovered(c.slot)
@@ -126,8 +167,10 @@ func TestAdvance(t *testing.T) {
 	copy(root[:], []byte{0x23, 0x23})
 	require.NoError(t, s.Advance(ctx, 90, root))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	// backfill walks backwards, the slots above the new backfill position are covered, not those below
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(85))
+	require.Equal(t, primitives.Slot(90), s.EndGap())
 
 	// this should still be len 1 after failing to advance
 	require.Equal(t, 1, len(saveBackfillBuf))
@@ -136,6 +179,49 @@ func TestAdvance(t *testing.T) {
 	require.Equal(t, 1, len(saveBackfillBuf))
 }
 
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	var backfillRoot [32]byte
+	var earliest primitives.Slot
+	mdb := &mockBackfillDB{
+		saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
+			backfillRoot = root
+			return nil
+		},
+		saveEarliestAvailableSlot: func(ctx context.Context, slot primitives.Slot) error {
+			earliest = slot
+			return nil
+		},
+	}
+	s := &Status{end: 100, store: mdb}
+	require.Equal(t, false, s.Complete())
+	require.Equal(t, primitives.Slot(100), s.EarliestAvailableSlot())
+
+	// Pruning below the lowest backfilled block leaves backfill running.
+	require.NoError(t, s.Prune(ctx, 40, [32]byte{0x01}))
+	require.Equal(t, primitives.Slot(40), earliest)
+	require.Equal(t, [32]byte{}, backfillRoot)
+	require.Equal(t, false, s.Complete())
+	require.Equal(t, primitives.Slot(100), s.EarliestAvailableSlot())
+	require.Equal(t, false, s.SlotCovered(30))
+
+	// Backfill stops once it reaches the pruned history.
+	require.NoError(t, s.Advance(ctx, 40, [32]byte{0x02}))
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, primitives.Slot(40), s.EarliestAvailableSlot())
+
+	// Pruning above the lowest backfilled block moves the backfill position up.
+	require.NoError(t, s.Prune(ctx, 60, [32]byte{0x03}))
+	require.Equal(t, [32]byte{0x03}, backfillRoot)
+	require.Equal(t, primitives.Slot(60), s.EndGap())
+	require.Equal(t, primitives.Slot(60), s.EarliestAvailableSlot())
+	require.Equal(t, true, s.Complete())
+
+	// The earliest available slot never decreases.
+	require.NoError(t, s.Prune(ctx, 50, [32]byte{0x04}))
+	require.Equal(t, primitives.Slot(60), earliest)
+}
+
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	return func(ctx context.Context) ([32]byte, error) {
 		return root, nil
@@ -163,10 +249,26 @@ func TestReload(t *testing.T) {
 
 	backfillSlot := primitives.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
+	copy(backfillRoot[:], []byte{0x02})
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
+	var genesisRoot [32]byte
+	copy(genesisRoot[:], []byte{0x03})
+	genesisBlock, err := setupTestBlock(0)
+	require.NoError(t, err)
+	blockByRoot := func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+		switch root {
+		case originRoot:
+			return originBlock, nil
+		case backfillRoot:
+			return backfillBlock, nil
+		case genesisRoot:
+			return genesisBlock, nil
+		}
+		return nil, errors.New("not derp")
+	}
+
 	cases := []struct {
 		name     string
 		db       BackfillDB
@@ -320,21 +422,44 @@ func TestReload(t *testing.T) {
 		{
 			name: "complete happy path",
 			db: &mockBackfillDB{
-				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
 				originCheckpointBlockRoot: goodBlockRoot(originRoot),
-				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
-					switch root {
-					case originRoot:
-						return originBlock, nil
-					case backfillRoot:
-						return backfillBlock, nil
+				block:                     blockByRoot,
+				backfillBlockRoot:         goodBlockRoot(backfillRoot),
+			},
+			err:      derp,
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot},
+		},
+		{
+			name: "backfill reached genesis",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block:                     blockByRoot,
+				backfillBlockRoot:         goodBlockRoot(genesisRoot),
+				hasBlock: func(ctx context.Context, root [32]byte) bool {
+					return true
+				},
+			},
+			err:      derp,
+			expected: &Status{genesisSync: false, start: 0, end: 0},
+		},
+		{
+			name: "genesis backfill root saved before backfill walked backwards",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block:                     blockByRoot,
+				backfillBlockRoot:         goodBlockRoot(genesisRoot),
+				saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
+					if root != originRoot {
+						return errors.New("backfill root should be reset to the origin root")
 					}
-					return nil, errors.New("not derp")
+					return nil
 				},
-				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: originSlot},
 		},
 	}
 
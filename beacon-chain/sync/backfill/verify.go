@@ -0,0 +1,105 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+var (
+	errNotLinked        = errors.New("blocks are not linked to the lowest backfilled block")
+	errUnknownProposer  = errors.New("proposer is not in the validator set")
+	errInvalidSignature = errors.New("invalid proposer signature")
+)
+
+// verifyLinked checks that the blocks, ordered by slot, form a chain whose last block is the block with the given
+// root, which is the parent of the lowest block backfilled so far.
+func verifyLinked(blks []interfaces.ReadOnlySignedBeaconBlock, parentRoot [32]byte) error {
+	expected := parentRoot
+	for i := len(blks) - 1; i >= 0; i-- {
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if root != expected {
+			return errors.Wrapf(errNotLinked, "block at slot %d has root %#x, expected %#x", blks[i].Block().Slot(), root, expected)
+		}
+		expected = blks[i].Block().ParentRoot()
+	}
+	return nil
+}
+
+// verifier checks the proposer signatures of backfilled blocks. The validator set of the origin state is a superset
+// of the validator sets of the blocks below the origin, and validator public keys never change, so the origin state
+// is all that is needed to verify the signature of any historical block.
+type verifier struct {
+	keys                  [][fieldparams.BLSPubkeyLength]byte
+	genesisValidatorsRoot [32]byte
+	domains               map[[4]byte][]byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState) *verifier {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, st.NumValidators())
+	for i := range keys {
+		keys[i] = st.PubkeyAtIndex(primitives.ValidatorIndex(i))
+	}
+	return &verifier{
+		keys:                  keys,
+		genesisValidatorsRoot: bytesutil.ToBytes32(st.GenesisValidatorsRoot()),
+		domains:               make(map[[4]byte][]byte),
+	}
+}
+
+// verify batch verifies the proposer signatures of the blocks.
+func (v *verifier) verify(blks []interfaces.ReadOnlySignedBeaconBlock) error {
+	set := bls.NewSet()
+	for _, b := range blks {
+		idx := b.Block().ProposerIndex()
+		if uint64(idx) >= uint64(len(v.keys)) {
+			return errors.Wrapf(errUnknownProposer, "proposer index %d of block at slot %d", idx, b.Block().Slot())
+		}
+		domain, err := v.domain(slots.ToEpoch(b.Block().Slot()))
+		if err != nil {
+			return err
+		}
+		sig := b.Signature()
+		batch, err := signing.BlockSignatureBatch(v.keys[idx][:], sig[:], domain, b.Block().HashTreeRoot)
+		if err != nil {
+			return err
+		}
+		set.Join(batch)
+	}
+	ok, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(errInvalidSignature, err.Error())
+	}
+	if !ok {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func (v *verifier) domain(epoch primitives.Epoch) ([]byte, error) {
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	version := bytesutil.ToBytes4(fork.CurrentVersion)
+	if d, ok := v.domains[version]; ok {
+		return d, nil
+	}
+	d, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, v.genesisValidatorsRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	v.domains[version] = d
+	return d, nil
+}
@@ -80,8 +80,9 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		return err
 	}
 
-	for data := range queue.fetchedData {
-		s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
+	// Segments are verified concurrently ahead of the state transition, which consumes them in order.
+	for seg := range s.verifySegments(ctx, queue.fetchedData) {
+		s.processVerifiedSegment(ctx, genesis, s.cfg.Chain.HeadSlot(), seg)
 	}
 
 	log.WithFields(logrus.Fields{
@@ -122,13 +123,22 @@ func (s *Service) syncToNonFinalizedEpoch(ctx context.Context, genesis time.Time
 	return nil
 }
 
-// processFetchedData processes data received from queue.
-func (s *Service) processFetchedData(
-	ctx context.Context, genesis time.Time, startSlot primitives.Slot, data *blocksQueueFetchedData) {
-	defer s.updatePeerScorerStats(data.pid, startSlot)
+// processVerifiedSegment processes a segment verified ahead of the state transition.
+func (s *Service) processVerifiedSegment(
+	ctx context.Context, genesis time.Time, startSlot primitives.Slot, seg *verifiedSegment) {
+	defer s.updatePeerScorerStats(seg.pid, startSlot)
 
-	// Use Batch Block Verify to process and verify batches directly.
-	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, s.cfg.Chain.ReceiveBlockBatch); err != nil {
+	if seg.err != nil {
+		log.WithError(seg.err).WithField("peer", seg.pid).Warn("Skip processing invalid segment")
+		return
+	}
+	// Use Batch Block Verify to process and verify batches directly, except for the signatures
+	// already verified.
+	bFunc := s.cfg.Chain.ReceiveBlockBatch
+	if seg.sigsVerified {
+		bFunc = s.cfg.Chain.ReceiveVerifiedBlockBatch
+	}
+	if err := s.processLinearBlocks(ctx, genesis, seg.blocks, seg.roots, bFunc); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
 	}
 }
@@ -249,42 +259,45 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	if len(blks) == 0 {
 		return errors.New("0 blocks provided into method")
 	}
-	firstBlock := blks[0]
-	blkRoot, err := firstBlock.Block().HashTreeRoot()
-	if err != nil {
-		return err
+	blockRoots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		blkRoot, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if i > 0 && b.Block().ParentRoot() != blockRoots[i-1] {
+			return fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
+				blockRoots[i-1][:], b.Block().ParentRoot())
+		}
+		blockRoots[i] = blkRoot
+	}
+	return s.processLinearBlocks(ctx, genesis, blks, blockRoots, bFunc)
+}
+
+// processLinearBlocks skips the blocks of a linear batch that are already processed, checks that we have
+// the parent of the remaining ones, and triggers the batch receiver function.
+func (s *Service) processLinearBlocks(ctx context.Context, genesis time.Time,
+	blks []interfaces.ReadOnlySignedBeaconBlock, blockRoots [][32]byte, bFunc batchBlockReceiverFn) error {
+	if len(blks) == 0 {
+		return errors.New("0 blocks provided into method")
+	}
+	if len(blks) != len(blockRoots) {
+		return fmt.Errorf("got %d block roots for %d blocks", len(blockRoots), len(blks))
 	}
 	headSlot := s.cfg.Chain.HeadSlot()
-	for headSlot >= firstBlock.Block().Slot() && s.isProcessedBlock(ctx, firstBlock, blkRoot) {
+	for headSlot >= blks[0].Block().Slot() && s.isProcessedBlock(ctx, blks[0], blockRoots[0]) {
 		if len(blks) == 1 {
-			return fmt.Errorf("headSlot:%d, blockSlot:%d , root %#x:%w", headSlot, firstBlock.Block().Slot(), blkRoot, errBlockAlreadyProcessed)
+			return fmt.Errorf("headSlot:%d, blockSlot:%d , root %#x:%w", headSlot, blks[0].Block().Slot(), blockRoots[0], errBlockAlreadyProcessed)
 		}
 		blks = blks[1:]
-		firstBlock = blks[0]
-		blkRoot, err = firstBlock.Block().HashTreeRoot()
-		if err != nil {
-			return err
-		}
+		blockRoots = blockRoots[1:]
 	}
-	s.logBatchSyncStatus(genesis, blks, blkRoot)
+	firstBlock := blks[0]
+	s.logBatchSyncStatus(genesis, blks, blockRoots[0])
 	parentRoot := firstBlock.Block().ParentRoot()
 	if !s.cfg.Chain.HasBlock(ctx, parentRoot) {
 		return fmt.Errorf("%w: %#x (in processBatchedBlocks, slot=%d)", errParentDoesNotExist, firstBlock.Block().ParentRoot(), firstBlock.Block().Slot())
 	}
-	blockRoots := make([][32]byte, len(blks))
-	blockRoots[0] = blkRoot
-	for i := 1; i < len(blks); i++ {
-		b := blks[i]
-		if b.Block().ParentRoot() != blockRoots[i-1] {
-			return fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
-				blockRoots[i-1][:], b.Block().ParentRoot())
-		}
-		blkRoot, err := b.Block().HashTreeRoot()
-		if err != nil {
-			return err
-		}
-		blockRoots[i] = blkRoot
-	}
 	return bFunc(ctx, blks, blockRoots)
 }
 
@@ -0,0 +1,138 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/sirupsen/logrus"
+)
+
+// segmentVerifiers is the maximum number of fetched segments verified concurrently, ahead of
+// the state transition.
+const segmentVerifiers = 4
+
+// verifiedSegment is a batch of blocks fetched from a peer, checked to form a linear chain, with
+// its block roots. Its proposer signatures and randao reveals are verified ahead of the state
+// transition when possible.
+type verifiedSegment struct {
+	pid    peer.ID
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+	roots  [][32]byte
+	// sigsVerified is false when the proposer signatures could not be verified ahead of the
+	// state transition, in which case it verifies them as usual.
+	sigsVerified bool
+	err          error
+}
+
+// verifySegments verifies the fetched batches concurrently, and returns them in the order they
+// were fetched. The returned channel is closed once the fetched data channel is.
+func (s *Service) verifySegments(ctx context.Context, fetched <-chan *blocksQueueFetchedData) <-chan *verifiedSegment {
+	// The verifications in flight, in fetch order. The buffer bounds how far ahead we verify.
+	pending := make(chan chan *verifiedSegment, segmentVerifiers)
+	verified := make(chan *verifiedSegment)
+	go func() {
+		defer close(pending)
+		for data := range fetched {
+			result := make(chan *verifiedSegment, 1)
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			go func(data *blocksQueueFetchedData) {
+				result <- s.verifySegment(ctx, data)
+			}(data)
+		}
+	}()
+	go func() {
+		defer close(verified)
+		for result := range pending {
+			select {
+			case verified <- <-result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return verified
+}
+
+// verifySegment computes the block roots of a fetched batch, checks that the blocks form a linear
+// chain, and verifies their proposer signatures and randao reveals in a single signature batch.
+func (s *Service) verifySegment(ctx context.Context, data *blocksQueueFetchedData) *verifiedSegment {
+	seg := &verifiedSegment{
+		pid:    data.pid,
+		blocks: data.blocks,
+		roots:  make([][32]byte, len(data.blocks)),
+	}
+	if len(data.blocks) == 0 {
+		seg.err = errors.New("0 blocks provided into method")
+		return seg
+	}
+	// The public keys of the proposers are read from the head state, as the public key of a
+	// validator index never changes. Proposers unknown to the head state are left to the
+	// state transition.
+	headState, err := s.cfg.Chain.HeadStateReadOnly(ctx)
+	if err != nil || headState == nil || headState.IsNil() {
+		headState = nil
+	}
+	gvr := s.cfg.Chain.GenesisValidatorsRoot()
+	set := bls.NewSet()
+	seg.sigsVerified = headState != nil
+	for i, blk := range data.blocks {
+		if seg.sigsVerified {
+			blkSet, root, err := proposerSignatureBatch(headState, blk, gvr)
+			if err != nil {
+				log.WithError(err).WithField("slot", blk.Block().Slot()).Debug("Could not verify proposer signature ahead of the state transition")
+				seg.sigsVerified = false
+			} else {
+				set.Join(blkSet)
+				seg.roots[i] = root
+			}
+		}
+		if !seg.sigsVerified {
+			seg.roots[i], err = blk.Block().HashTreeRoot()
+			if err != nil {
+				seg.err = err
+				return seg
+			}
+		}
+		if i > 0 && blk.Block().ParentRoot() != seg.roots[i-1] {
+			seg.err = fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
+				seg.roots[i-1][:], blk.Block().ParentRoot())
+			return seg
+		}
+	}
+	if !seg.sigsVerified {
+		return seg
+	}
+	verified, err := set.Verify()
+	if err != nil || !verified {
+		// The state transition verifies the signatures again, and rejects the segment if they are invalid.
+		log.WithError(err).WithFields(logrus.Fields{
+			"peer":      data.pid,
+			"firstSlot": data.blocks[0].Block().Slot(),
+		}).Debug("Could not verify segment signatures ahead of the state transition")
+		seg.sigsVerified = false
+	}
+	return seg
+}
+
+// proposerSignatureBatch retrieves the signature batch of the proposer signature and the randao reveal of
+// a block, along with its root.
+func proposerSignatureBatch(
+	st state.ReadOnlyBeaconState, blk interfaces.ReadOnlySignedBeaconBlock, gvr [32]byte,
+) (*bls.SignatureBatch, [32]byte, error) {
+	proposerIndex := blk.Block().ProposerIndex()
+	if uint64(proposerIndex) >= uint64(st.NumValidators()) {
+		return nil, [32]byte{}, fmt.Errorf("proposer %d is unknown to the head state", proposerIndex)
+	}
+	pubKey := st.PubkeyAtIndex(proposerIndex)
+	return blocks.ProposerSignatureBatchUsingCurrentFork(blk, pubKey[:], gvr[:])
+}
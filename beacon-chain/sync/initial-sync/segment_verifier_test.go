@@ -0,0 +1,255 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/paulbellamy/ratecounter"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	p2pt "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestService_verifySegments(t *testing.T) {
+	ctx := context.Background()
+	genesis, keys := util.DeterministicGenesisState(t, 64)
+	st := genesis.Copy()
+	var pbs []*eth.SignedBeaconBlock
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var roots [][32]byte
+	for i := 1; i <= 8; i++ {
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), primitives.Slot(i))
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		pbs = append(pbs, b)
+		blks = append(blks, wsb)
+		roots = append(roots, root)
+	}
+	s := &Service{
+		ctx: ctx,
+		cfg: &Config{
+			Chain: &mock.ChainService{
+				State:          genesis,
+				ValidatorsRoot: bytesutil.ToBytes32(genesis.GenesisValidatorsRoot()),
+			},
+		},
+	}
+
+	badSig, err := blocks.NewSignedBeaconBlock(&eth.SignedBeaconBlock{
+		Block:     pbs[5].Block,
+		Signature: keys[0].Sign([]byte("bad")).Marshal(),
+	})
+	require.NoError(t, err)
+	nonLinear := []interfaces.ReadOnlySignedBeaconBlock{blks[0], blks[2]}
+
+	fetched := make(chan *blocksQueueFetchedData, 4)
+	fetched <- &blocksQueueFetchedData{pid: "a", blocks: blks[:4]}
+	fetched <- &blocksQueueFetchedData{pid: "b", blocks: []interfaces.ReadOnlySignedBeaconBlock{blks[4], badSig, blks[6]}}
+	fetched <- &blocksQueueFetchedData{pid: "c", blocks: nonLinear}
+	fetched <- &blocksQueueFetchedData{pid: "d", blocks: blks[7:]}
+	close(fetched)
+
+	var segs []*verifiedSegment
+	for seg := range s.verifySegments(ctx, fetched) {
+		segs = append(segs, seg)
+	}
+	require.Equal(t, 4, len(segs))
+	for i, pid := range []peer.ID{"a", "b", "c", "d"} {
+		assert.Equal(t, pid, segs[i].pid, "Segments are not in fetch order")
+	}
+
+	assert.NoError(t, segs[0].err)
+	assert.Equal(t, true, segs[0].sigsVerified)
+	assert.DeepEqual(t, roots[:4], segs[0].roots)
+
+	// Invalid signatures are left to the state transition to reject.
+	assert.NoError(t, segs[1].err)
+	assert.Equal(t, false, segs[1].sigsVerified)
+	assert.DeepEqual(t, roots[4:7], segs[1].roots)
+
+	assert.ErrorContains(t, fmt.Sprintf("expected linear block list with parent root of %#x", roots[0]), segs[2].err)
+
+	assert.NoError(t, segs[3].err)
+	assert.Equal(t, true, segs[3].sigsVerified)
+}
+
+func TestService_verifySegment_UnknownProposer(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Service{
+		ctx: context.Background(),
+		cfg: &Config{Chain: &mock.ChainService{State: st}},
+	}
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 1
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	seg := s.verifySegment(context.Background(), &blocksQueueFetchedData{blocks: []interfaces.ReadOnlySignedBeaconBlock{wsb}})
+	require.NoError(t, seg.err)
+	assert.Equal(t, false, seg.sigsVerified)
+	assert.DeepEqual(t, [][32]byte{root}, seg.roots)
+}
+
+// recordedBlocksPath is an SSZ list of the first 128 blocks of a chain started from the deterministic genesis state
+// of 64 validators, as generated by util.GenerateFullBlock with the default config.
+const recordedBlocksPath = "testdata/recorded_blocks.ssz"
+
+func recordedBlocks(tb testing.TB) []interfaces.ReadOnlySignedBeaconBlock {
+	enc, err := os.ReadFile(recordedBlocksPath)
+	require.NoError(tb, err)
+	num, err := ssz.DecodeDynamicLength(enc, 1024)
+	require.NoError(tb, err)
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, num)
+	require.NoError(tb, ssz.UnmarshalDynamic(enc, num, func(i int, buf []byte) error {
+		blk := &eth.SignedBeaconBlock{}
+		if err := blk.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		blks[i], err = blocks.NewSignedBeaconBlock(blk)
+		return err
+	}))
+	return blks
+}
+
+// BenchmarkService_ProcessSegments_Replay replays recorded blocks through the state transition in the segments
+// fetched by initial sync, either verifying every signature in the state transition, or verifying the proposer
+// signatures of the next segments concurrently with it.
+func BenchmarkService_ProcessSegments_Replay(b *testing.B) {
+	const segmentSize = 16
+	// The database holds the embedded genesis state of the network of a known config name.
+	params.SetupTestConfigCleanup(b)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	genesis, _ := util.DeterministicGenesisState(b, 64)
+	blks := recordedBlocks(b)
+	lastSlot := blks[len(blks)-1].Block().Slot()
+	fetched := func() []*blocksQueueFetchedData {
+		data := make([]*blocksQueueFetchedData, 0, len(blks)/segmentSize+1)
+		for i := 0; i < len(blks); i += segmentSize {
+			end := i + segmentSize
+			if end > len(blks) {
+				end = len(blks)
+			}
+			data = append(data, &blocksQueueFetchedData{blocks: blks[i:end]})
+		}
+		return data
+	}
+
+	b.Run("full verification", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			s, chain, closeDB := newReplayService(b, genesis)
+			b.StartTimer()
+			for _, data := range fetched() {
+				seg := &verifiedSegment{blocks: data.blocks, roots: make([][32]byte, len(data.blocks))}
+				for j, blk := range data.blocks {
+					root, err := blk.Block().HashTreeRoot()
+					require.NoError(b, err)
+					seg.roots[j] = root
+				}
+				s.processVerifiedSegment(ctx, time.Unix(0, 0), chain.HeadSlot(), seg)
+			}
+			b.StopTimer()
+			require.Equal(b, lastSlot, chain.HeadSlot())
+			closeDB()
+			b.StartTimer()
+		}
+	})
+	b.Run("concurrent segment verification", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			s, chain, closeDB := newReplayService(b, genesis)
+			b.StartTimer()
+			// The segments are fed as the blocks queue does, verifySegments keeping at most segmentVerifiers of them
+			// in flight ahead of the state transition.
+			queue := make(chan *blocksQueueFetchedData, 1)
+			go func() {
+				defer close(queue)
+				for _, data := range fetched() {
+					queue <- data
+				}
+			}()
+			for seg := range s.verifySegments(ctx, queue) {
+				require.Equal(b, true, seg.sigsVerified)
+				s.processVerifiedSegment(ctx, time.Unix(0, 0), chain.HeadSlot(), seg)
+			}
+			b.StopTimer()
+			require.Equal(b, lastSlot, chain.HeadSlot())
+			closeDB()
+			b.StartTimer()
+		}
+	})
+}
+
+// newReplayService returns an initial sync service backed by a blockchain service started from the genesis state.
+// The returned function closes its database, which would otherwise stay open until the benchmark ends.
+func newReplayService(b *testing.B, genesis state.BeaconState) (*Service, *blockchain.Service, func()) {
+	ctx := context.Background()
+	beaconDB, err := kv.NewKVStore(ctx, b.TempDir())
+	require.NoError(b, err)
+	require.NoError(b, beaconDB.SaveGenesisData(ctx, genesis.Copy()))
+	attService, err := attestations.NewService(ctx, &attestations.Config{Pool: attestations.NewPool()})
+	require.NoError(b, err)
+	fc := doublylinkedtree.New()
+	chain, err := blockchain.NewService(ctx,
+		blockchain.WithDatabase(beaconDB),
+		blockchain.WithStateGen(stategen.New(beaconDB, fc)),
+		blockchain.WithForkChoiceStore(fc),
+		blockchain.WithAttestationService(attService),
+		blockchain.WithStateNotifier(&mock.MockStateNotifier{}),
+		blockchain.WithFinalizedStateAtStartUp(genesis.Copy()),
+	)
+	require.NoError(b, err)
+	require.NoError(b, chain.StartFromSavedState(genesis.Copy()))
+	s := &Service{
+		ctx:     ctx,
+		cfg:     &Config{Chain: chain, P2P: &replayP2P{FakeP2P: p2pt.NewFuzzTestP2P(), peers: peers.NewStatus(ctx, &peers.StatusConfig{ScorerParams: &scorers.Config{}})}},
+		counter: ratecounter.NewRateCounter(counterSeconds * time.Second),
+	}
+	return s, chain, func() {
+		require.NoError(b, beaconDB.Close())
+	}
+}
+
+// replayP2P is a p2p service without peers, as the batch sync status logs count the connected ones.
+type replayP2P struct {
+	*p2pt.FakeP2P
+	peers *peers.Status
+}
+
+// Peers returns the empty peer statuses.
+func (p *replayP2P) Peers() *peers.Status {
+	return p.peers
+}
@@ -130,6 +130,15 @@ func WithSlasherBlockHeadersFeed(slasherBlockHeadersFeed *event.Feed) Option {
 	}
 }
 
+// WithAvailableBlocker makes the node reply to block range requests below the earliest available slot with a
+// resource unavailable error, rather than an incomplete range.
+func WithAvailableBlocker(avb AvailableBlocker) Option {
+	return func(s *Service) error {
+		s.cfg.availableBlocker = avb
+		return nil
+	}
+}
+
 func WithExecutionPayloadReconstructor(r execution.ExecutionPayloadReconstructor) Option {
 	return func(s *Service) error {
 		s.cfg.executionPayloadReconstructor = r
@@ -44,6 +44,20 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	if m.Step > 1 {
 		m.Step = 1
 	}
+	if s.cfg.availableBlocker != nil {
+		// Blocks before the earliest available slot were pruned. A range entirely before it cannot be served, while
+		// a range straddling it is served from the earliest available slot on.
+		if earliest := s.cfg.availableBlocker.EarliestAvailableSlot(); m.StartSlot < earliest {
+			if m.StartSlot.Add(m.Count-1) < earliest {
+				s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+				err := errors.Wrapf(p2ptypes.ErrResourceUnavailable, "end slot %d, earliest available slot %d", m.StartSlot.Add(m.Count-1), earliest)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			m.Count -= uint64(earliest - m.StartSlot)
+			m.StartSlot = earliest
+		}
+	}
 	// The initial count for the first batch to be returned back.
 	count := m.Count
 	allowedBlocksPerSecond := uint64(flags.Get().BlockBatchLimit)
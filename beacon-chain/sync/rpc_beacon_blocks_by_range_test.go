@@ -162,6 +162,91 @@ func TestRPCBeaconBlocksByRange_ReturnCorrectNumberBack(t *testing.T) {
 	}
 }
 
+type mockAvailableBlocker primitives.Slot
+
+func (m mockAvailableBlocker) EarliestAvailableSlot() primitives.Slot {
+	return primitives.Slot(m)
+}
+
+func TestRPCBeaconBlocksByRange_ResourceUnavailableBelowEarliestSlot(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: &chainMock.ChainService{}, availableBlocker: mockAvailableBlocker(100)}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(0.000001, 640, time.Second, false)
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	req := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 90, Step: 1, Count: 10}
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	err = r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1)
+	require.ErrorIs(t, err, p2ptypes.ErrResourceUnavailable)
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestRPCBeaconBlocksByRange_StraddlesEarliestSlot(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	// The blocks before the earliest available slot are still in the database, so that serving them would show.
+	var parentRoot [32]byte
+	for i := primitives.Slot(90); i < 110; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = parentRoot[:]
+		util.SaveBlock(t, context.Background(), d, blk)
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = root
+	}
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: &chainMock.ChainService{}, availableBlocker: mockAvailableBlocker(100)}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(0.000001, 640, time.Second, false)
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	req := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 95, Step: 1, Count: 10}
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i := primitives.Slot(100); i < 105; i++ {
+			expectSuccess(t, stream)
+			res := util.NewBeaconBlock()
+			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, i, res.Block.Slot)
+		}
+		b := make([]byte, 1)
+		_, err := stream.Read(b)
+		require.ErrorContains(t, io.EOF.Error(), err)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_ReconstructsPayloads(t *testing.T) {
 	p1 := p2ptest.NewTestP2P(t)
 	p2 := p2ptest.NewTestP2P(t)
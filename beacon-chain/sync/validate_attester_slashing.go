@@ -6,6 +6,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -57,6 +59,16 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 	s.cfg.chain.ReceiveAttesterSlashing(ctx, slashing)
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
+
+	// Broadcast the attester slashing on a feed to notify other services in the beacon node
+	// of a received attester slashing.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.AttesterSlashingReceived,
+		Data: &opfeed.AttesterSlashingReceivedData{
+			AttesterSlashing: slashing,
+		},
+	})
+
 	return pubsub.ValidationAccept, nil
 }
 
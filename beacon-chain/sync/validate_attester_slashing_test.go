@@ -81,9 +81,10 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s, Genesis: time.Now()},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s, Genesis: time.Now()},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 		subHandler:                newSubTopicHandler(),
@@ -117,9 +118,10 @@ func TestValidateAttesterSlashing_CanFilter(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			initialSync: &mockSync.Sync{IsSyncing: false},
-			chain:       &mock.ChainService{Genesis: time.Now()},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			initialSync:       &mockSync.Sync{IsSyncing: false},
+			chain:             &mock.ChainService{Genesis: time.Now()},
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 		subHandler:                newSubTopicHandler(),
@@ -186,9 +188,10 @@ func TestValidateAttesterSlashing_ContextTimeout(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 	}
@@ -218,9 +221,10 @@ func TestValidateAttesterSlashing_Syncing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s},
-			initialSync: &mockSync.Sync{IsSyncing: true},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s},
+			initialSync:       &mockSync.Sync{IsSyncing: true},
 		},
 	}
 
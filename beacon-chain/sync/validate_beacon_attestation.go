@@ -87,8 +87,8 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject, err
 	}
 
-	if features.Get().EnableSlasher {
-		// Feed the indexed attestation to slasher if enabled. This action
+	if features.Get().EnableSlasher || features.Get().EnableSlasherFeed {
+		// Feed the indexed attestation to slasher or its feed if enabled. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
 			// Using a different context to prevent timeouts as this operation can be expensive
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
@@ -78,8 +79,8 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		},
 	})
 
-	if features.Get().EnableSlasher {
-		// Feed the block header to slasher if enabled. This action
+	if features.Get().EnableSlasher || features.Get().EnableSlasherFeed {
+		// Feed the block header to slasher or its feed if enabled. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
 			blockHeader, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
@@ -207,6 +208,15 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"graffiti":           string(graffiti[:]),
 	}).Debug("Received block")
 
+	// Broadcast the block on a feed to notify other services in the beacon node
+	// of a block which passed gossip validation, ahead of its import.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.BlockGossipReceived,
+		Data: &opfeed.BlockGossipReceivedData{
+			SignedBlock: blk,
+		},
+	})
+
 	blockVerificationGossipSummary.Observe(float64(prysmTime.Since(receivedTime).Milliseconds()))
 	return pubsub.ValidationAccept, nil
 }
@@ -6,6 +6,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -56,6 +58,16 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 	}
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
+
+	// Broadcast the proposer slashing on a feed to notify other services in the beacon node
+	// of a received proposer slashing.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{
+			ProposerSlashing: slashing,
+		},
+	})
+
 	return pubsub.ValidationAccept, nil
 }
 
@@ -115,9 +115,10 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s, Genesis: time.Now()},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s, Genesis: time.Now()},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
 		},
 		seenProposerSlashingCache: lruwrpr.New(10),
 	}
@@ -157,9 +158,10 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: st},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: st},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
 		},
 		seenProposerSlashingCache: lruwrpr.New(10),
 	}
@@ -188,9 +190,10 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s},
-			initialSync: &mockSync.Sync{IsSyncing: true},
+			operationNotifier: &mock.MockOperationNotifier{},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s},
+			initialSync:       &mockSync.Sync{IsSyncing: true},
 		},
 	}
 
@@ -4,18 +4,20 @@ package flags
 
 import (
 	"strings"
+	"time"
 
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/urfave/cli/v2"
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
-	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
-		Value: "",
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
+	MevRelayEndpoint = &cli.StringSliceFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"This flag can be used multiple times or as a comma separated list to connect to several relays, in which case the highest value bid is used",
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
@@ -27,6 +29,53 @@ var (
 		Usage: "Number of total skip slot to fallback from using relay/builder to local execution engine for block construction in last epoch rolling window",
 		Value: 8,
 	}
+	// MinBuilderBid sets the minimum builder bid value to use a builder payload.
+	MinBuilderBid = &cli.Uint64Flag{
+		Name: "min-builder-bid",
+		Usage: "The minimum builder bid value in gwei to use a builder payload instead of the local execution engine payload. " +
+			"Can be overridden per validator with the builder min_bid proposer setting",
+	}
+	// BuilderBoostFactor sets the percentage applied to a builder bid value before comparing it to the local payload value.
+	BuilderBoostFactor = &cli.Uint64Flag{
+		Name: "builder-boost-factor",
+		Usage: "The percentage the builder bid value is multiplied by before being compared against the local execution engine payload value. " +
+			"100 compares values as is, lower values favor local payloads and 0 always uses the local payload",
+		Value: 100,
+	}
+	// BuilderBidCutoff enables polling the relays for headers, from the slot start until the given time into the slot.
+	BuilderBidCutoff = &cli.DurationFlag{
+		Name: "builder-bid-cutoff",
+		Usage: "Time into the slot until which the relays are polled for headers, the best bid seen by then being used, ex 1s. " +
+			"Polling starts at the slot start for the validators proposing through this node and registered with the relays. " +
+			"Builders raise their bids as the slot goes on, at the cost of later block propagation. " +
+			"Disabled by default, in which case the relays are asked once",
+	}
+	// BuilderRelayTimeout sets the maximum amount of time a single relay is given to respond to a get header request.
+	BuilderRelayTimeout = &cli.DurationFlag{
+		Name:  "builder-relay-timeout",
+		Usage: "Maximum amount of time a single relay is given to respond to a get header request, ex 500ms",
+		Value: builder.DefaultRelayTimeout,
+	}
+	// BuilderBidDeadline sets the time into the slot after which polling the relays gives up and the local payload is used.
+	BuilderBidDeadline = &cli.DurationFlag{
+		Name:  "builder-bid-deadline",
+		Usage: "Time into the slot after which, if no relay offered a valid bid, the local execution engine payload is used. Only used with --builder-bid-cutoff",
+		Value: 2 * time.Second,
+	}
+	// BuilderRelayQuarantineEpochs sets the number of epochs a faulty relay is not asked for headers.
+	BuilderRelayQuarantineEpochs = &cli.Uint64Flag{
+		Name: "builder-relay-quarantine-epochs",
+		Usage: "Number of epochs a relay is quarantined for, not being asked for headers, once it collected too many faults. " +
+			"Faults are failed unblinds, invalid bids, withdrawals mismatches and timeouts. 0 disables quarantining",
+		Value: 2,
+	}
+	// BuilderRelayFaultThreshold sets the number of slots with faults within an epoch which quarantines a relay.
+	BuilderRelayFaultThreshold = &cli.Uint64Flag{
+		Name: "builder-relay-fault-threshold",
+		Usage: "Number of faults within an epoch which quarantines a relay, a relay being faulted at most once per slot. " +
+			"A failed unblind quarantines the relay straight away",
+		Value: 3,
+	}
 	// ExecutionEngineEndpoint provides an HTTP access endpoint to connect to an execution client on the execution layer
 	ExecutionEngineEndpoint = &cli.StringFlag{
 		Name:  "execution-endpoint",
@@ -161,6 +210,76 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 2,
 	}
+	// Backfill enables downloading the blocks missing between genesis and the checkpoint sync origin block.
+	Backfill = &cli.BoolFlag{
+		Name: "backfill",
+		Usage: "Downloads the blocks missing between genesis and the origin block of a node started via checkpoint sync, " +
+			"once initial sync is done, so that the node can serve the whole chain history.",
+	}
+	// BackfillBatchSize specifies the number of slots requested from a peer at once by backfill.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of slots backfill requests blocks for from a peer at once.",
+		Value: 64,
+	}
+	// BackfillBlocksPerSecond limits the rate at which backfill requests blocks.
+	BackfillBlocksPerSecond = &cli.Uint64Flag{
+		Name:  "backfill-blocks-per-second",
+		Usage: "The maximum number of blocks backfill requests from peers per second, so that it does not slow down syncing the head of the chain.",
+		Value: 64,
+	}
+	// ReorgHeadWeightThreshold sets the percentage of the committee weight under which a late head block is orphaned.
+	ReorgHeadWeightThreshold = &cli.Uint64Flag{
+		Name: "reorg-head-weight-threshold",
+		Usage: "The percentage of the committee weight a late head block must not exceed to be orphaned by the next proposal. " +
+			"Defaults to the network's REORG_WEIGHT_THRESHOLD",
+	}
+	// ReorgParentWeightThreshold sets the percentage of the committee weight the parent of an orphaned block must exceed.
+	ReorgParentWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-parent-weight-threshold",
+		Usage: "The percentage of the committee weight the parent of a late head block must exceed for the head to be orphaned. 0 disables the check",
+	}
+	// ReorgMaxEpochsSinceFinalization sets the number of epochs without finality after which late blocks are not orphaned.
+	ReorgMaxEpochsSinceFinalization = &cli.Uint64Flag{
+		Name:  "reorg-max-epochs-since-finalization",
+		Usage: "The number of epochs since finalization after which late blocks are not orphaned. Defaults to the network's REORG_MAX_EPOCHS_SINCE_FINALIZATION",
+	}
+	// ReorgLateBlockCutoff sets the number of seconds into its slot from which a block is late.
+	ReorgLateBlockCutoff = &cli.Uint64Flag{
+		Name:  "reorg-late-block-cutoff",
+		Usage: "The number of seconds into its slot from which a head block is late and may be orphaned. Defaults to the first third of the slot",
+	}
+	// ReorgProposingEarlyCutoff sets the number of seconds into the slot before which a proposer may orphan a late block.
+	ReorgProposingEarlyCutoff = &cli.Uint64Flag{
+		Name:  "reorg-proposing-early-cutoff",
+		Usage: "The number of seconds into the slot before which a proposer may orphan a late head block. Defaults to the first sixth of the slot",
+	}
+	// ReconstructStates enables regenerating the archived states below the checkpoint sync origin block.
+	ReconstructStates = &cli.BoolFlag{
+		Name: "reconstruct-states",
+		Usage: "Regenerates the archived states below the origin block of a node started via checkpoint sync, by replaying " +
+			"the blocks from genesis once they are backfilled, so that the node can serve historical states. Requires --backfill.",
+	}
+	// PruneBeforeSlot enables deleting the finalized history below a slot.
+	PruneBeforeSlot = &cli.Uint64Flag{
+		Name: "prune-before-slot",
+		Usage: "Deletes the finalized blocks and states below the given slot once it is finalized, so that the database " +
+			"does not keep the whole chain history. The database is compacted on the next start of the node.",
+	}
+	// HistoryRetentionEpochs enables deleting the finalized history older than a number of epochs.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "Deletes the finalized blocks and states older than the given number of epochs before the finalized " +
+			"checkpoint, so that the database does not keep the whole chain history. Peers expect at least 33024 epochs " +
+			"of blocks to be served. The database is compacted on the next start of the node.",
+	}
+	// DBBackend sets the key-value engine the beacon database is stored with.
+	DBBackend = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "The key-value engine the beacon database is stored with, bolt or pebble. A new database is stored with " +
+			"bolt by default, and an existing database stored with another engine must first be converted with " +
+			"prysmctl db migrate-backend.",
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
@@ -246,4 +365,21 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherReplayStartEpoch defines the first epoch of the history replayed by slasher when it starts.
+	SlasherReplayStartEpoch = &cli.Uint64Flag{
+		Name: "slasher-replay-start-epoch",
+		Usage: "Replays the blocks stored in the beacon database from this epoch, and the attestations they include, " +
+			"through slashing detection before detecting slashings live. Requires a new slasher database",
+	}
+	// SlasherReplayEndEpoch defines the last epoch of the history replayed by slasher when it starts.
+	SlasherReplayEndEpoch = &cli.Uint64Flag{
+		Name:  "slasher-replay-end-epoch",
+		Usage: "The last epoch replayed with --slasher-replay-start-epoch, defaults to the last finalized epoch",
+	}
+	// SlasherReplayReport defines a file the slashings found when replaying history are written to.
+	SlasherReplayReport = &cli.StringFlag{
+		Name: "slasher-replay-report",
+		Usage: "Writes the slashings found when replaying history to this JSON file, " +
+			"instead of submitting them to the slashing operations pool",
+	}
 )
@@ -54,6 +54,18 @@ var appFlags = []cli.Flag{
 	flags.SetGCPercent,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.Backfill,
+	flags.BackfillBatchSize,
+	flags.BackfillBlocksPerSecond,
+	flags.ReorgHeadWeightThreshold,
+	flags.ReorgParentWeightThreshold,
+	flags.ReorgMaxEpochsSinceFinalization,
+	flags.ReorgLateBlockCutoff,
+	flags.ReorgProposingEarlyCutoff,
+	flags.ReconstructStates,
+	flags.PruneBeforeSlot,
+	flags.HistoryRetentionEpochs,
+	flags.DBBackend,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
@@ -73,6 +85,13 @@ var appFlags = []cli.Flag{
 	flags.MevRelayEndpoint,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
+	flags.MinBuilderBid,
+	flags.BuilderBoostFactor,
+	flags.BuilderBidCutoff,
+	flags.BuilderBidDeadline,
+	flags.BuilderRelayTimeout,
+	flags.BuilderRelayQuarantineEpochs,
+	flags.BuilderRelayFaultThreshold,
 	flags.EngineEndpointTimeoutSeconds,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -92,6 +111,8 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PScoringConfig,
+	cmd.P2PTrustedPeers,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -129,6 +150,9 @@ var appFlags = []cli.Flag{
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.SlasherReplayStartEpoch,
+	flags.SlasherReplayEndEpoch,
+	flags.SlasherReplayReport,
 }
 
 func init() {
@@ -112,6 +112,18 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SlotsPerArchivedPoint,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.Backfill,
+			flags.BackfillBatchSize,
+			flags.BackfillBlocksPerSecond,
+			flags.ReorgHeadWeightThreshold,
+			flags.ReorgParentWeightThreshold,
+			flags.ReorgMaxEpochsSinceFinalization,
+			flags.ReorgLateBlockCutoff,
+			flags.ReorgProposingEarlyCutoff,
+			flags.ReconstructStates,
+			flags.PruneBeforeSlot,
+			flags.HistoryRetentionEpochs,
+			flags.DBBackend,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -123,8 +135,18 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MevRelayEndpoint,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
+			flags.MinBuilderBid,
+			flags.BuilderBoostFactor,
+			flags.BuilderBidCutoff,
+			flags.BuilderBidDeadline,
+			flags.BuilderRelayTimeout,
+			flags.BuilderRelayQuarantineEpochs,
+			flags.BuilderRelayFaultThreshold,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherReplayStartEpoch,
+			flags.SlasherReplayEndEpoch,
+			flags.SlasherReplayReport,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
@@ -152,6 +174,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PScoringConfig,
+			cmd.P2PTrustedPeers,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
@@ -172,6 +172,20 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PScoringConfig defines a YAML file overriding the peer scoring weights and thresholds.
+	P2PScoringConfig = &cli.StringFlag{
+		Name: "p2p-scoring-config",
+		Usage: "The path of a YAML file overriding the peer scorer weights, the bad peer thresholds, the gossip " +
+			"topic weights and the gossipsub score thresholds. Changes to the file are applied while the node runs, " +
+			"except for the gossipsub score thresholds.",
+	}
+	// P2PTrustedPeers defines the peers that always stay connected to the beacon node.
+	P2PTrustedPeers = &cli.StringSliceFlag{
+		Name: "p2p-trusted-peer",
+		Usage: "A peer, given by peer ID, ENR or multiaddr, that is never disconnected for its score and has a " +
+			"reserved connection slot outside of --p2p-max-peers. Trusted peers with an address are redialled with " +
+			"backoff when disconnected. This flag may be used multiple times.",
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -0,0 +1,129 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var bidsFlags = struct {
+	APIEndpoint string
+	StartSlot   uint64
+	EndSlot     uint64
+	JSON        bool
+}{}
+
+var bidsCmd = &cli.Command{
+	Name: "bids",
+	Usage: "List the relay bids and blinded block submissions recorded for the beacon node's proposals. " +
+		"Requires the beacon node to run with --enable-debug-rpc-endpoints",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionBids(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not list builder bids")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "prysm-api-endpoint",
+			Usage:       "gRPC API endpoint for the Prysm beacon node",
+			Destination: &bidsFlags.APIEndpoint,
+			Value:       "localhost:4000",
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot to list records for",
+			Destination: &bidsFlags.StartSlot,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot to list records for, inclusive. Defaults to the start slot",
+			Destination: &bidsFlags.EndSlot,
+		},
+		&cli.BoolFlag{
+			Name:        "json",
+			Usage:       "print every record as a json object on its own line instead of a table",
+			Destination: &bidsFlags.JSON,
+		},
+	},
+}
+
+func cliActionBids(cliCtx *cli.Context) error {
+	f := bidsFlags
+	conn, err := grpc.Dial(f.APIEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrapf(err, "could not dial %s", f.APIEndpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection")
+		}
+	}()
+	res, err := pb.NewDebugClient(conn).ListBuilderBids(cliCtx.Context, &pb.BuilderBidsRequest{
+		StartSlot: primitives.Slot(f.StartSlot),
+		EndSlot:   primitives.Slot(f.EndSlot),
+	})
+	if err != nil {
+		return err
+	}
+	if f.JSON {
+		return printBidsJSON(os.Stdout, res.Records)
+	}
+	return printBidsTable(os.Stdout, res.Records)
+}
+
+func printBidsJSON(w io.Writer, records []*pb.BuilderBidRecord) error {
+	for _, r := range records {
+		b, err := protojson.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, string(b)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printBidsTable prints one row per relay bid followed by one row per blinded block submission of each proposal.
+func printBidsTable(w io.Writer, records []*pb.BuilderBidRecord) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SLOT\tEVENT\tRELAY\tVALUE (WEI)\tBLOCK HASH\tLATENCY (MS)\tRESULT")
+	for _, r := range records {
+		for _, b := range r.Bids {
+			result := "bid"
+			switch {
+			case b.Error != "":
+				result = "error: " + b.Error
+			case b.Chosen:
+				result = "chosen"
+			}
+			fmt.Fprintf(tw, "%d\tbid\t%s\t%s\t%s\t%d\t%s\n", r.Slot, b.Relay, b.Value, hash(b.BlockHash), b.LatencyMs, result)
+		}
+		for _, s := range r.Submissions {
+			result := "delivered"
+			if !s.Delivered {
+				result = "error: " + s.Error
+			}
+			fmt.Fprintf(tw, "%d\tsubmission\t%s\t\t%s\t%d\t%s\n", r.Slot, s.Relay, hash(s.BlockHash), s.LatencyMs, result)
+		}
+	}
+	return tw.Flush()
+}
+
+func hash(h []byte) string {
+	if len(h) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%#x", h)
+}
@@ -0,0 +1,31 @@
+package builder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestPrintBidsTable(t *testing.T) {
+	records := []*pb.BuilderBidRecord{{
+		Slot: 7,
+		Bids: []*pb.RelayBidRecord{
+			{Relay: "a.relay", Value: "100", BlockHash: []byte{0xab}, LatencyMs: 12, Chosen: true},
+			{Relay: "b.relay", LatencyMs: 950, Error: "context deadline exceeded"},
+		},
+		Submissions: []*pb.RelaySubmissionRecord{
+			{Relay: "a.relay", BlockHash: []byte{0xab}, LatencyMs: 30, Delivered: true},
+		},
+	}}
+	var buf bytes.Buffer
+	require.NoError(t, printBidsTable(&buf, records))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 4, len(lines))
+	assert.DeepEqual(t, []string{"7", "bid", "a.relay", "100", "0xab", "12", "chosen"}, strings.Fields(lines[1]))
+	assert.Equal(t, true, strings.HasSuffix(lines[2], "error: context deadline exceeded"))
+	assert.DeepEqual(t, []string{"7", "submission", "a.relay", "0xab", "30", "delivered"}, strings.Fields(lines[3]))
+}
@@ -0,0 +1,13 @@
+package builder
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "builder",
+		Usage: "commands to inspect the beacon node's use of external block builders",
+		Subcommands: []*cli.Command{
+			bidsCmd,
+		},
+	},
+}
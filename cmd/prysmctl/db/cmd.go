@@ -9,6 +9,12 @@ var Commands = []*cli.Command{
 		Subcommands: []*cli.Command{
 			queryCmd,
 			bucketsCmd,
+			reconstructStatesCmd,
+			compactCmd,
+			verifyCmd,
+			migrateBackendCmd,
+			exportEraCmd,
+			importEraCmd,
 		},
 	},
 }
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	validatorkv "github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	beaconDBKind    = "beacon"
+	validatorDBKind = "validator"
+)
+
+var compactFlags = struct {
+	Path string
+}{}
+
+var compactCmd = &cli.Command{
+	Name: "compact",
+	Usage: "Copy the live data of a beacon or validator db into a fresh file, which replaces the original, giving the " +
+		"space freed by deletions back to the file system. The beacon node or validator client must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := compactAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compact db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing the beacon or validator db",
+			Destination: &compactFlags.Path,
+			Required:    true,
+		},
+	},
+}
+
+func compactAction(_ *cli.Context) error {
+	kind, err := dbKind(compactFlags.Path)
+	if err != nil {
+		return err
+	}
+	if kind == beaconDBKind {
+		return kv.Compact(compactFlags.Path)
+	}
+	return validatorkv.Compact(compactFlags.Path)
+}
+
+// dbKind returns whether the given directory holds a beacon or a validator db.
+func dbKind(dir string) (string, error) {
+	_, beacon, err := kv.DetectBackend(dir)
+	if err != nil {
+		return "", err
+	}
+	validator := file.FileExists(filepath.Join(dir, validatorkv.ProtectionDbFileName))
+	switch {
+	case beacon && validator:
+		return "", errors.Errorf("%s holds both a beacon and a validator db", dir)
+	case beacon:
+		return beaconDBKind, nil
+	case validator:
+		return validatorDBKind, nil
+	default:
+		return "", errors.Errorf("no beacon or validator db found in %s", dir)
+	}
+}
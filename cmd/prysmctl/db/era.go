@@ -0,0 +1,165 @@
+package db
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/era"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportEraFlags = struct {
+	Path    string
+	OutDir  string
+	Network string
+	From    uint64
+	To      uint64
+}{}
+
+var exportEraCmd = &cli.Command{
+	Name: "export-era",
+	Usage: "Write the finalized history of a beacon db to era files, which another node can import with import-era. " +
+		"The beacon node must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export eras")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &exportEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "out-dir",
+			Usage:       "directory the era files are written to",
+			Destination: &exportEraFlags.OutDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network the db belongs to, eg mainnet, prater or sepolia",
+			Destination: &exportEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.Uint64Flag{
+			Name:        "from",
+			Usage:       "first era to export, era 0 holding the genesis state",
+			Destination: &exportEraFlags.From,
+		},
+		&cli.Uint64Flag{
+			Name:        "to",
+			Usage:       "last era to export, defaults to the last finalized era",
+			Destination: &exportEraFlags.To,
+		},
+	},
+}
+
+var importEraFlags = struct {
+	Path    string
+	EraDir  string
+	Network string
+}{}
+
+var importEraCmd = &cli.Command{
+	Name: "import-era",
+	Usage: "Import the history held by era files into a beacon db, either to initialize an empty db from genesis, or " +
+		"to backfill the db of a node initialized via checkpoint sync. The beacon node must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := importEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import eras")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db, which is created if missing",
+			Destination: &importEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "era-dir",
+			Usage:       "directory containing the .era files to import",
+			Destination: &importEraFlags.EraDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network the db belongs to, eg mainnet, prater or sepolia",
+			Destination: &importEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+	},
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	f := exportEraFlags
+	if err := setNetwork(f.Network); err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	d, err := kv.NewKVStore(ctx, f.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db in %s", f.Path)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	to := f.To
+	if !cliCtx.IsSet("to") {
+		if to, err = era.LastFinalizedEra(ctx, d); err != nil {
+			return err
+		}
+	}
+	if f.From > to {
+		return errors.Errorf("first era %d is above the last era %d", f.From, to)
+	}
+	paths, err := era.Export(ctx, d, f.OutDir, f.From, to)
+	if err != nil {
+		return err
+	}
+	log.WithField("files", len(paths)).Info("Exported eras")
+	return nil
+}
+
+func importEraAction(cliCtx *cli.Context) error {
+	f := importEraFlags
+	if err := setNetwork(f.Network); err != nil {
+		return err
+	}
+	paths, err := filepath.Glob(filepath.Join(f.EraDir, "*.era"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.Errorf("no era file found in %s", f.EraDir)
+	}
+	ctx := cliCtx.Context
+	d, err := kv.NewKVStore(ctx, f.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db in %s", f.Path)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	return era.Import(ctx, d, paths)
+}
+
+func setNetwork(name string) error {
+	cfg, err := params.ByName(name)
+	if err != nil {
+		return errors.Wrapf(err, "unknown network %s", name)
+	}
+	return params.SetActive(cfg)
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateBackendFlags = struct {
+	Path string
+	To   string
+}{}
+
+var migrateBackendCmd = &cli.Command{
+	Name: "migrate-backend",
+	Usage: "Convert a beacon db to another key-value engine, copying it into a new db which replaces the original. " +
+		"The beacon node must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateBackendAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing the beacon db",
+			Destination: &migrateBackendFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "to",
+			Usage:       "key-value engine to convert the db to, bolt or pebble",
+			Destination: &migrateBackendFlags.To,
+			Value:       string(backend.Pebble),
+		},
+	},
+}
+
+func migrateBackendAction(cliCtx *cli.Context) error {
+	f := migrateBackendFlags
+	to, err := backend.ParseKind(f.To)
+	if err != nil {
+		return err
+	}
+	return kv.MigrateBackend(cliCtx.Context, f.Path, to)
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var reconstructStatesFlags = struct {
+	Path                  string
+	GenesisState          string
+	Network               string
+	SlotsPerArchivedPoint uint64
+}{}
+
+var reconstructStatesCmd = &cli.Command{
+	Name: "reconstruct-states",
+	Usage: "Regenerate the archived states below the origin block of a node initialized via checkpoint sync, by " +
+		"replaying the backfilled blocks from genesis. Resumes where it left off when interrupted. The beacon node " +
+		"must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := reconstructStatesAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not reconstruct states")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &reconstructStatesFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "genesis-state",
+			Usage:       "path to a ssz encoded genesis state to replay from, instead of the one in the db",
+			Destination: &reconstructStatesFlags.GenesisState,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network the db belongs to, eg mainnet, prater or sepolia",
+			Destination: &reconstructStatesFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.Uint64Flag{
+			Name:        "slots-per-archive-point",
+			Usage:       "the slot durations of when an archived state gets saved in the db, matching the beacon node flag",
+			Destination: &reconstructStatesFlags.SlotsPerArchivedPoint,
+			Value:       2048,
+		},
+	},
+}
+
+func reconstructStatesAction(cliCtx *cli.Context) error {
+	f := reconstructStatesFlags
+	if err := setNetwork(f.Network); err != nil {
+		return err
+	}
+
+	opts := []stategen.ReconstructorOption{
+		stategen.WithSlotsPerArchivedPoint(primitives.Slot(f.SlotsPerArchivedPoint)),
+	}
+	if f.GenesisState != "" {
+		b, err := os.ReadFile(filepath.Clean(f.GenesisState))
+		if err != nil {
+			return errors.Wrapf(err, "could not read genesis state %s", f.GenesisState)
+		}
+		cf, err := detect.FromState(b)
+		if err != nil {
+			return errors.Wrap(err, "could not detect the fork of the genesis state")
+		}
+		st, err := cf.UnmarshalBeaconState(b)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal genesis state")
+		}
+		opts = append(opts, stategen.WithGenesisState(st))
+	}
+
+	ctx := cliCtx.Context
+	d, err := kv.NewKVStore(ctx, f.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db in %s", f.Path)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	return stategen.NewReconstructor(d, opts...).Reconstruct(ctx)
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/io/boltutil"
+	validatorkv "github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var verifyFlags = struct {
+	Path   string
+	Repair bool
+}{}
+
+var verifyCmd = &cli.Command{
+	Name: "verify",
+	Usage: "Walk every bucket of a beacon or validator db, and report the entries which are not consistent with each " +
+		"other, such as indices pointing to missing blocks. The beacon node or validator client must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := verifyAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not verify db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing the beacon or validator db",
+			Destination: &verifyFlags.Path,
+			Required:    true,
+		},
+		&cli.BoolFlag{
+			Name:        "repair",
+			Usage:       "repair the inconsistencies which can be, such as dangling index entries",
+			Destination: &verifyFlags.Repair,
+		},
+	},
+}
+
+// inconsistency is an entry of a beacon or validator db which is not consistent with the rest of the db.
+type inconsistency interface {
+	fmt.Stringer
+	Repairable() bool
+}
+
+// verifiableDB is a db which can report and repair its inconsistencies.
+type verifiableDB[I inconsistency] interface {
+	Verify(ctx context.Context) ([]I, error)
+	Repair(issues []I) (int, error)
+	Close() error
+}
+
+func verifyAction(cliCtx *cli.Context) error {
+	f := verifyFlags
+	kind, err := dbKind(f.Path)
+	if err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	if kind == beaconDBKind {
+		d, err := kv.NewKVStore(ctx, f.Path)
+		if err != nil {
+			return errors.Wrapf(err, "could not open db in %s", f.Path)
+		}
+		return verifyDB[*backend.Inconsistency](ctx, d, f.Repair)
+	}
+	d, err := validatorkv.NewKVStore(ctx, f.Path, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db in %s", f.Path)
+	}
+	return verifyDB[*boltutil.Inconsistency](ctx, d, f.Repair)
+}
+
+func verifyDB[I inconsistency](ctx context.Context, d verifiableDB[I], repair bool) error {
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+
+	issues, err := d.Verify(ctx)
+	if err != nil {
+		return err
+	}
+	repairable := 0
+	for _, i := range issues {
+		if i.Repairable() {
+			repairable++
+		}
+		fmt.Printf("%s\n", i)
+	}
+	log.WithFields(log.Fields{
+		"inconsistencies": len(issues),
+		"repairable":      repairable,
+	}).Info("Verified db")
+	if len(issues) == 0 {
+		return nil
+	}
+	if !repair {
+		return errors.Errorf("found %d inconsistencies, %d of which can be repaired with --repair", len(issues), repairable)
+	}
+	repaired, err := d.Repair(issues)
+	if err != nil {
+		return errors.Wrapf(err, "repaired %d inconsistencies out of %d", repaired, repairable)
+	}
+	log.WithField("repaired", repaired).Info("Repaired db")
+	if repaired < len(issues) {
+		return errors.Errorf("%d inconsistencies cannot be repaired", len(issues)-repaired)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package forkchoice
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "forkchoice",
+		Usage: "commands to inspect the fork choice store of a beacon node",
+		Subcommands: []*cli.Command{
+			exportCmd,
+			diffCmd,
+		},
+	},
+}
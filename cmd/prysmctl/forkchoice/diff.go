@@ -0,0 +1,227 @@
+package forkchoice
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var diffCmd = &cli.Command{
+	Name:      "diff",
+	Usage:     "Compare two json exports of the fork choice tree and show how the head, node weights and votes changed",
+	ArgsUsage: "<old.json> <new.json>",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionDiff(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not diff fork choice exports")
+		}
+		return nil
+	},
+}
+
+func cliActionDiff(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 2 {
+		return errors.New("expected the paths of two json exports")
+	}
+	older, err := readGraph(cliCtx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	newer, err := readGraph(cliCtx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	return printDiff(os.Stdout, diffGraphs(older, newer))
+}
+
+func readGraph(path string) (*pb.ForkChoiceGraph, error) {
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	graph := &pb.ForkChoiceGraph{}
+	if err := protojson.Unmarshal(b, graph); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", path)
+	}
+	return graph, nil
+}
+
+type graphDiff struct {
+	oldSlot, newSlot primitives.Slot
+	oldHead, newHead *pb.ForkChoiceGraphNode
+	nodes            []nodeDiff
+	moves            []voteMove
+	// hasVotes is set when both graphs were exported with their votes.
+	hasVotes bool
+}
+
+// nodeDiff is a node whose weight or ballots changed between two graphs. The old or new node is nil
+// if the node was added or pruned.
+type nodeDiff struct {
+	root     []byte
+	slot     primitives.Slot
+	old, new *pb.ForkChoiceGraphNode
+}
+
+// voteMove aggregates the validators whose vote moved from one root to another.
+type voteMove struct {
+	from, to   []byte
+	validators uint64
+	balance    uint64
+	// towardsHead is set when the vote moved onto the path to the new head.
+	towardsHead bool
+}
+
+func diffGraphs(older, newer *pb.ForkChoiceGraph) *graphDiff {
+	d := &graphDiff{
+		oldSlot:  older.Slot,
+		newSlot:  newer.Slot,
+		oldHead:  findNode(older, older.HeadRoot),
+		newHead:  findNode(newer, newer.HeadRoot),
+		hasVotes: len(older.Votes) > 0 && len(newer.Votes) > 0,
+	}
+
+	oldNodes := make(map[[32]byte]*pb.ForkChoiceGraphNode, len(older.Nodes))
+	for _, n := range older.Nodes {
+		oldNodes[bytesutil.ToBytes32(n.Root)] = n
+	}
+	for _, n := range newer.Nodes {
+		root := bytesutil.ToBytes32(n.Root)
+		o, ok := oldNodes[root]
+		delete(oldNodes, root)
+		if ok && o.Weight == n.Weight && o.Ballots == n.Ballots {
+			continue
+		}
+		d.nodes = append(d.nodes, nodeDiff{root: n.Root, slot: n.Slot, old: o, new: n})
+	}
+	for _, o := range oldNodes {
+		d.nodes = append(d.nodes, nodeDiff{root: o.Root, slot: o.Slot, old: o})
+	}
+	sort.Slice(d.nodes, func(i, j int) bool {
+		if d.nodes[i].slot != d.nodes[j].slot {
+			return d.nodes[i].slot < d.nodes[j].slot
+		}
+		return bytes.Compare(d.nodes[i].root, d.nodes[j].root) < 0
+	})
+
+	if !d.hasVotes {
+		return d
+	}
+	onHeadPath := make(map[[32]byte]bool, len(newer.HeadPath))
+	for _, r := range newer.HeadPath {
+		onHeadPath[bytesutil.ToBytes32(r)] = true
+	}
+	oldVotes := make(map[primitives.ValidatorIndex]*pb.ForkChoiceGraphVote, len(older.Votes))
+	for _, v := range older.Votes {
+		oldVotes[v.ValidatorIndex] = v
+	}
+	moves := make(map[[64]byte]*voteMove)
+	for _, v := range newer.Votes {
+		var from []byte
+		if o, ok := oldVotes[v.ValidatorIndex]; ok {
+			from = o.Root
+		}
+		if bytes.Equal(from, v.Root) {
+			continue
+		}
+		var key [64]byte
+		copy(key[:32], from)
+		copy(key[32:], v.Root)
+		m, ok := moves[key]
+		if !ok {
+			m = &voteMove{
+				from:        from,
+				to:          v.Root,
+				towardsHead: onHeadPath[bytesutil.ToBytes32(v.Root)] && !onHeadPath[bytesutil.ToBytes32(from)],
+			}
+			moves[key] = m
+		}
+		m.validators++
+		m.balance += v.Balance
+	}
+	for _, m := range moves {
+		d.moves = append(d.moves, *m)
+	}
+	sort.Slice(d.moves, func(i, j int) bool {
+		if d.moves[i].balance != d.moves[j].balance {
+			return d.moves[i].balance > d.moves[j].balance
+		}
+		if c := bytes.Compare(d.moves[i].from, d.moves[j].from); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(d.moves[i].to, d.moves[j].to) < 0
+	})
+	return d
+}
+
+func findNode(graph *pb.ForkChoiceGraph, root []byte) *pb.ForkChoiceGraphNode {
+	for _, n := range graph.Nodes {
+		if bytes.Equal(n.Root, root) {
+			return n
+		}
+	}
+	return nil
+}
+
+// printDiff prints the head change, then one row per node whose weight or ballots changed and one
+// row per group of validators whose vote moved between the same two roots.
+func printDiff(w io.Writer, d *graphDiff) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	oldHead, newHead := headString(d.oldHead), headString(d.newHead)
+	if oldHead == newHead {
+		fmt.Fprintf(tw, "Head unchanged between slots %d and %d: %s\n", d.oldSlot, d.newSlot, newHead)
+	} else {
+		fmt.Fprintf(tw, "Head moved between slots %d and %d: %s -> %s\n", d.oldSlot, d.newSlot, oldHead, newHead)
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "ROOT\tSLOT\tOLD WEIGHT\tNEW WEIGHT\tDELTA\tOLD BALLOTS\tNEW BALLOTS\tSTATUS")
+	for _, n := range d.nodes {
+		switch {
+		case n.old == nil:
+			fmt.Fprintf(tw, "%s\t%d\t\t%d\t+%d\t\t%d\tadded\n", shortRoot(n.root), n.slot, n.new.Weight, n.new.Weight, n.new.Ballots)
+		case n.new == nil:
+			fmt.Fprintf(tw, "%s\t%d\t%d\t\t-%d\t%d\t\tpruned\n", shortRoot(n.root), n.slot, n.old.Weight, n.old.Weight, n.old.Ballots)
+		default:
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%d\t%d\tchanged\n", shortRoot(n.root), n.slot, n.old.Weight, n.new.Weight,
+				delta(n.old.Weight, n.new.Weight), n.old.Ballots, n.new.Ballots)
+		}
+	}
+	fmt.Fprintln(tw)
+	if !d.hasVotes {
+		fmt.Fprintln(tw, "Votes were not exported in both graphs, export them with --include-votes to see how votes moved")
+		return tw.Flush()
+	}
+	fmt.Fprintln(tw, "FROM\tTO\tVALIDATORS\tBALANCE\tTOWARDS HEAD")
+	for _, m := range d.moves {
+		from := "none"
+		if len(m.from) > 0 {
+			from = shortRoot(m.from)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%t\n", from, shortRoot(m.to), m.validators, m.balance, m.towardsHead)
+	}
+	return tw.Flush()
+}
+
+func headString(n *pb.ForkChoiceGraphNode) string {
+	if n == nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s (slot %d)", shortRoot(n.Root), n.Slot)
+}
+
+func delta(older, newer uint64) string {
+	if newer >= older {
+		return fmt.Sprintf("+%d", newer-older)
+	}
+	return fmt.Sprintf("-%d", older-newer)
+}
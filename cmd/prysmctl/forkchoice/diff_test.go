@@ -0,0 +1,88 @@
+package forkchoice
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestDiffGraphs(t *testing.T) {
+	// Two validators move their vote from block 2 to block 3, which becomes the head.
+	older := &pb.ForkChoiceGraph{
+		Slot:     3,
+		HeadRoot: root(2),
+		HeadPath: [][]byte{root(1), root(2)},
+		Nodes: []*pb.ForkChoiceGraphNode{
+			{Slot: 1, Root: root(1), Weight: 60},
+			{Slot: 2, Root: root(2), ParentRoot: root(1), Weight: 40, Ballots: 3},
+			{Slot: 3, Root: root(3), ParentRoot: root(1), Weight: 20, Ballots: 1},
+		},
+		Votes: []*pb.ForkChoiceGraphVote{
+			{ValidatorIndex: 0, Root: root(2), Balance: 10},
+			{ValidatorIndex: 1, Root: root(2), Balance: 10},
+			{ValidatorIndex: 2, Root: root(2), Balance: 20},
+			{ValidatorIndex: 3, Root: root(3), Balance: 20},
+		},
+	}
+	newer := &pb.ForkChoiceGraph{
+		Slot:     4,
+		HeadRoot: root(3),
+		HeadPath: [][]byte{root(1), root(3)},
+		Nodes: []*pb.ForkChoiceGraphNode{
+			{Slot: 1, Root: root(1), Weight: 70},
+			{Slot: 2, Root: root(2), ParentRoot: root(1), Weight: 20, Ballots: 1},
+			{Slot: 3, Root: root(3), ParentRoot: root(1), Weight: 40, Ballots: 3},
+			{Slot: 4, Root: root(4), ParentRoot: root(2), Weight: 10, Ballots: 1},
+		},
+		Votes: []*pb.ForkChoiceGraphVote{
+			{ValidatorIndex: 0, Root: root(3), Balance: 10},
+			{ValidatorIndex: 1, Root: root(3), Balance: 10},
+			{ValidatorIndex: 2, Root: root(2), Balance: 20},
+			{ValidatorIndex: 3, Root: root(3), Balance: 20},
+			{ValidatorIndex: 4, Root: root(4), Balance: 10},
+		},
+	}
+	d := diffGraphs(older, newer)
+	assert.DeepEqual(t, root(2), d.oldHead.Root)
+	assert.DeepEqual(t, root(3), d.newHead.Root)
+	require.Equal(t, 4, len(d.nodes))
+	assert.Equal(t, true, d.nodes[3].old == nil)
+
+	require.Equal(t, 2, len(d.moves))
+	assert.DeepEqual(t, root(2), d.moves[0].from)
+	assert.DeepEqual(t, root(3), d.moves[0].to)
+	assert.Equal(t, uint64(2), d.moves[0].validators)
+	assert.Equal(t, uint64(20), d.moves[0].balance)
+	assert.Equal(t, true, d.moves[0].towardsHead)
+	assert.Equal(t, 0, len(d.moves[1].from))
+	assert.Equal(t, false, d.moves[1].towardsHead)
+
+	var buf bytes.Buffer
+	require.NoError(t, printDiff(&buf, d))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, "Head moved between slots 3 and 4: 0x02000000 (slot 2) -> 0x03000000 (slot 3)", strings.TrimSpace(lines[0]))
+	assert.DeepEqual(t, []string{"0x02000000", "2", "40", "20", "-20", "3", "1", "changed"}, strings.Fields(lines[4]))
+	assert.DeepEqual(t, []string{"0x04000000", "4", "10", "+10", "1", "added"}, strings.Fields(lines[6]))
+	assert.DeepEqual(t, []string{"0x02000000", "0x03000000", "2", "20", "true"}, strings.Fields(lines[9]))
+	assert.DeepEqual(t, []string{"none", "0x04000000", "1", "10", "false"}, strings.Fields(lines[10]))
+}
+
+func TestDiffGraphs_NoVotes(t *testing.T) {
+	graph := &pb.ForkChoiceGraph{
+		HeadRoot: root(1),
+		Nodes:    []*pb.ForkChoiceGraphNode{{Slot: 1, Root: root(1), Weight: 10}},
+	}
+	d := diffGraphs(graph, graph)
+	assert.Equal(t, 0, len(d.nodes))
+	assert.Equal(t, false, d.hasVotes)
+
+	var buf bytes.Buffer
+	require.NoError(t, printDiff(&buf, d))
+	out := buf.String()
+	assert.Equal(t, true, strings.HasPrefix(out, "Head unchanged between slots 0 and 0: 0x01000000 (slot 1)"))
+	assert.Equal(t, true, strings.Contains(out, "--include-votes"))
+}
@@ -0,0 +1,199 @@
+package forkchoice
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	formatDOT  = "dot"
+	formatJSON = "json"
+)
+
+var exportFlags = struct {
+	APIEndpoint  string
+	Format       string
+	IncludeVotes bool
+	Output       string
+}{}
+
+var exportCmd = &cli.Command{
+	Name: "export",
+	Usage: "Export the fork choice tree of the beacon node as a graphviz DOT graph or as JSON. " +
+		"Requires the beacon node to run with --enable-debug-rpc-endpoints",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionExport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export fork choice")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "prysm-api-endpoint",
+			Usage:       "gRPC API endpoint for the Prysm beacon node",
+			Destination: &exportFlags.APIEndpoint,
+			Value:       "localhost:4000",
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "output format, either dot or json",
+			Destination: &exportFlags.Format,
+			Value:       formatDOT,
+		},
+		&cli.BoolFlag{
+			Name:        "include-votes",
+			Usage:       "include the latest vote of every validator, which is needed to diff two json exports",
+			Destination: &exportFlags.IncludeVotes,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the export to. Defaults to stdout",
+			Destination: &exportFlags.Output,
+		},
+	},
+}
+
+func cliActionExport(cliCtx *cli.Context) error {
+	f := exportFlags
+	if f.Format != formatDOT && f.Format != formatJSON {
+		return fmt.Errorf("unknown format %q, expected %s or %s", f.Format, formatDOT, formatJSON)
+	}
+	conn, err := grpc.Dial(f.APIEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrapf(err, "could not dial %s", f.APIEndpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection")
+		}
+	}()
+	graph, err := pb.NewDebugClient(conn).GetForkChoiceGraph(cliCtx.Context, &pb.ForkChoiceGraphRequest{
+		IncludeVotes: f.IncludeVotes,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if f.Output != "" {
+		file, err := os.Create(f.Output)
+		if err != nil {
+			return errors.Wrapf(err, "could not create %s", f.Output)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.WithError(err).Error("Could not close output file")
+			}
+		}()
+		w = file
+	}
+	if f.Format == formatJSON {
+		b, err := protojson.MarshalOptions{Multiline: true}.Marshal(graph)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+	return writeDOT(w, graph)
+}
+
+// writeDOT writes the fork choice tree as a graphviz graph. Nodes on the path to the head are drawn
+// with a bold border and bold edges, the head is filled, optimistic nodes are dashed and nodes that
+// are not viable for head are greyed out.
+func writeDOT(w io.Writer, graph *pb.ForkChoiceGraph) error {
+	onHeadPath := make(map[[32]byte]bool, len(graph.HeadPath))
+	for _, r := range graph.HeadPath {
+		onHeadPath[bytesutil.ToBytes32(r)] = true
+	}
+	head := bytesutil.ToBytes32(graph.HeadRoot)
+	proposerHead := bytesutil.ToBytes32(graph.ProposerHeadRoot)
+	boost := bytesutil.ToBytes32(graph.ProposerBoostRoot)
+	var justified, finalized [32]byte
+	if graph.JustifiedCheckpoint != nil {
+		justified = bytesutil.ToBytes32(graph.JustifiedCheckpoint.Root)
+	}
+	if graph.FinalizedCheckpoint != nil {
+		finalized = bytesutil.ToBytes32(graph.FinalizedCheckpoint.Root)
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph forkchoice {\n")
+	b.WriteString("\trankdir=LR;\n")
+	fmt.Fprintf(&b, "\tlabel=\"slot %d, committee weight %d, should override fcu %t\";\n", graph.Slot, graph.CommitteeWeight, graph.ShouldOverrideFcu)
+	b.WriteString("\tnode [shape=box, fontname=monospace];\n")
+	for _, n := range graph.Nodes {
+		root := bytesutil.ToBytes32(n.Root)
+		label := []string{
+			fmt.Sprintf("slot %d %s", n.Slot, shortRoot(n.Root)),
+			fmt.Sprintf("weight %d", n.Weight),
+			fmt.Sprintf("balance %d", n.Balance),
+			fmt.Sprintf("ballots %d", n.Ballots),
+		}
+		var style, attrs []string
+		switch root {
+		case head:
+			label = append(label, "head")
+			style = append(style, "filled")
+			attrs = append(attrs, "fillcolor=palegreen")
+		case proposerHead:
+			label = append(label, "proposer head")
+		}
+		// The proposer boost root is zero when no block is boosted.
+		if root == boost && boost != [32]byte{} {
+			label = append(label, "proposer boost")
+		}
+		if root == justified {
+			label = append(label, "justified")
+		}
+		if root == finalized {
+			label = append(label, "finalized")
+		}
+		if n.Optimistic {
+			label = append(label, "optimistic")
+			style = append(style, "dashed")
+		}
+		if onHeadPath[root] {
+			attrs = append(attrs, "penwidth=3")
+		}
+		if !n.ViableForHead {
+			attrs = append(attrs, "fontcolor=grey", "color=grey")
+		}
+		if len(style) > 0 {
+			attrs = append(attrs, fmt.Sprintf("style=%q", strings.Join(style, ",")))
+		}
+		attrs = append(attrs, fmt.Sprintf("label=\"%s\"", strings.Join(label, "\\n")))
+		fmt.Fprintf(&b, "\t\"%#x\" [%s];\n", n.Root, strings.Join(attrs, ", "))
+	}
+	for _, n := range graph.Nodes {
+		if len(n.ParentRoot) == 0 {
+			continue
+		}
+		attrs := ""
+		if onHeadPath[bytesutil.ToBytes32(n.Root)] && onHeadPath[bytesutil.ToBytes32(n.ParentRoot)] {
+			attrs = " [penwidth=3]"
+		}
+		fmt.Fprintf(&b, "\t\"%#x\" -> \"%#x\"%s;\n", n.ParentRoot, n.Root, attrs)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// shortRoot returns the first four bytes of a root, which is enough to tell apart the nodes of a tree.
+func shortRoot(r []byte) string {
+	if len(r) > 4 {
+		r = r[:4]
+	}
+	return fmt.Sprintf("%#x", r)
+}
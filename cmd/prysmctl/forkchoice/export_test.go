@@ -0,0 +1,44 @@
+package forkchoice
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func root(b byte) []byte {
+	r := make([]byte, 32)
+	r[0] = b
+	return r
+}
+
+func TestWriteDOT(t *testing.T) {
+	graph := &pb.ForkChoiceGraph{
+		Slot:                3,
+		JustifiedCheckpoint: &pb.Checkpoint{Root: root(1)},
+		FinalizedCheckpoint: &pb.Checkpoint{Root: root(1)},
+		HeadRoot:            root(3),
+		HeadPath:            [][]byte{root(1), root(3)},
+		ProposerBoostRoot:   root(3),
+		ProposerHeadRoot:    root(3),
+		Nodes: []*pb.ForkChoiceGraphNode{
+			{Slot: 1, Root: root(1), Weight: 50, ViableForHead: true},
+			{Slot: 2, Root: root(2), ParentRoot: root(1), Weight: 10, Ballots: 1, Optimistic: true},
+			{Slot: 3, Root: root(3), ParentRoot: root(1), Weight: 40, Balance: 40, Ballots: 2, ViableForHead: true},
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writeDOT(&buf, graph))
+	out := buf.String()
+	assert.Equal(t, true, strings.HasPrefix(out, "digraph forkchoice {"))
+	assert.Equal(t, true, strings.Contains(out, `label="slot 1 0x01000000\nweight 50\nbalance 0\nballots 0\njustified\nfinalized"`))
+	assert.Equal(t, true, strings.Contains(out, `fontcolor=grey, color=grey, style="dashed"`))
+	assert.Equal(t, true, strings.Contains(out, `fillcolor=palegreen, penwidth=3, style="filled", label="slot 3 0x03000000\nweight 40\nbalance 40\nballots 2\nhead\nproposer boost"`))
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Equal(t, true, strings.HasSuffix(lines[len(lines)-3], "\"0x0200000000000000000000000000000000000000000000000000000000000000\";"))
+	assert.Equal(t, true, strings.HasSuffix(lines[len(lines)-2], "\"0x0300000000000000000000000000000000000000000000000000000000000000\" [penwidth=3];"))
+}
@@ -3,9 +3,11 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/builder"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/checkpointsync"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/db"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/deprecated"
+	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/forkchoice"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/p2p"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/testnet"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/validator"
@@ -31,8 +33,10 @@ func init() {
 	// pointing to their new locations
 	prysmctlCommands = append(prysmctlCommands, deprecated.Commands...)
 
+	prysmctlCommands = append(prysmctlCommands, builder.Commands...)
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
 	prysmctlCommands = append(prysmctlCommands, db.Commands...)
+	prysmctlCommands = append(prysmctlCommands, forkchoice.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
@@ -0,0 +1,188 @@
+package p2p
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+var scoresFlags = struct {
+	APIEndpoint string
+	Format      string
+	Peer        string
+	Topics      bool
+}{}
+
+var scoresCmd = &cli.Command{
+	Name: "scores",
+	Usage: "Print the score of every peer of the beacon node, broken down into the contributions of the scorers " +
+		"and the gossipsub score components of each topic. Requires the beacon node to run with --enable-debug-rpc-endpoints",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionScores(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not list peer scores")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "prysm-api-endpoint",
+			Usage:       "gRPC API endpoint for the Prysm beacon node",
+			Destination: &scoresFlags.APIEndpoint,
+			Value:       "localhost:4000",
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "output format, either table or json",
+			Destination: &scoresFlags.Format,
+			Value:       formatTable,
+		},
+		&cli.StringFlag{
+			Name:        "peer",
+			Usage:       "only print the score of the peer with this id",
+			Destination: &scoresFlags.Peer,
+		},
+		&cli.BoolFlag{
+			Name:        "topics",
+			Usage:       "print the gossipsub score components of each topic under every peer",
+			Destination: &scoresFlags.Topics,
+		},
+	},
+}
+
+func cliActionScores(cliCtx *cli.Context) error {
+	f := scoresFlags
+	if f.Format != formatTable && f.Format != formatJSON {
+		return fmt.Errorf("unknown format %q, expected %s or %s", f.Format, formatTable, formatJSON)
+	}
+	conn, err := grpc.Dial(f.APIEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrapf(err, "could not dial %s", f.APIEndpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection")
+		}
+	}()
+	scores, err := pb.NewDebugClient(conn).ListPeerScores(cliCtx.Context, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get peer scores")
+	}
+	if f.Peer != "" {
+		scores.Peers = filterPeerScores(scores.Peers, f.Peer)
+		if len(scores.Peers) == 0 {
+			return fmt.Errorf("peer %s is unknown to the beacon node", f.Peer)
+		}
+	}
+	if f.Format == formatJSON {
+		enc, err := protojson.MarshalOptions{Multiline: true}.Marshal(scores)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal peer scores")
+		}
+		_, err = fmt.Fprintln(os.Stdout, string(enc))
+		return err
+	}
+	return writeScores(os.Stdout, scores, f.Topics)
+}
+
+func filterPeerScores(peers []*pb.PeerScore, pid string) []*pb.PeerScore {
+	for _, p := range peers {
+		if p.PeerId == pid {
+			return []*pb.PeerScore{p}
+		}
+	}
+	return nil
+}
+
+// writeScores prints the weights and thresholds in use followed by the peers, lowest score first.
+func writeScores(out io.Writer, scores *pb.PeerScores, withTopics bool) error {
+	w := scores.Weights
+	if _, err := fmt.Fprintf(out, "scorer weights: bad_responses=%g block_provider=%g peer_status=%g gossip=%g\n",
+		w.GetBadResponses(), w.GetBlockProvider(), w.GetPeerStatus(), w.GetGossip()); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "bad peer thresholds: bad_responses=%d gossip_score=%g\n\n",
+		scores.BadResponsesThreshold, scores.GossipScoreThreshold); err != nil {
+		return err
+	}
+
+	peers := make([]*pb.PeerScore, len(scores.Peers))
+	copy(peers, scores.Peers)
+	sort.SliceStable(peers, func(i, j int) bool {
+		return peers[i].OverallScore < peers[j].OverallScore
+	})
+
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	header := []string{"PEER", "STATE", "SCORE", "BAD"}
+	if len(peers) > 0 {
+		for _, c := range peers[0].Scorers {
+			header = append(header, strings.ToUpper(c.Scorer))
+		}
+	}
+	header = append(header, "GOSSIPSUB", "BEHAVIOUR", "APP", "IP_COLOCATION")
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for _, p := range peers {
+		row := []string{
+			p.PeerId,
+			strings.ToLower(p.ConnectionState.String()),
+			fmt.Sprintf("%.4f", p.OverallScore),
+			fmt.Sprintf("%t", p.IsBad),
+		}
+		for _, c := range p.Scorers {
+			contribution := fmt.Sprintf("%.4f", c.Contribution)
+			if c.IsBad {
+				contribution += "!"
+			}
+			row = append(row, contribution)
+		}
+		row = append(row,
+			fmt.Sprintf("%.2f", p.GossipScore),
+			fmt.Sprintf("%.2f", p.BehaviourPenalty),
+			fmt.Sprintf("%.2f", p.AppSpecificScore),
+			fmt.Sprintf("%.2f", p.IpColocationFactor),
+		)
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+		if withTopics {
+			if err := writeTopicScores(tw, p.TopicScores); err != nil {
+				return err
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+// writeTopicScores prints the weighted gossipsub score components of each topic, sorted by topic.
+func writeTopicScores(out io.Writer, topicScores map[string]*pb.TopicScoreSnapshot) error {
+	topics := make([]string, 0, len(topicScores))
+	for t := range topicScores {
+		topics = append(topics, t)
+	}
+	sort.Strings(topics)
+	for _, t := range topics {
+		ts := topicScores[t]
+		if _, err := fmt.Fprintf(out, "  %s\tweight=%g\tmesh_time=%.2f\tfirst_deliveries=%.2f\tmesh_deliveries=%.2f\tinvalid=%.2f\tscore=%.4f\n",
+			t, ts.TopicWeight, ts.TimeInMeshScore, ts.FirstMessageDeliveriesScore, ts.MeshMessageDeliveriesScore,
+			ts.InvalidMessageDeliveriesScore, ts.Score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
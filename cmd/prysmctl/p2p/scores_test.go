@@ -0,0 +1,59 @@
+package p2p
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestWriteScores(t *testing.T) {
+	scores := &pb.PeerScores{
+		Weights:               &pb.ScorerWeights{BadResponses: 0.3, PeerStatus: 0.3, Gossip: 0.4},
+		BadResponsesThreshold: 5,
+		GossipScoreThreshold:  -100,
+		Peers: []*pb.PeerScore{
+			{
+				PeerId:       "good",
+				OverallScore: 1.5,
+				Scorers:      []*pb.ScorerContribution{{Scorer: "bad_responses"}, {Scorer: "gossip", Contribution: 1.5}},
+			},
+			{
+				PeerId:       "bad",
+				OverallScore: -3,
+				IsBad:        true,
+				Scorers:      []*pb.ScorerContribution{{Scorer: "bad_responses", Contribution: -3, IsBad: true}, {Scorer: "gossip"}},
+				TopicScores: map[string]*pb.TopicScoreSnapshot{
+					"/eth2/00000000/voluntary_exit/ssz_snappy": {TopicWeight: 0.05},
+					"/eth2/00000000/beacon_block/ssz_snappy":   {TopicWeight: 0.8, Score: -2},
+				},
+			},
+		},
+	}
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeScores(buf, scores, true))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 8, len(lines))
+	assert.Equal(t, "scorer weights: bad_responses=0.3 block_provider=0 peer_status=0.3 gossip=0.4", lines[0])
+	assert.Equal(t, "bad peer thresholds: bad_responses=5 gossip_score=-100", lines[1])
+	assert.Equal(t, true, strings.Contains(lines[3], "BAD_RESPONSES"))
+	// The lowest score comes first, followed by its topics.
+	assert.Equal(t, true, strings.HasPrefix(lines[4], "bad "))
+	assert.Equal(t, true, strings.Contains(lines[4], "-3.0000!"))
+	assert.Equal(t, true, strings.Contains(lines[5], "beacon_block"))
+	assert.Equal(t, true, strings.Contains(lines[5], "score=-2.0000"))
+	assert.Equal(t, true, strings.Contains(lines[6], "voluntary_exit"))
+	assert.Equal(t, true, strings.HasPrefix(lines[7], "good "))
+
+	buf.Reset()
+	require.NoError(t, writeScores(buf, scores, false))
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 6, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[5], "good "))
+
+	assert.Equal(t, 1, len(filterPeerScores(scores.Peers, "good")))
+	assert.Equal(t, 0, len(filterPeerScores(scores.Peers, "unknown")))
+}
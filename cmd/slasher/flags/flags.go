@@ -0,0 +1,50 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// BeaconRPCProviderFlag defines the gRPC endpoints of the beacon nodes slasher follows.
+	BeaconRPCProviderFlag = &cli.StringSliceFlag{
+		Name: "beacon-rpc-provider",
+		Usage: "gRPC endpoints of the beacon nodes to follow, which must run with --slasher-feed. " +
+			"Can be given more than once",
+		Value: cli.NewStringSlice("127.0.0.1:4000"),
+	}
+	// BeaconCertFlag defines a flag for the certificate used to connect to the beacon nodes securely.
+	BeaconCertFlag = &cli.StringFlag{
+		Name:  "beacon-tls-cert",
+		Usage: "Certificate of the beacon nodes, for secure gRPC connections to them",
+	}
+	// RPCHost defines the host on which the gRPC server of slasher should listen.
+	RPCHost = &cli.StringFlag{
+		Name:  "rpc-host",
+		Usage: "Host on which the gRPC server of slasher should listen",
+		Value: "127.0.0.1",
+	}
+	// RPCPort defines the port on which the gRPC server of slasher should listen.
+	RPCPort = &cli.IntFlag{
+		Name:  "rpc-port",
+		Usage: "Port on which the gRPC server of slasher should listen",
+		Value: 4002,
+	}
+	// CertFlag defines a flag for the certificate of the gRPC server of slasher.
+	CertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely",
+	}
+	// KeyFlag defines a flag for the key of the gRPC server of slasher.
+	KeyFlag = &cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely",
+	}
+	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus",
+		Value: 8082,
+	}
+)
@@ -55,11 +55,14 @@ type Flags struct {
 	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
 
 	EnableSlasher                   bool // Enable slasher in the beacon node runtime.
+	EnableSlasherFeed               bool // EnableSlasherFeed streams the blocks and attestations received by the beacon node to standalone slashers.
 	EnableSlashingProtectionPruning bool // EnableSlashingProtectionPruning for the validator client.
 
 	SaveFullExecutionPayloads bool // Save full beacon blocks with execution payloads in the database.
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
 
+	EnableForkChoicePersistence bool // EnableForkChoicePersistence saves the fork choice store in the database and restores it on startup.
+
 	DisableStakinContractCheck bool // Disables check for deposit contract when proposing blocks
 
 	EnableVerboseSigVerification bool // EnableVerboseSigVerification specifies whether to verify individual signature if batch verification fails
@@ -178,6 +181,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableSlasherFlag.Name, enableSlasherFlag.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableSlasher = true
 	}
+	if ctx.Bool(enableSlasherFeedFlag.Name) {
+		logEnabled(enableSlasherFeedFlag)
+		cfg.EnableSlasherFeed = true
+	}
 	if ctx.Bool(enableHistoricalSpaceRepresentation.Name) {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
@@ -206,6 +213,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(enableOptionalEngineMethods)
 		cfg.EnableOptionalEngineMethods = true
 	}
+	if ctx.Bool(enableForkChoicePersistence.Name) {
+		logEnabled(enableForkChoicePersistence)
+		cfg.EnableForkChoicePersistence = true
+	}
 	Init(cfg)
 	return nil
 }
@@ -68,6 +68,10 @@ var (
 		Name:  "slasher",
 		Usage: "Enables a slasher in the beacon node for detecting slashable offenses",
 	}
+	enableSlasherFeedFlag = &cli.BoolFlag{
+		Name:  "slasher-feed",
+		Usage: "Streams the blocks and attestations received by the beacon node to standalone slasher processes",
+	}
 	enableSlashingProtectionPruning = &cli.BoolFlag{
 		Name:  "enable-slashing-protection-history-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protection database",
@@ -114,6 +118,10 @@ var (
 		Name:  "enable-optional-engine-methods",
 		Usage: "Enables the optional engine methods",
 	}
+	enableForkChoicePersistence = &cli.BoolFlag{
+		Name:  "enable-forkchoice-persistence",
+		Usage: "Saves the fork choice store in the database every epoch and restores it on startup, instead of rebuilding it from the finalized checkpoint",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -151,6 +159,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	Mainnet,
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
+	enableSlasherFeedFlag,
 	enableHistoricalSpaceRepresentation,
 	disableStakinContractCheck,
 	disableReorgLateBlocks,
@@ -159,6 +168,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	enableFullSSZDataLogging,
 	enableVerboseSigVerification,
 	enableOptionalEngineMethods,
+	enableForkChoicePersistence,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -208,6 +208,10 @@ type BeaconChainConfig struct {
 	MaxBuilderConsecutiveMissedSlots primitives.Slot // MaxBuilderConsecutiveMissedSlots defines the number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction.
 	MaxBuilderEpochMissedSlots       primitives.Slot // MaxBuilderEpochMissedSlots is defines the number of total skip slot (per epoch rolling windows) to fallback from using relay/builder to local execution engine for block construction.
 
+	// Mev-boost bid selection
+	MinBuilderBid      uint64 // MinBuilderBid defines the minimum builder bid value in gwei, lower bids fallback to the local execution engine payload.
+	BuilderBoostFactor uint64 // BuilderBoostFactor defines the percentage the builder bid value is multiplied by before being compared against the local payload value.
+
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue uint64 // ExecutionEngineTimeoutValue defines the seconds to wait before timing out engine endpoints with execution payload execution semantics (newPayload, forkchoiceUpdated).
 }
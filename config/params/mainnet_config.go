@@ -258,6 +258,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	MaxBuilderConsecutiveMissedSlots: 3,
 	MaxBuilderEpochMissedSlots:       5,
 
+	// Mevboost bid selection
+	MinBuilderBid:      0,
+	BuilderBoostFactor: 100,
+
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue: 8, // 8 seconds default based on: https://github.com/ethereum/execution-apis/blob/main/src/engine/specification.md#core
 }
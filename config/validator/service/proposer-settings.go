@@ -24,10 +24,12 @@ type ProposerOptionPayload struct {
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
 // GasLimit is a number set to help the network decide on the maximum gas in each block.
+// MinBid is the minimum builder bid value in gwei below which the beacon node builds the block locally.
 type BuilderConfig struct {
 	Enabled  bool     `json:"enabled" yaml:"enabled"`
 	GasLimit Uint64   `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
 	Relays   []string `json:"relays" yaml:"relays"`
+	MinBid   Uint64   `json:"min_bid,omitempty" yaml:"min_bid,omitempty"`
 }
 
 type Uint64 uint64
@@ -0,0 +1,175 @@
+// Package boltutil provides maintenance helpers shared by the bolt databases of the beacon node and the validator
+// client, which bolt itself does not offer: compacting a database file, and reporting and repairing inconsistencies.
+package boltutil
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+// compactTxMaxSize is the maximum number of bytes copied in a single transaction when compacting a database.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// SkipFunc reports whether the key at the given bucket path is left out when copying a database.
+type SkipFunc func(path [][]byte, k []byte) bool
+
+// CompactFile compacts the database file at the given path, which must not be open: the live data is copied into a
+// fresh file, which then replaces the original. Bolt never gives the pages freed by deletions back to the file
+// system otherwise. It returns the size of the file before and after compaction.
+func CompactFile(datafile string, allocSize int, skip SkipFunc) (before, after int64, err error) {
+	start := time.Now()
+	log.WithField("path", datafile).Info("Compacting database, this may take a while")
+	before, after, err = compactFile(datafile, allocSize, skip)
+	if err != nil {
+		return 0, 0, err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": before,
+		"sizeAfter":  after,
+		"duration":   time.Since(start),
+	}).Info("Compacted database")
+	return before, after, nil
+}
+
+func compactFile(datafile string, allocSize int, skip SkipFunc) (before, after int64, err error) {
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	tmp := datafile + ".compact"
+	if err := compactInto(src, tmp, allocSize, skip); err != nil {
+		if closeErr := src.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		if rmErr := os.Remove(tmp); rmErr != nil && !os.IsNotExist(rmErr) {
+			log.WithError(rmErr).Error("Could not remove partially compacted database")
+		}
+		return 0, 0, errors.Wrap(err, "could not compact database")
+	}
+	if err := src.Close(); err != nil {
+		return 0, 0, err
+	}
+	beforeInfo, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	afterInfo, err := os.Stat(tmp)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(tmp, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database with its compacted copy")
+	}
+	return beforeInfo.Size(), afterInfo.Size(), nil
+}
+
+func compactInto(src *bolt.DB, dstPath string, allocSize int, skip SkipFunc) error {
+	dst, err := bolt.Open(dstPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, NoSync: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			log.WithError(err).Error("Could not close compacted database")
+		}
+	}()
+	if allocSize > 0 {
+		dst.AllocSize = allocSize
+	}
+	if err := Copy(src, dst, skip); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
+
+// Copy copies every bucket and key of the source database into the destination database, except those the skip
+// function, if any, leaves out. Keys are copied in bounded transactions.
+func Copy(src, dst *bolt.DB, skip SkipFunc) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The transaction is already committed unless copying failed.
+		_ = tx.Rollback()
+	}()
+	var size int64
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return walkBucket(b, nil, name, nil, b.Sequence(), func(path [][]byte, k, v []byte, seq uint64) error {
+				if skip != nil && skip(path, k) {
+					return nil
+				}
+				if sz := int64(len(k) + len(v)); size+sz > compactTxMaxSize {
+					if err := tx.Commit(); err != nil {
+						return err
+					}
+					// Rolling back the committed transaction is a no-op, should the next one fail to begin.
+					next, err := dst.Begin(true)
+					if err != nil {
+						return err
+					}
+					tx = next
+					size = 0
+				}
+				size += int64(len(k) + len(v))
+				return copyEntry(tx, path, k, v, seq)
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// walkBucket calls fn for the given key, then for every key of the bucket it points to, if any, recursively.
+func walkBucket(b *bolt.Bucket, path [][]byte, k, v []byte, seq uint64, fn func(path [][]byte, k, v []byte, seq uint64) error) error {
+	if err := fn(path, k, v, seq); err != nil {
+		return err
+	}
+	if v != nil {
+		return nil
+	}
+	path = append(path, k)
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested := b.Bucket(k)
+			return walkBucket(nested, path, k, nil, nested.Sequence(), fn)
+		}
+		return walkBucket(b, path, k, v, b.Sequence(), fn)
+	})
+}
+
+// copyEntry writes a key, or creates a bucket when the value is nil, under the bucket at the given path.
+func copyEntry(tx *bolt.Tx, path [][]byte, k, v []byte, seq uint64) error {
+	if len(path) == 0 {
+		bkt, err := tx.CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+		return bkt.SetSequence(seq)
+	}
+	bkt := tx.Bucket(path[0])
+	for _, p := range path[1:] {
+		bkt = bkt.Bucket(p)
+	}
+	// Keys are copied in order, fill pages completely.
+	bkt.FillPercent = 1.0
+	if v == nil {
+		nested, err := bkt.CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+		return nested.SetSequence(seq)
+	}
+	return bkt.Put(k, v)
+}
@@ -0,0 +1,71 @@
+package boltutil
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCompactFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := bolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		top, err := tx.CreateBucket([]byte("top"))
+		if err != nil {
+			return err
+		}
+		if err := top.SetSequence(7); err != nil {
+			return err
+		}
+		nested, err := top.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 1000; i++ {
+			if err := nested.Put([]byte{byte(i >> 8), byte(i)}, bytes.Repeat([]byte{0x01}, 1024)); err != nil {
+				return err
+			}
+		}
+		if err := top.Put([]byte("kept"), []byte("value")); err != nil {
+			return err
+		}
+		return top.Put([]byte("skipped"), []byte("value"))
+	}))
+	// Free most of the pages.
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		nested := tx.Bucket([]byte("top")).Bucket([]byte("nested"))
+		for i := 10; i < 1000; i++ {
+			if err := nested.Delete([]byte{byte(i >> 8), byte(i)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	before, after, err := CompactFile(path, 0, func(path [][]byte, k []byte) bool {
+		return len(path) == 1 && string(k) == "skipped"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, after < before, "Compacted size %d is not below %d", after, before)
+
+	db, err = bolt.Open(path, 0600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		top := tx.Bucket([]byte("top"))
+		require.NotNil(t, top)
+		assert.Equal(t, uint64(7), top.Sequence())
+		assert.DeepEqual(t, []byte("value"), top.Get([]byte("kept")))
+		assert.Equal(t, true, top.Get([]byte("skipped")) == nil)
+		assert.Equal(t, 10, top.Bucket([]byte("nested")).Stats().KeyN)
+		return nil
+	}))
+}
@@ -0,0 +1,5 @@
+package boltutil
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "db")
@@ -0,0 +1,59 @@
+package boltutil
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// repairBatchSize is the maximum number of inconsistencies repaired in a single transaction.
+const repairBatchSize = 256
+
+// Inconsistency is an entry of a database which is not consistent with the rest of the database, such as an index
+// pointing to a missing record.
+type Inconsistency struct {
+	Bucket string
+	Key    []byte
+	Reason string
+	// Fix repairs the inconsistency, if it can be repaired without losing data needed by the node.
+	Fix func(tx *bolt.Tx) error
+}
+
+// String describes the inconsistency.
+func (i *Inconsistency) String() string {
+	return fmt.Sprintf("%s %#x: %s", i.Bucket, i.Key, i.Reason)
+}
+
+// Repairable reports whether the inconsistency can be repaired.
+func (i *Inconsistency) Repairable() bool {
+	return i.Fix != nil
+}
+
+// Repair fixes the given inconsistencies which can be repaired, in bounded transactions. It returns the number of
+// inconsistencies repaired.
+func Repair(db *bolt.DB, issues []*Inconsistency) (int, error) {
+	fixable := make([]*Inconsistency, 0, len(issues))
+	for _, i := range issues {
+		if i.Repairable() {
+			fixable = append(fixable, i)
+		}
+	}
+	for start := 0; start < len(fixable); start += repairBatchSize {
+		end := start + repairBatchSize
+		if end > len(fixable) {
+			end = len(fixable)
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			for _, i := range fixable[start:end] {
+				if err := i.Fix(tx); err != nil {
+					return errors.Wrapf(err, "could not repair %s", i)
+				}
+			}
+			return nil
+		}); err != nil {
+			return start, err
+		}
+	}
+	return len(fixable), nil
+}
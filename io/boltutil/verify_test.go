@@ -0,0 +1,45 @@
+package boltutil
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestRepair(t *testing.T) {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket([]byte("bucket"))
+		return err
+	}))
+
+	var issues []*Inconsistency
+	for i := 0; i < repairBatchSize+10; i++ {
+		key := []byte{byte(i >> 8), byte(i)}
+		issues = append(issues, &Inconsistency{
+			Bucket: "bucket",
+			Key:    key,
+			Reason: "missing",
+			Fix: func(tx *bolt.Tx) error {
+				return tx.Bucket([]byte("bucket")).Put(key, []byte{0x01})
+			},
+		})
+	}
+	issues = append(issues, &Inconsistency{Bucket: "bucket", Key: []byte{0xff, 0xff}, Reason: "unrepairable"})
+	assert.Equal(t, "bucket 0xffff: unrepairable", issues[len(issues)-1].String())
+
+	repaired, err := Repair(db, issues)
+	require.NoError(t, err)
+	assert.Equal(t, repairBatchSize+10, repaired)
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, repairBatchSize+10, tx.Bucket([]byte("bucket")).Stats().KeyN)
+		return nil
+	}))
+}
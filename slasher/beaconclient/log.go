@@ -0,0 +1,5 @@
+package beaconclient
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "beaconclient")
@@ -0,0 +1,17 @@
+package beaconclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	receivedAttestationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_beacon_client_attestations_received_total",
+		Help: "Number of distinct indexed attestations received from beacon nodes",
+	})
+	receivedBlockHeadersTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_beacon_client_block_headers_received_total",
+		Help: "Number of distinct signed block headers received from beacon nodes",
+	})
+)
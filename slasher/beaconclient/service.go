@@ -0,0 +1,404 @@
+// Package beaconclient defines a service which follows one or more beacon nodes over gRPC
+// for a standalone slasher. It feeds slasher the attestations and block headers received
+// by the beacon nodes, and submits the slashings slasher detects back to them.
+package beaconclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Period after which a failed request or a stream which ended is retried.
+	reconnectPeriod = 5 * time.Second
+	// Number of attestations and block headers remembered to skip the ones
+	// received from more than one beacon node.
+	seenCacheSize = 1 << 16
+)
+
+// Config for the beacon client service.
+type Config struct {
+	Endpoints               []string
+	CertPath                string
+	MaxCallRecvMsgSize      int
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+}
+
+// A beacon node followed by the service.
+type beaconNode struct {
+	endpoint    string
+	conn        *grpc.ClientConn
+	nodeClient  ethpb.NodeClient
+	chainClient ethpb.BeaconChainClient
+	feedClient  ethpb.SlasherFeedClient
+}
+
+// Service follows the chain of beacon nodes for a standalone slasher. It implements
+// the state notifier, sync checker and remote beacon node slasher depends on.
+type Service struct {
+	cfg              *Config
+	ctx              context.Context
+	cancel           context.CancelFunc
+	nodes            []*beaconNode
+	stateFeed        *event.Feed
+	seenAtts         *lru.Cache
+	seenBlockHeaders *lru.Cache
+	lock             sync.RWMutex
+	initialized      bool
+	syncing          bool
+}
+
+// NewService dials the configured beacon nodes. Connections are established lazily,
+// so beacon nodes which are not up yet are retried once the service starts.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New("no beacon node endpoint provided")
+	}
+	var transportSecurity grpc.DialOption
+	if cfg.CertPath != "" {
+		creds, err := credentials.NewClientTLSFromFile(cfg.CertPath, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get valid credentials")
+		}
+		transportSecurity = grpc.WithTransportCredentials(creds)
+	} else {
+		transportSecurity = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection to the beacon nodes")
+	}
+	dialOpts := []grpc.DialOption{transportSecurity}
+	if cfg.MaxCallRecvMsgSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.MaxCallRecvMsgSize)))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:              cfg,
+		ctx:              ctx,
+		cancel:           cancel,
+		stateFeed:        new(event.Feed),
+		seenAtts:         lruwrpr.New(seenCacheSize),
+		seenBlockHeaders: lruwrpr.New(seenCacheSize),
+		syncing:          true,
+	}
+	for _, endpoint := range cfg.Endpoints {
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+		}
+		s.nodes = append(s.nodes, &beaconNode{
+			endpoint:    endpoint,
+			conn:        conn,
+			nodeClient:  ethpb.NewNodeClient(conn),
+			chainClient: ethpb.NewBeaconChainClient(conn),
+			feedClient:  ethpb.NewSlasherFeedClient(conn),
+		})
+	}
+	return s, nil
+}
+
+// Start following the beacon nodes.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the service and close the connections to the beacon nodes.
+func (s *Service) Stop() error {
+	s.cancel()
+	for _, node := range s.nodes {
+		if err := node.conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", node.endpoint).Error("Could not close connection to beacon node")
+		}
+	}
+	return nil
+}
+
+// Status returns an error until the genesis of the chain is known.
+func (s *Service) Status() error {
+	if !s.Initialized() {
+		return errors.New("waiting for the genesis of the chain from the beacon nodes")
+	}
+	return nil
+}
+
+// StateFeed sends the chain initialization event once the genesis of the chain is known.
+func (s *Service) StateFeed() *event.Feed {
+	return s.stateFeed
+}
+
+// Initialized returns true once the genesis of the chain is known.
+func (s *Service) Initialized() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.initialized
+}
+
+// Syncing returns true while none of the beacon nodes is synced.
+func (s *Service) Syncing() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.syncing
+}
+
+// Synced returns true once any beacon node is synced.
+func (s *Service) Synced() bool {
+	return !s.Syncing()
+}
+
+// Resync is a no-op, as beacon nodes resync on their own.
+func (_ *Service) Resync() error {
+	return nil
+}
+
+// HeadSlot returns the head slot of the first beacon node which responds.
+func (s *Service) HeadSlot(ctx context.Context) (primitives.Slot, error) {
+	var headSlot primitives.Slot
+	err := s.anyNode(func(node *beaconNode) error {
+		head, err := node.chainClient.GetChainHead(ctx, &emptypb.Empty{})
+		if err != nil {
+			return err
+		}
+		headSlot = head.HeadSlot
+		return nil
+	})
+	return headSlot, errors.Wrap(err, "could not get chain head")
+}
+
+// NumValidators returns the number of validators in the head state of the first
+// beacon node which responds.
+func (s *Service) NumValidators(ctx context.Context) (uint64, error) {
+	var numVals uint64
+	err := s.anyNode(func(node *beaconNode) error {
+		vals, err := node.chainClient.ListValidators(ctx, &ethpb.ListValidatorsRequest{PageSize: 1})
+		if err != nil {
+			return err
+		}
+		numVals = uint64(vals.TotalSize)
+		return nil
+	})
+	return numVals, errors.Wrap(err, "could not list validators")
+}
+
+// SubmitAttesterSlashing submits an attester slashing to every beacon node.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	return s.allNodes(func(node *beaconNode) error {
+		_, err := node.chainClient.SubmitAttesterSlashing(ctx, slashing)
+		return err
+	})
+}
+
+// SubmitProposerSlashing submits a proposer slashing to every beacon node.
+func (s *Service) SubmitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	return s.allNodes(func(node *beaconNode) error {
+		_, err := node.chainClient.SubmitProposerSlashing(ctx, slashing)
+		return err
+	})
+}
+
+func (s *Service) run() {
+	genesis, err := s.waitForGenesis()
+	if err != nil {
+		return
+	}
+	genesisTime := genesis.GenesisTime.AsTime()
+	s.lock.Lock()
+	s.initialized = true
+	s.lock.Unlock()
+
+	for _, node := range s.nodes {
+		go s.keepStreaming(node, "attestations", s.streamAttestations)
+		go s.keepStreaming(node, "block headers", s.streamBlockHeaders)
+	}
+	go s.updateSyncStatus(genesisTime)
+
+	// Slasher only subscribes to the state feed once it starts, so the event is sent
+	// again until it is received.
+	initializedEvent := &feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             genesisTime,
+			GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		},
+	}
+	for s.stateFeed.Send(initializedEvent) == 0 {
+		select {
+		case <-time.After(time.Second):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Waits until a beacon node returns the genesis of the chain.
+func (s *Service) waitForGenesis() (*ethpb.Genesis, error) {
+	for {
+		var genesis *ethpb.Genesis
+		err := s.anyNode(func(node *beaconNode) error {
+			res, err := node.nodeClient.GetGenesis(s.ctx, &emptypb.Empty{})
+			if err != nil {
+				return err
+			}
+			if res.GenesisTime == nil || res.GenesisTime.AsTime().Unix() == 0 {
+				return errors.New("chain has not started")
+			}
+			genesis = res
+			return nil
+		})
+		if err == nil {
+			log.WithField("genesisTime", genesis.GenesisTime.AsTime()).Info("Received genesis from beacon node")
+			return genesis, nil
+		}
+		log.WithError(err).Warn("Could not get genesis from beacon nodes, retrying")
+		select {
+		case <-time.After(reconnectPeriod):
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+}
+
+// Polls the sync status of the beacon nodes at every slot.
+func (s *Service) updateSyncStatus(genesisTime time.Time) {
+	ticker := slots.NewSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		syncing := true
+		for _, node := range s.nodes {
+			status, err := node.nodeClient.GetSyncStatus(s.ctx, &emptypb.Empty{})
+			if err != nil {
+				log.WithError(err).WithField("endpoint", node.endpoint).Debug("Could not get sync status of beacon node")
+				continue
+			}
+			if !status.Syncing {
+				syncing = false
+				break
+			}
+		}
+		s.lock.Lock()
+		s.syncing = syncing
+		s.lock.Unlock()
+
+		select {
+		case <-ticker.C():
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Runs a stream from a beacon node until the service stops, opening it again whenever it ends.
+func (s *Service) keepStreaming(node *beaconNode, name string, stream func(node *beaconNode) error) {
+	for {
+		err := stream(node)
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithFields(logrus.Fields{
+			"endpoint": node.endpoint,
+			"stream":   name,
+		}).Warn("Stream from beacon node ended, reopening")
+		select {
+		case <-time.After(reconnectPeriod):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Feeds slasher the indexed attestations streamed by a beacon node, skipping the ones already
+// received from another beacon node.
+func (s *Service) streamAttestations(node *beaconNode) error {
+	stream, err := node.feedClient.StreamSlasherAttestations(s.ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not open stream")
+	}
+	for {
+		att, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		root, err := att.HashTreeRoot()
+		if err != nil {
+			log.WithError(err).Error("Could not hash indexed attestation")
+			continue
+		}
+		if seen, _ := s.seenAtts.ContainsOrAdd(root, true); seen {
+			continue
+		}
+		receivedAttestationsTotal.Inc()
+		s.cfg.IndexedAttestationsFeed.Send(att)
+	}
+}
+
+// Feeds slasher the signed block headers streamed by a beacon node, skipping the ones already
+// received from another beacon node.
+func (s *Service) streamBlockHeaders(node *beaconNode) error {
+	stream, err := node.feedClient.StreamSlasherBlockHeaders(s.ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not open stream")
+	}
+	for {
+		header, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		root, err := header.HashTreeRoot()
+		if err != nil {
+			log.WithError(err).Error("Could not hash signed block header")
+			continue
+		}
+		if seen, _ := s.seenBlockHeaders.ContainsOrAdd(root, true); seen {
+			continue
+		}
+		receivedBlockHeadersTotal.Inc()
+		s.cfg.BeaconBlockHeadersFeed.Send(header)
+	}
+}
+
+// Calls f with each beacon node until it succeeds, and returns the error of the last
+// beacon node otherwise.
+func (s *Service) anyNode(f func(node *beaconNode) error) error {
+	var err error
+	for _, node := range s.nodes {
+		if err = f(node); err == nil {
+			return nil
+		}
+		log.WithError(err).WithField("endpoint", node.endpoint).Debug("Request to beacon node failed")
+	}
+	return err
+}
+
+// Calls f with every beacon node, and returns an error if it failed for all of them.
+func (s *Service) allNodes(f func(node *beaconNode) error) error {
+	var lastErr error
+	succeeded := false
+	for _, node := range s.nodes {
+		if err := f(node); err != nil {
+			log.WithError(err).WithField("endpoint", node.endpoint).Debug("Request to beacon node failed")
+			lastErr = err
+			continue
+		}
+		succeeded = true
+	}
+	if succeeded {
+		return nil
+	}
+	return lastErr
+}
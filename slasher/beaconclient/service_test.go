@@ -0,0 +1,168 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type attestationsStream struct {
+	grpc.ClientStream
+	atts []*ethpb.IndexedAttestation
+}
+
+func (s *attestationsStream) Recv() (*ethpb.IndexedAttestation, error) {
+	if len(s.atts) == 0 {
+		return nil, io.EOF
+	}
+	att := s.atts[0]
+	s.atts = s.atts[1:]
+	return att, nil
+}
+
+type feedClient struct {
+	ethpb.SlasherFeedClient
+	atts []*ethpb.IndexedAttestation
+}
+
+func (c *feedClient) StreamSlasherAttestations(
+	_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption,
+) (ethpb.SlasherFeed_StreamSlasherAttestationsClient, error) {
+	return &attestationsStream{atts: c.atts}, nil
+}
+
+func (_ *feedClient) StreamSlasherBlockHeaders(
+	_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption,
+) (ethpb.SlasherFeed_StreamSlasherBlockHeadersClient, error) {
+	return nil, errors.New("unavailable")
+}
+
+type chainClient struct {
+	ethpb.BeaconChainClient
+	headSlot primitives.Slot
+	err      error
+	slashed  int
+}
+
+func (c *chainClient) GetChainHead(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &ethpb.ChainHead{HeadSlot: c.headSlot}, nil
+}
+
+func (c *chainClient) SubmitAttesterSlashing(
+	_ context.Context, _ *ethpb.AttesterSlashing, _ ...grpc.CallOption,
+) (*ethpb.SubmitSlashingResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.slashed++
+	return &ethpb.SubmitSlashingResponse{}, nil
+}
+
+type nodeClient struct {
+	ethpb.NodeClient
+	genesisTime time.Time
+	syncing     bool
+}
+
+func (c *nodeClient) GetGenesis(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	return &ethpb.Genesis{GenesisTime: timestamppb.New(c.genesisTime), GenesisValidatorsRoot: make([]byte, 32)}, nil
+}
+
+func (c *nodeClient) GetSyncStatus(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{Syncing: c.syncing}, nil
+}
+
+func TestService_streamAttestations_SkipsSeen(t *testing.T) {
+	att1 := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	att2 := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{2}})
+	attsFeed := new(event.Feed)
+	s := &Service{
+		cfg:      &Config{IndexedAttestationsFeed: attsFeed},
+		ctx:      context.Background(),
+		seenAtts: lruwrpr.New(seenCacheSize),
+	}
+	received := make(chan *ethpb.IndexedAttestation, 4)
+	sub := attsFeed.Subscribe(received)
+	defer sub.Unsubscribe()
+
+	// Both beacon nodes stream the first attestation.
+	first := &beaconNode{feedClient: &feedClient{atts: []*ethpb.IndexedAttestation{att1}}}
+	second := &beaconNode{feedClient: &feedClient{atts: []*ethpb.IndexedAttestation{att1, att2}}}
+	require.ErrorIs(t, s.streamAttestations(first), io.EOF)
+	require.ErrorIs(t, s.streamAttestations(second), io.EOF)
+	require.Equal(t, 2, len(received))
+	require.DeepEqual(t, att1, <-received)
+	require.DeepEqual(t, att2, <-received)
+}
+
+func TestService_RemoteBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	down := &chainClient{err: errors.New("unavailable")}
+	up := &chainClient{headSlot: 12}
+	s := &Service{nodes: []*beaconNode{{chainClient: down}, {chainClient: up}}}
+
+	headSlot, err := s.HeadSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(12), headSlot)
+
+	// Slashings are submitted to every beacon node, and only fail if no beacon node accepts them.
+	require.NoError(t, s.SubmitAttesterSlashing(ctx, &ethpb.AttesterSlashing{}))
+	require.Equal(t, 1, up.slashed)
+	s.nodes = s.nodes[:1]
+	require.ErrorContains(t, "unavailable", s.SubmitAttesterSlashing(ctx, &ethpb.AttesterSlashing{}))
+	_, err = s.HeadSlot(ctx)
+	require.ErrorContains(t, "could not get chain head", err)
+}
+
+func TestService_run_NotifiesInitialized(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	genesisTime := time.Unix(time.Now().Unix()-100, 0)
+	s := &Service{
+		cfg: &Config{},
+		ctx: ctx,
+		nodes: []*beaconNode{{
+			nodeClient: &nodeClient{genesisTime: genesisTime},
+			feedClient: &feedClient{},
+		}},
+		stateFeed:        new(event.Feed),
+		seenAtts:         lruwrpr.New(seenCacheSize),
+		seenBlockHeaders: lruwrpr.New(seenCacheSize),
+		syncing:          true,
+	}
+	require.ErrorContains(t, "waiting for the genesis", s.Status())
+	go s.run()
+
+	stateChannel := make(chan *feed.Event, 1)
+	sub := s.StateFeed().Subscribe(stateChannel)
+	defer sub.Unsubscribe()
+	ev := <-stateChannel
+	require.Equal(t, statefeed.Initialized, int(ev.Type))
+	data, ok := ev.Data.(*statefeed.InitializedData)
+	require.Equal(t, true, ok)
+	require.Equal(t, genesisTime.Unix(), data.StartTime.Unix())
+	require.NoError(t, s.Status())
+	require.Equal(t, true, s.Initialized())
+	// The only beacon node is synced.
+	for s.Syncing() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, true, s.Synced())
+}
@@ -0,0 +1,233 @@
+// Package node defines a standalone slasher, which runs slashing detection in its own process
+// on the attestations and blocks received by one or more beacon nodes, and serves the slasher
+// gRPC API to validator clients.
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v4/cmd"
+	"github.com/prysmaticlabs/prysm/v4/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/prysmaticlabs/prysm/v4/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/slasher/beaconclient"
+	"github.com/prysmaticlabs/prysm/v4/slasher/rpc"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// SlasherNode defines a struct that handles the services running a standalone slasher.
+type SlasherNode struct {
+	cliCtx                  *cli.Context
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	services                *runtime.ServiceRegistry
+	lock                    sync.RWMutex
+	stop                    chan struct{} // Channel to wait for termination notifications.
+	db                      *slasherkv.Store
+	indexedAttestationsFeed *event.Feed
+	beaconBlockHeadersFeed  *event.Feed
+}
+
+// New creates a standalone slasher from the command line flags.
+func New(cliCtx *cli.Context) (*SlasherNode, error) {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	s := &SlasherNode{
+		cliCtx:                  cliCtx,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		services:                runtime.NewServiceRegistry(),
+		stop:                    make(chan struct{}),
+		indexedAttestationsFeed: new(event.Feed),
+		beaconBlockHeadersFeed:  new(event.Feed),
+	}
+
+	if err := s.startDB(); err != nil {
+		return nil, err
+	}
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		if err := s.registerPrometheusService(); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.registerBeaconClientService(); err != nil {
+		return nil, err
+	}
+	if err := s.registerSlasherService(); err != nil {
+		return nil, err
+	}
+	if err := s.registerRPCService(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start every service of the slasher.
+func (s *SlasherNode) Start() {
+	s.lock.Lock()
+
+	log.WithFields(logrus.Fields{
+		"version": version.Version(),
+	}).Info("Starting slasher")
+
+	s.services.StartAll()
+
+	stop := s.stop
+	s.lock.Unlock()
+
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		<-sigc
+		log.Info("Got interrupt, shutting down...")
+		debug.Exit(s.cliCtx) // Ensure trace and CPU profile data are flushed.
+		go s.Close()
+		for i := 10; i > 0; i-- {
+			<-sigc
+			if i > 1 {
+				log.WithField("times", i-1).Info("Already shutting down, interrupt more to panic")
+			}
+		}
+		panic("Panic closing the slasher")
+	}()
+
+	// Wait for stop channel to be closed.
+	<-stop
+}
+
+// Close handles graceful shutdown of the system.
+func (s *SlasherNode) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	log.Info("Stopping slasher")
+	s.services.StopAll()
+	if err := s.db.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database")
+	}
+	s.cancel()
+	close(s.stop)
+}
+
+// Opens the slasher database. It is laid out like the database of the slasher of the beacon
+// node, so that the one in --slasher-datadir can be reused by pointing --datadir to it.
+func (s *SlasherNode) startDB() error {
+	dbPath := filepath.Join(s.cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	clearDB := s.cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := s.cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking DB")
+
+	d, err := slasherkv.NewKVStore(s.ctx, dbPath)
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Your database backups will not be removed - do you want to proceed? (Y/N)"
+		deniedText := "Database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear database")
+		}
+		d, err = slasherkv.NewKVStore(s.ctx, dbPath)
+		if err != nil {
+			return errors.Wrap(err, "could not create new database")
+		}
+	}
+	s.db = d
+	return nil
+}
+
+func (s *SlasherNode) registerPrometheusService() error {
+	service := prometheus.NewService(
+		fmt.Sprintf("%s:%d", s.cliCtx.String(cmd.MonitoringHostFlag.Name), s.cliCtx.Int(flags.MonitoringPortFlag.Name)),
+		s.services,
+	)
+	logrus.AddHook(prometheus.NewLogrusCollector())
+	return s.services.RegisterService(service)
+}
+
+func (s *SlasherNode) registerBeaconClientService() error {
+	client, err := beaconclient.NewService(s.ctx, &beaconclient.Config{
+		Endpoints:               s.cliCtx.StringSlice(flags.BeaconRPCProviderFlag.Name),
+		CertPath:                s.cliCtx.String(flags.BeaconCertFlag.Name),
+		MaxCallRecvMsgSize:      s.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		IndexedAttestationsFeed: s.indexedAttestationsFeed,
+		BeaconBlockHeadersFeed:  s.beaconBlockHeadersFeed,
+	})
+	if err != nil {
+		return err
+	}
+	return s.services.RegisterService(client)
+}
+
+func (s *SlasherNode) registerSlasherService() error {
+	var client *beaconclient.Service
+	if err := s.services.FetchService(&client); err != nil {
+		return err
+	}
+	slasherSrv, err := slasher.New(s.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: s.indexedAttestationsFeed,
+		BeaconBlockHeadersFeed:  s.beaconBlockHeadersFeed,
+		Database:                s.db,
+		StateNotifier:           client,
+		SyncChecker:             client,
+		RemoteBeaconNode:        client,
+	})
+	if err != nil {
+		return err
+	}
+	return s.services.RegisterService(slasherSrv)
+}
+
+func (s *SlasherNode) registerRPCService() error {
+	var slasherSrv *slasher.Service
+	if err := s.services.FetchService(&slasherSrv); err != nil {
+		return err
+	}
+	rpcService, err := rpc.NewService(s.ctx, &rpc.Config{
+		Host:            s.cliCtx.String(flags.RPCHost.Name),
+		Port:            s.cliCtx.Int(flags.RPCPort.Name),
+		CertFlag:        s.cliCtx.String(flags.CertFlag.Name),
+		KeyFlag:         s.cliCtx.String(flags.KeyFlag.Name),
+		MaxMsgSize:      s.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		SlashingChecker: slasherSrv,
+	})
+	if err != nil {
+		return err
+	}
+	return s.services.RegisterService(rpcService)
+}
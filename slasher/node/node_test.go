@@ -0,0 +1,42 @@
+package node
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v4/cmd"
+	"github.com/prysmaticlabs/prysm/v4/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/prysmaticlabs/prysm/v4/slasher/beaconclient"
+	"github.com/prysmaticlabs/prysm/v4/slasher/rpc"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+// Test that the slasher can build with default flag values.
+func TestNode_Builds(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	dataDir := t.TempDir()
+	set.String(cmd.DataDirFlag.Name, dataDir, "the slasher data directory")
+	set.Bool(cmd.DisableMonitoringFlag.Name, true, "disable monitoring")
+	beaconNodes := cli.NewStringSlice("127.0.0.1:4000", "127.0.0.1:4100")
+	set.Var(beaconNodes, flags.BeaconRPCProviderFlag.Name, "beacon nodes")
+	set.Int(flags.RPCPort.Name, 0, "rpc port")
+	ctx := cli.NewContext(&app, set, nil)
+
+	node, err := New(ctx)
+	require.NoError(t, err)
+	var client *beaconclient.Service
+	require.NoError(t, node.services.FetchService(&client))
+	var slasherSrv *slasher.Service
+	require.NoError(t, node.services.FetchService(&slasherSrv))
+	var rpcService *rpc.Service
+	require.NoError(t, node.services.FetchService(&rpcService))
+	require.Equal(t, true, file.FileExists(filepath.Join(dataDir, kv.BeaconNodeDbDirName, slasherkv.DatabaseFileName)))
+	node.Close()
+}
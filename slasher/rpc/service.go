@@ -0,0 +1,114 @@
+// Package rpc defines the gRPC server of a standalone slasher, which serves the slasher
+// service to validator clients checking whether their attestations and blocks are slashable.
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	slasherv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/slasher"
+	slasherservice "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+)
+
+// Config for the slasher gRPC server.
+type Config struct {
+	Host            string
+	Port            int
+	CertFlag        string
+	KeyFlag         string
+	MaxMsgSize      int
+	SlashingChecker slasherservice.SlashingChecker
+}
+
+// Service serving the slasher gRPC API.
+type Service struct {
+	cfg        *Config
+	ctx        context.Context
+	cancel     context.CancelFunc
+	listener   net.Listener
+	grpcServer *grpc.Server
+}
+
+// NewService instantiates the gRPC server of a standalone slasher.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	opts := []grpc.ServerOption{
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			recovery.StreamServerInterceptor(),
+			grpcprometheus.StreamServerInterceptor,
+		)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(),
+			grpcprometheus.UnaryServerInterceptor,
+		)),
+	}
+	if cfg.MaxMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxMsgSize))
+	}
+	if cfg.CertFlag != "" && cfg.KeyFlag != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.CertFlag, cfg.KeyFlag)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "could not load TLS keys")
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warn("You are using an insecure gRPC server. If you are running your slasher and " +
+			"validator on the same machines, you can ignore this message")
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	ethpb.RegisterSlasherServer(s.grpcServer, &slasherv1alpha1.Server{
+		SlashingChecker: cfg.SlashingChecker,
+	})
+	reflection.Register(s.grpcServer)
+	return s, nil
+}
+
+// Start listening and serving the gRPC API.
+func (s *Service) Start() {
+	address := fmt.Sprintf("%s:%d", s.cfg.Host, s.cfg.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+		return
+	}
+	s.listener = lis
+	log.WithField("address", address).Info("gRPC server listening on port")
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.WithError(err).Errorf("Could not serve gRPC")
+		}
+	}()
+}
+
+// Stop the gRPC server.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of gRPC server")
+	}
+	return nil
+}
+
+// Status returns an error if the gRPC server is not listening.
+func (s *Service) Status() error {
+	if s.listener == nil {
+		return errors.New("gRPC server is not listening")
+	}
+	return nil
+}
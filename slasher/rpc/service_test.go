@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/mock"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/grpc"
+)
+
+func TestService_ServesSlasher(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx, &Config{
+		Host:            "127.0.0.1",
+		Port:            0,
+		SlashingChecker: &mock.MockSlashingChecker{ProposerSlashingFound: true},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, s.Status())
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	require.NoError(t, s.Status())
+
+	conn, err := grpc.DialContext(ctx, s.listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	res, err := ethpb.NewSlasherClient(conn).IsSlashableBlock(ctx, &ethpb.SignedBeaconBlockHeader{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.ProposerSlashings))
+}
@@ -157,6 +157,26 @@ func (mr *MockNodeClientMockRecorder) GetVersion(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockNodeClient)(nil).GetVersion), varargs...)
 }
 
+// ListBuilderRelays mocks base method.
+func (m *MockNodeClient) ListBuilderRelays(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.BuilderRelays, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBuilderRelays", varargs...)
+	ret0, _ := ret[0].(*eth.BuilderRelays)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuilderRelays indicates an expected call of ListBuilderRelays.
+func (mr *MockNodeClientMockRecorder) ListBuilderRelays(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuilderRelays", reflect.TypeOf((*MockNodeClient)(nil).ListBuilderRelays), varargs...)
+}
+
 // ListImplementedServices mocks base method.
 func (m *MockNodeClient) ListImplementedServices(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.ImplementedServices, error) {
 	m.ctrl.T.Helper()
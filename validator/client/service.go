@@ -22,6 +22,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/wallet"
 	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/beacon-chain-client-factory"
+	grpcApi "github.com/prysmaticlabs/prysm/v4/validator/client/grpc-api"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
 	nodeClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/node-client-factory"
 	slasherClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/slasher-client-factory"
@@ -58,6 +59,7 @@ type ValidatorService struct {
 	logValidatorBalances  bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
 	conn                  validatorHelpers.NodeConnection
+	slasherConn           *grpc.ClientConn
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -100,6 +102,8 @@ type Config struct {
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	SlasherEndpoint            string
+	SlasherCertFlag            string
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -155,6 +159,23 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cfg.BeaconApiTimeout,
 	)
 
+	if cfg.SlasherEndpoint != "" {
+		slasherDialOpts := ConstructDialOptions(
+			s.maxCallRecvMsgSize,
+			cfg.SlasherCertFlag,
+			s.grpcRetries,
+			s.grpcRetryDelay,
+		)
+		if slasherDialOpts == nil {
+			return s, errors.New("could not construct dial options for slasher")
+		}
+		slasherConn, err := grpc.DialContext(ctx, cfg.SlasherEndpoint, slasherDialOpts...)
+		if err != nil {
+			return s, err
+		}
+		s.slasherConn = slasherConn
+	}
+
 	return s, nil
 }
 
@@ -172,6 +193,13 @@ func (v *ValidatorService) Start() {
 
 	aggregatedSlotCommitteeIDCache := lruwrpr.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
 
+	// Slashable attestations and blocks are checked by a standalone slasher if one is configured,
+	// and by the slasher of the beacon node otherwise.
+	slashingProtectionClient := slasherClientFactory.NewSlasherClient(v.conn)
+	if v.slasherConn != nil {
+		slashingProtectionClient = grpcApi.NewSlasherClient(v.slasherConn)
+	}
+
 	sPubKeys, err := v.db.EIPImportBlacklistedPublicKeys(v.ctx)
 	if err != nil {
 		log.WithError(err).Error("Could not read slashable public keys from disk")
@@ -192,7 +220,7 @@ func (v *ValidatorService) Start() {
 		db:                             v.db,
 		validatorClient:                validatorClientFactory.NewValidatorClient(v.conn),
 		beaconClient:                   beaconChainClientFactory.NewBeaconChainClient(v.conn),
-		slashingProtectionClient:       slasherClientFactory.NewSlasherClient(v.conn),
+		slashingProtectionClient:       slashingProtectionClient,
 		node:                           nodeClientFactory.NewNodeClient(v.conn),
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -236,6 +264,11 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.slasherConn != nil {
+		if err := v.slasherConn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to slasher")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
@@ -1038,11 +1038,13 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 	for _, k := range pubkeys {
 		// Default case: Define fee recipient to burn address
 		var feeRecipient common.Address
+		var builderConfig *validatorserviceconfig.BuilderConfig
 		isFeeRecipientDefined := false
 
 		// If fee recipient is defined in default configuration, use it
 		if v.ProposerSettings() != nil && v.ProposerSettings().DefaultConfig != nil && v.ProposerSettings().DefaultConfig.FeeRecipientConfig != nil {
 			feeRecipient = v.ProposerSettings().DefaultConfig.FeeRecipientConfig.FeeRecipient // Use cli config for fee recipient.
+			builderConfig = v.ProposerSettings().DefaultConfig.BuilderConfig
 			isFeeRecipientDefined = true
 		}
 
@@ -1068,15 +1070,24 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 
 			if ok && config != nil && config.FeeRecipientConfig != nil {
 				feeRecipient = config.FeeRecipientConfig.FeeRecipient // Use file config for fee recipient.
+				if config.BuilderConfig != nil {
+					builderConfig = config.BuilderConfig // Use file config for builder preferences.
+				}
 				isFeeRecipientDefined = true
 			}
 		}
 
 		if isFeeRecipientDefined {
-			prepareProposerReqs = append(prepareProposerReqs, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			req := &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
 				ValidatorIndex: validatorIndex,
 				FeeRecipient:   feeRecipient[:],
-			})
+			}
+			// Builder preferences only apply when the builder is enabled for the validator.
+			if builderConfig != nil && builderConfig.Enabled {
+				req.Relays = builderConfig.Relays
+				req.MinBid = uint64(builderConfig.MinBid)
+			}
+			prepareProposerReqs = append(prepareProposerReqs, req)
 
 			if hexutil.Encode(feeRecipient.Bytes()) == params.BeaconConfig().EthBurnAddressHex {
 				log.WithFields(logrus.Fields{
@@ -2100,6 +2100,49 @@ func TestValidator_buildPrepProposerReqs_WithoutDefaultConfig(t *testing.T) {
 	assert.DeepEqual(t, expected, actual)
 }
 
+func TestValidator_buildPrepProposerReqs_BuilderPreferences(t *testing.T) {
+	// pubkey1 => default relays
+	// pubkey2 => its own relays and minimum bid
+	// pubkey3 => builder disabled, no preferences
+	pubkey1 := getPubkeyFromString(t, "0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111")
+	pubkey2 := getPubkeyFromString(t, "0x222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222")
+	pubkey3 := getPubkeyFromString(t, "0x333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333")
+	feeRecipient := getFeeRecipientFromString(t, "0x1111111111111111111111111111111111111111")
+
+	v := validator{
+		proposerSettings: &validatorserviceconfig.ProposerSettings{
+			DefaultConfig: &validatorserviceconfig.ProposerOption{
+				FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+				BuilderConfig:      &validatorserviceconfig.BuilderConfig{Enabled: true, Relays: []string{"https://default.relay"}},
+			},
+			ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+				pubkey2: {
+					FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+					BuilderConfig:      &validatorserviceconfig.BuilderConfig{Enabled: true, Relays: []string{"https://custom.relay"}, MinBid: 100},
+				},
+				pubkey3: {
+					FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+					BuilderConfig:      &validatorserviceconfig.BuilderConfig{Enabled: false, Relays: []string{"https://custom.relay"}, MinBid: 100},
+				},
+			},
+		},
+		pubkeyToValidatorIndex: map[[48]byte]primitives.ValidatorIndex{
+			pubkey1: 1,
+			pubkey2: 2,
+			pubkey3: 3,
+		},
+	}
+
+	expected := []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+		{ValidatorIndex: 1, FeeRecipient: feeRecipient[:], Relays: []string{"https://default.relay"}},
+		{ValidatorIndex: 2, FeeRecipient: feeRecipient[:], Relays: []string{"https://custom.relay"}, MinBid: 100},
+		{ValidatorIndex: 3, FeeRecipient: feeRecipient[:]},
+	}
+	actual, err := v.buildPrepProposerReqs(context.Background(), [][fieldparams.BLSPubkeyLength]byte{pubkey1, pubkey2, pubkey3})
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected, actual)
+}
+
 func TestValidator_buildPrepProposerReqs_WithDefaultConfig(t *testing.T) {
 	// pubkey1 => feeRecipient1 (already in `v.validatorIndex`)
 	// pubkey2 => feeRecipient2 (NOT in `v.validatorIndex`, index found by beacon node)
@@ -0,0 +1,14 @@
+package kv
+
+import (
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/v4/io/boltutil"
+)
+
+// Compact compacts the validator database in the given directory, which must not be in use, giving the pages freed by
+// deletions back to the file system.
+func Compact(dirPath string) error {
+	_, _, err := boltutil.CompactFile(filepath.Join(dirPath, ProtectionDbFileName), 0, nil)
+	return err
+}
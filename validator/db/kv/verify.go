@@ -0,0 +1,166 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/boltutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// epochLength is the length of an encoded epoch or slot.
+const epochLength = 8
+
+// Verify walks the attestation and proposal history of every public key, and returns the entries which are not
+// consistent with each other. Slashing protection data is never deleted: only the inconsistencies which can be
+// repaired by making the protection stricter, such as an attestation missing from one of the two epoch indices, can be
+// repaired with Repair. The other inconsistencies are only reported.
+func (s *Store) Verify(ctx context.Context) ([]*boltutil.Inconsistency, error) {
+	_, span := trace.StartSpan(ctx, "Validator.Verify")
+	defer span.End()
+
+	var issues []*boltutil.Inconsistency
+	report := func(bucket []byte, key []byte, fix func(tx *bolt.Tx) error, format string, args ...interface{}) {
+		issues = append(issues, &boltutil.Inconsistency{
+			Bucket: string(bucket),
+			Key:    bytesutil.SafeCopyBytes(key),
+			Reason: fmt.Sprintf(format, args...),
+			Fix:    fix,
+		})
+	}
+	err := s.view(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(pubKeysBucket).ForEach(func(pubKey, v []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if v != nil {
+				report(pubKeysBucket, pubKey, nil, "public key entry is not a bucket")
+				return nil
+			}
+			verifyAttestationHistory(tx.Bucket(pubKeysBucket).Bucket(pubKey), bytesutil.SafeCopyBytes(pubKey), report)
+			return nil
+		}); err != nil {
+			return err
+		}
+		verifyEpochValues(tx, report, lowestSignedSourceBucket, lowestSignedTargetBucket, lowestSignedProposalsBucket, highestSignedProposalsBucket)
+		return tx.Bucket(historicProposalsBucket).ForEach(func(pubKey, v []byte) error {
+			if v != nil {
+				report(historicProposalsBucket, pubKey, nil, "public key entry is not a bucket")
+				return nil
+			}
+			verifyProposalHistory(tx, bytesutil.SafeCopyBytes(pubKey), report)
+			return nil
+		})
+	})
+	return issues, err
+}
+
+// Repair fixes the inconsistencies returned by Verify which can be repaired, and returns their number.
+func (s *Store) Repair(issues []*boltutil.Inconsistency) (int, error) {
+	return boltutil.Repair(s.db, issues)
+}
+
+type reportFunc func(bucket []byte, key []byte, fix func(tx *bolt.Tx) error, format string, args ...interface{})
+
+// verifyAttestationHistory checks that the source and target epoch indices of a public key list the same attestations,
+// and that the signing root of every attested target is saved.
+func verifyAttestationHistory(pkBucket *bolt.Bucket, pubKey []byte, report reportFunc) {
+	sources := pkBucket.Bucket(attestationSourceEpochsBucket)
+	targets := pkBucket.Bucket(attestationTargetEpochsBucket)
+	signingRoots := pkBucket.Bucket(attestationSigningRootsBucket)
+	if sources == nil || targets == nil || signingRoots == nil {
+		if sources != nil || targets != nil || signingRoots != nil {
+			report(pubKeysBucket, pubKey, nil, "attestation history is missing some of its buckets")
+		}
+		return
+	}
+	verifyEpochIndex(pubKey, sources, targets, attestationSourceEpochsBucket, attestationTargetEpochsBucket, report)
+	verifyEpochIndex(pubKey, targets, sources, attestationTargetEpochsBucket, attestationSourceEpochsBucket, report)
+	if err := targets.ForEach(func(target, _ []byte) error {
+		if signingRoots.Get(target) == nil {
+			report(pubKeysBucket, pubKey, nil, "signing root of target epoch %d is missing", bytesutil.BytesToEpochBigEndian(target))
+		}
+		return nil
+	}); err != nil {
+		report(pubKeysBucket, pubKey, nil, "could not walk target epochs: %v", err)
+	}
+}
+
+// verifyEpochIndex checks that every pair of epochs of an index is also in the inverse index, and repairs it by adding
+// the pair to the inverse index.
+func verifyEpochIndex(pubKey []byte, index, inverse *bolt.Bucket, indexName, inverseName []byte, report reportFunc) {
+	if err := index.ForEach(func(k, v []byte) error {
+		if len(k) != epochLength || len(v)%epochLength != 0 {
+			report(pubKeysBucket, pubKey, nil, "%s entry %#x has a malformed key or value", indexName, k)
+			return nil
+		}
+		for i := 0; i < len(v); i += epochLength {
+			other := v[i : i+epochLength]
+			if containsEpoch(inverse.Get(other), k) {
+				continue
+			}
+			key, value := bytesutil.SafeCopyBytes(other), bytesutil.SafeCopyBytes(k)
+			report(pubKeysBucket, pubKey, func(tx *bolt.Tx) error {
+				bkt := tx.Bucket(pubKeysBucket).Bucket(pubKey).Bucket(inverseName)
+				existing := bkt.Get(key)
+				if containsEpoch(existing, value) {
+					return nil
+				}
+				return bkt.Put(key, append(bytesutil.SafeCopyBytes(existing), value...))
+			}, "epoch %d of %s is missing epoch %d from %s", bytesutil.BytesToEpochBigEndian(k), indexName,
+				bytesutil.BytesToEpochBigEndian(other), inverseName)
+		}
+		return nil
+	}); err != nil {
+		report(pubKeysBucket, pubKey, nil, "could not walk %s: %v", indexName, err)
+	}
+}
+
+// verifyProposalHistory checks that the highest signed proposal of a public key is not below any proposal of its
+// history, and repairs it by raising it.
+func verifyProposalHistory(tx *bolt.Tx, pubKey []byte, report reportFunc) {
+	history := tx.Bucket(historicProposalsBucket).Bucket(pubKey)
+	k, _ := history.Cursor().Last()
+	if k == nil {
+		return
+	}
+	if len(k) != epochLength {
+		report(historicProposalsBucket, pubKey, nil, "proposal slot %#x is malformed", k)
+		return
+	}
+	highest := tx.Bucket(highestSignedProposalsBucket).Get(pubKey)
+	if len(highest) == epochLength && bytes.Compare(highest, k) >= 0 {
+		return
+	}
+	slot := bytesutil.SafeCopyBytes(k)
+	report(highestSignedProposalsBucket, pubKey, func(tx *bolt.Tx) error {
+		return tx.Bucket(highestSignedProposalsBucket).Put(pubKey, slot)
+	}, "highest signed proposal is below the proposal at slot %d", bytesutil.BytesToSlotBigEndian(slot))
+}
+
+// verifyEpochValues checks that every value of the given buckets is an encoded epoch or slot.
+func verifyEpochValues(tx *bolt.Tx, report reportFunc, buckets ...[]byte) {
+	for _, name := range buckets {
+		if err := tx.Bucket(name).ForEach(func(k, v []byte) error {
+			if len(v) != epochLength {
+				report(name, k, nil, "value of length %d is not an epoch or slot", len(v))
+			}
+			return nil
+		}); err != nil {
+			report(name, nil, nil, "could not walk bucket: %v", err)
+		}
+	}
+}
+
+// containsEpoch reports whether the given list of encoded epochs contains an epoch.
+func containsEpoch(epochs []byte, epoch []byte) bool {
+	for i := 0; i+epochLength <= len(epochs); i += epochLength {
+		if bytes.Equal(epochs[i:i+epochLength], epoch) {
+			return true
+		}
+	}
+	return false
+}
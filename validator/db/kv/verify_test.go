@@ -0,0 +1,58 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_Verify(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{2}, createAttestation(2, 3)))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+
+	issues, err := validatorDB.Verify(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(issues), "Unexpected inconsistencies %v", issues)
+
+	// Lose an attestation from the target epochs index, a signing root, and the highest signed proposal.
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+		if err := pkBucket.Bucket(attestationTargetEpochsBucket).Delete(bytesutil.EpochToBytesBigEndian(3)); err != nil {
+			return err
+		}
+		if err := pkBucket.Bucket(attestationSigningRootsBucket).Delete(bytesutil.EpochToBytesBigEndian(2)); err != nil {
+			return err
+		}
+		return tx.Bucket(highestSignedProposalsBucket).Put(pubKey[:], bytesutil.SlotToBytesBigEndian(5))
+	}))
+
+	issues, err = validatorDB.Verify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(issues))
+	repaired, err := validatorDB.Repair(issues)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repaired)
+
+	// The missing signing root cannot be repaired.
+	issues, err = validatorDB.Verify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(issues))
+	slot, exists, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(10), uint64(slot))
+	require.NoError(t, validatorDB.view(func(tx *bolt.Tx) error {
+		targets := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]).Bucket(attestationTargetEpochsBucket)
+		assert.DeepEqual(t, bytesutil.EpochToBytesBigEndian(2), targets.Get(bytesutil.EpochToBytesBigEndian(3)))
+		return nil
+	}))
+}
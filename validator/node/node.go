@@ -413,6 +413,12 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	// A standalone slasher is only used when its endpoint is set explicitly.
+	var slasherEndpoint string
+	if c.cliCtx.IsSet(flags.SlasherRPCProviderFlag.Name) {
+		slasherEndpoint = c.cliCtx.String(flags.SlasherRPCProviderFlag.Name)
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -434,6 +440,8 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ProposerSettings:           bpc,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		SlasherEndpoint:            slasherEndpoint,
+		SlasherCertFlag:            c.cliCtx.String(flags.SlasherCertFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")